@@ -17,13 +17,63 @@
 		RingConf
 		Conf
 		SubscriberID
+		RingEventFilter
+		RingEvent
+		SubscriberList
+		SubscriberInfo
 		RegisterRequest
+		ReplaceNodeRequest
 		HardwareProfile
+		NodeHardware
+		NodeStatusReport
+		CapacityProposal
+		CapacityPolicyStatus
+		SimulatedNodes
+		SimulateRequest
+		NodeProjection
+		SimulateResult
+		RegisterMultiRequest
+		ServiceRegistration
+		RegisterMultiResult
+		ServiceInfo
+		ServiceList
+		ServiceReload
+		ConfigReloadResult
 		Disk
 		NodeConfig
 		Ring
+		RingDelta
+		PartitionAssignment
 		SearchResult
+		NodeQuery
+		NodeQueryResult
+		NodeQueryMatch
+		PartitionLookup
+		KeyLookup
+		PartitionLookupResult
+		PartitionReplica
+		RingStats
+		NodeBalance
+		TierBalance
 		NodeSoftwareVersion
+		SoftwareVersions
+		SoftwareVersionGroup
+		NodeVersion
+		UpgradeRequest
+		UpgradeStatus
+		UpgradeNode
+		NodeControlRequest
+		NodeControlResult
+		NodeControlStatus
+		MaintenanceRequest
+		MaintenanceStatus
+		MaintenanceList
+		RampRequest
+		RampStatus
+		RampList
+		DecommissionRequest
+		DecommissionStatus
+		DecommissionList
 		NodeUpgrade
 		NodeUpgradeStatus
 		RingMsg
@@ -53,6 +103,222 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 const _ = proto1.ProtoPackageIsVersion1
 
+type RingEventType int32
+
+const (
+	RingEventType_UNKNOWN_EVENT     RingEventType = 0
+	RingEventType_NODE_ADDED        RingEventType = 1
+	RingEventType_NODE_REMOVED      RingEventType = 2
+	RingEventType_NODE_ACTIVATED    RingEventType = 3
+	RingEventType_NODE_DEACTIVATED  RingEventType = 4
+	RingEventType_CAPACITY_CHANGED  RingEventType = 5
+	RingEventType_TIERS_CHANGED     RingEventType = 6
+	RingEventType_ADDRESSES_CHANGED RingEventType = 7
+	RingEventType_CONFIG_CHANGED    RingEventType = 8
+	RingEventType_REPLICAS_CHANGED  RingEventType = 9
+)
+
+var RingEventType_name = map[int32]string{
+	0: "UNKNOWN_EVENT",
+	1: "NODE_ADDED",
+	2: "NODE_REMOVED",
+	3: "NODE_ACTIVATED",
+	4: "NODE_DEACTIVATED",
+	5: "CAPACITY_CHANGED",
+	6: "TIERS_CHANGED",
+	7: "ADDRESSES_CHANGED",
+	8: "CONFIG_CHANGED",
+	9: "REPLICAS_CHANGED",
+}
+var RingEventType_value = map[string]int32{
+	"UNKNOWN_EVENT":     0,
+	"NODE_ADDED":        1,
+	"NODE_REMOVED":      2,
+	"NODE_ACTIVATED":    3,
+	"NODE_DEACTIVATED":  4,
+	"CAPACITY_CHANGED":  5,
+	"TIERS_CHANGED":     6,
+	"ADDRESSES_CHANGED": 7,
+	"CONFIG_CHANGED":    8,
+	"REPLICAS_CHANGED":  9,
+}
+
+func (x RingEventType) String() string {
+	return proto1.EnumName(RingEventType_name, int32(x))
+}
+func (RingEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{0} }
+
+type CapacityProposalState int32
+
+const (
+	CapacityProposalState_PROPOSAL_PENDING  CapacityProposalState = 0
+	CapacityProposalState_PROPOSAL_APPLIED  CapacityProposalState = 1
+	CapacityProposalState_PROPOSAL_REJECTED CapacityProposalState = 2
+	CapacityProposalState_PROPOSAL_FAILED   CapacityProposalState = 3
+	CapacityProposalState_PROPOSAL_EXPIRED  CapacityProposalState = 4
+)
+
+var CapacityProposalState_name = map[int32]string{
+	0: "PROPOSAL_PENDING",
+	1: "PROPOSAL_APPLIED",
+	2: "PROPOSAL_REJECTED",
+	3: "PROPOSAL_FAILED",
+	4: "PROPOSAL_EXPIRED",
+}
+var CapacityProposalState_value = map[string]int32{
+	"PROPOSAL_PENDING":  0,
+	"PROPOSAL_APPLIED":  1,
+	"PROPOSAL_REJECTED": 2,
+	"PROPOSAL_FAILED":   3,
+	"PROPOSAL_EXPIRED":  4,
+}
+
+func (x CapacityProposalState) String() string {
+	return proto1.EnumName(CapacityProposalState_name, int32(x))
+}
+func (CapacityProposalState) EnumDescriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{1} }
+
+type UpgradeState int32
+
+const (
+	UpgradeState_UPGRADE_IDLE         UpgradeState = 0
+	UpgradeState_UPGRADE_RUNNING      UpgradeState = 1
+	UpgradeState_UPGRADE_PAUSED       UpgradeState = 2
+	UpgradeState_UPGRADE_ABORTED      UpgradeState = 3
+	UpgradeState_UPGRADE_FAILED       UpgradeState = 4
+	UpgradeState_UPGRADE_COMPLETE     UpgradeState = 5
+	UpgradeState_UPGRADE_ROLLING_BACK UpgradeState = 6
+	UpgradeState_UPGRADE_ROLLED_BACK  UpgradeState = 7
+)
+
+var UpgradeState_name = map[int32]string{
+	0: "UPGRADE_IDLE",
+	1: "UPGRADE_RUNNING",
+	2: "UPGRADE_PAUSED",
+	3: "UPGRADE_ABORTED",
+	4: "UPGRADE_FAILED",
+	5: "UPGRADE_COMPLETE",
+	6: "UPGRADE_ROLLING_BACK",
+	7: "UPGRADE_ROLLED_BACK",
+}
+var UpgradeState_value = map[string]int32{
+	"UPGRADE_IDLE":         0,
+	"UPGRADE_RUNNING":      1,
+	"UPGRADE_PAUSED":       2,
+	"UPGRADE_ABORTED":      3,
+	"UPGRADE_FAILED":       4,
+	"UPGRADE_COMPLETE":     5,
+	"UPGRADE_ROLLING_BACK": 6,
+	"UPGRADE_ROLLED_BACK":  7,
+}
+
+func (x UpgradeState) String() string {
+	return proto1.EnumName(UpgradeState_name, int32(x))
+}
+func (UpgradeState) EnumDescriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{2} }
+
+type UpgradeNodeState int32
+
+const (
+	UpgradeNodeState_NODE_PENDING         UpgradeNodeState = 0
+	UpgradeNodeState_NODE_UPGRADING       UpgradeNodeState = 1
+	UpgradeNodeState_NODE_CHECKING        UpgradeNodeState = 2
+	UpgradeNodeState_NODE_UPGRADED        UpgradeNodeState = 3
+	UpgradeNodeState_NODE_FAILED          UpgradeNodeState = 4
+	UpgradeNodeState_NODE_SKIPPED         UpgradeNodeState = 5
+	UpgradeNodeState_NODE_ROLLING_BACK    UpgradeNodeState = 6
+	UpgradeNodeState_NODE_ROLLED_BACK     UpgradeNodeState = 7
+	UpgradeNodeState_NODE_ROLLBACK_FAILED UpgradeNodeState = 8
+)
+
+var UpgradeNodeState_name = map[int32]string{
+	0: "NODE_PENDING",
+	1: "NODE_UPGRADING",
+	2: "NODE_CHECKING",
+	3: "NODE_UPGRADED",
+	4: "NODE_FAILED",
+	5: "NODE_SKIPPED",
+	6: "NODE_ROLLING_BACK",
+	7: "NODE_ROLLED_BACK",
+	8: "NODE_ROLLBACK_FAILED",
+}
+var UpgradeNodeState_value = map[string]int32{
+	"NODE_PENDING":         0,
+	"NODE_UPGRADING":       1,
+	"NODE_CHECKING":        2,
+	"NODE_UPGRADED":        3,
+	"NODE_FAILED":          4,
+	"NODE_SKIPPED":         5,
+	"NODE_ROLLING_BACK":    6,
+	"NODE_ROLLED_BACK":     7,
+	"NODE_ROLLBACK_FAILED": 8,
+}
+
+func (x UpgradeNodeState) String() string {
+	return proto1.EnumName(UpgradeNodeState_name, int32(x))
+}
+func (UpgradeNodeState) EnumDescriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{3} }
+
+type RampState int32
+
+const (
+	RampState_RAMP_RUNNING  RampState = 0
+	RampState_RAMP_PAUSED   RampState = 1
+	RampState_RAMP_ABORTED  RampState = 2
+	RampState_RAMP_FAILED   RampState = 3
+	RampState_RAMP_COMPLETE RampState = 4
+)
+
+var RampState_name = map[int32]string{
+	0: "RAMP_RUNNING",
+	1: "RAMP_PAUSED",
+	2: "RAMP_ABORTED",
+	3: "RAMP_FAILED",
+	4: "RAMP_COMPLETE",
+}
+var RampState_value = map[string]int32{
+	"RAMP_RUNNING":  0,
+	"RAMP_PAUSED":   1,
+	"RAMP_ABORTED":  2,
+	"RAMP_FAILED":   3,
+	"RAMP_COMPLETE": 4,
+}
+
+func (x RampState) String() string {
+	return proto1.EnumName(RampState_name, int32(x))
+}
+func (RampState) EnumDescriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{4} }
+
+type DecommissionState int32
+
+const (
+	DecommissionState_DECOMMISSION_DRAINING   DecommissionState = 0
+	DecommissionState_DECOMMISSION_CONVERGING DecommissionState = 1
+	DecommissionState_DECOMMISSION_STOPPING   DecommissionState = 2
+	DecommissionState_DECOMMISSION_REMOVING   DecommissionState = 3
+	DecommissionState_DECOMMISSION_COMPLETE   DecommissionState = 4
+)
+
+var DecommissionState_name = map[int32]string{
+	0: "DECOMMISSION_DRAINING",
+	1: "DECOMMISSION_CONVERGING",
+	2: "DECOMMISSION_STOPPING",
+	3: "DECOMMISSION_REMOVING",
+	4: "DECOMMISSION_COMPLETE",
+}
+var DecommissionState_value = map[string]int32{
+	"DECOMMISSION_DRAINING":   0,
+	"DECOMMISSION_CONVERGING": 1,
+	"DECOMMISSION_STOPPING":   2,
+	"DECOMMISSION_REMOVING":   3,
+	"DECOMMISSION_COMPLETE":   4,
+}
+
+func (x DecommissionState) String() string {
+	return proto1.EnumName(DecommissionState_name, int32(x))
+}
+func (DecommissionState) EnumDescriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{5} }
+
 type EmptyMsg struct {
 }
 
@@ -141,7 +407,9 @@ func (*Conf) ProtoMessage()               {}
 func (*Conf) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{6} }
 
 type SubscriberID struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Deltas  bool   `protobuf:"varint,3,opt,name=deltas,proto3" json:"deltas,omitempty"`
 }
 
 func (m *SubscriberID) Reset()                    { *m = SubscriberID{} }
@@ -149,6 +417,79 @@ func (m *SubscriberID) String() string            { return proto1.CompactTextStr
 func (*SubscriberID) ProtoMessage()               {}
 func (*SubscriberID) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{7} }
 
+type RingEventFilter struct {
+	Types []RingEventType `protobuf:"varint,1,rep,packed,name=types,enum=proto.RingEventType" json:"types,omitempty"`
+	Nodes []uint64        `protobuf:"varint,2,rep,packed,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *RingEventFilter) Reset()                    { *m = RingEventFilter{} }
+func (m *RingEventFilter) String() string            { return proto1.CompactTextString(m) }
+func (*RingEventFilter) ProtoMessage()               {}
+func (*RingEventFilter) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{8} }
+
+type RingEvent struct {
+	Type            RingEventType `protobuf:"varint,1,opt,name=type,proto3,enum=proto.RingEventType" json:"type,omitempty"`
+	Version         int64         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	PreviousVersion int64         `protobuf:"varint,3,opt,name=previousVersion,proto3" json:"previousVersion,omitempty"`
+	Node            uint64        `protobuf:"varint,4,opt,name=node,proto3" json:"node,omitempty"`
+	OldNode         *Node         `protobuf:"bytes,5,opt,name=oldNode" json:"oldNode,omitempty"`
+	NewNode         *Node         `protobuf:"bytes,6,opt,name=newNode" json:"newNode,omitempty"`
+	OldConf         []byte        `protobuf:"bytes,7,opt,name=oldConf,proto3" json:"oldConf,omitempty"`
+	NewConf         []byte        `protobuf:"bytes,8,opt,name=newConf,proto3" json:"newConf,omitempty"`
+	OldReplicas     int32         `protobuf:"varint,9,opt,name=oldReplicas,proto3" json:"oldReplicas,omitempty"`
+	NewReplicas     int32         `protobuf:"varint,10,opt,name=newReplicas,proto3" json:"newReplicas,omitempty"`
+}
+
+func (m *RingEvent) Reset()                    { *m = RingEvent{} }
+func (m *RingEvent) String() string            { return proto1.CompactTextString(m) }
+func (*RingEvent) ProtoMessage()               {}
+func (*RingEvent) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{9} }
+
+func (m *RingEvent) GetOldNode() *Node {
+	if m != nil {
+		return m.OldNode
+	}
+	return nil
+}
+
+func (m *RingEvent) GetNewNode() *Node {
+	if m != nil {
+		return m.NewNode
+	}
+	return nil
+}
+
+type SubscriberList struct {
+	Version     int64             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Subscribers []*SubscriberInfo `protobuf:"bytes,2,rep,name=subscribers" json:"subscribers,omitempty"`
+}
+
+func (m *SubscriberList) Reset()                    { *m = SubscriberList{} }
+func (m *SubscriberList) String() string            { return proto1.CompactTextString(m) }
+func (*SubscriberList) ProtoMessage()               {}
+func (*SubscriberList) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{10} }
+
+func (m *SubscriberList) GetSubscribers() []*SubscriberInfo {
+	if m != nil {
+		return m.Subscribers
+	}
+	return nil
+}
+
+type SubscriberInfo struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer        string `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Connected   int64  `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	LastVersion int64  `protobuf:"varint,4,opt,name=lastVersion,proto3" json:"lastVersion,omitempty"`
+	LastSend    int64  `protobuf:"varint,5,opt,name=lastSend,proto3" json:"lastSend,omitempty"`
+	Deltas      bool   `protobuf:"varint,6,opt,name=deltas,proto3" json:"deltas,omitempty"`
+}
+
+func (m *SubscriberInfo) Reset()                    { *m = SubscriberInfo{} }
+func (m *SubscriberInfo) String() string            { return proto1.CompactTextString(m) }
+func (*SubscriberInfo) ProtoMessage()               {}
+func (*SubscriberInfo) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{11} }
+
 type RegisterRequest struct {
 	Hostname string           `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
 	Addrs    []string         `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
@@ -159,7 +500,7 @@ type RegisterRequest struct {
 func (m *RegisterRequest) Reset()                    { *m = RegisterRequest{} }
 func (m *RegisterRequest) String() string            { return proto1.CompactTextString(m) }
 func (*RegisterRequest) ProtoMessage()               {}
-func (*RegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{8} }
+func (*RegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{12} }
 
 func (m *RegisterRequest) GetHardware() *HardwareProfile {
 	if m != nil {
@@ -168,6 +509,25 @@ func (m *RegisterRequest) GetHardware() *HardwareProfile {
 	return nil
 }
 
+type ReplaceNodeRequest struct {
+	Id       uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname string           `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Addrs    []string         `protobuf:"bytes,3,rep,name=addrs" json:"addrs,omitempty"`
+	Hardware *HardwareProfile `protobuf:"bytes,4,opt,name=hardware" json:"hardware,omitempty"`
+}
+
+func (m *ReplaceNodeRequest) Reset()                    { *m = ReplaceNodeRequest{} }
+func (m *ReplaceNodeRequest) String() string            { return proto1.CompactTextString(m) }
+func (*ReplaceNodeRequest) ProtoMessage()               {}
+func (*ReplaceNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{13} }
+
+func (m *ReplaceNodeRequest) GetHardware() *HardwareProfile {
+	if m != nil {
+		return m.Hardware
+	}
+	return nil
+}
+
 type HardwareProfile struct {
 	Memtotal uint64  `protobuf:"varint,1,opt,name=memtotal,proto3" json:"memtotal,omitempty"`
 	Memfree  uint64  `protobuf:"varint,2,opt,name=memfree,proto3" json:"memfree,omitempty"`
@@ -178,7 +538,7 @@ type HardwareProfile struct {
 func (m *HardwareProfile) Reset()                    { *m = HardwareProfile{} }
 func (m *HardwareProfile) String() string            { return proto1.CompactTextString(m) }
 func (*HardwareProfile) ProtoMessage()               {}
-func (*HardwareProfile) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{9} }
+func (*HardwareProfile) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{14} }
 
 func (m *HardwareProfile) GetDisks() []*Disk {
 	if m != nil {
@@ -187,6 +547,298 @@ func (m *HardwareProfile) GetDisks() []*Disk {
 	return nil
 }
 
+type NodeHardware struct {
+	Id       uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname string           `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Hardware *HardwareProfile `protobuf:"bytes,3,opt,name=hardware" json:"hardware,omitempty"`
+	Updated  int64            `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *NodeHardware) Reset()                    { *m = NodeHardware{} }
+func (m *NodeHardware) String() string            { return proto1.CompactTextString(m) }
+func (*NodeHardware) ProtoMessage()               {}
+func (*NodeHardware) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{15} }
+
+func (m *NodeHardware) GetHardware() *HardwareProfile {
+	if m != nil {
+		return m.Hardware
+	}
+	return nil
+}
+
+type NodeStatusReport struct {
+	Id          uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname    string           `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Hardware    *HardwareProfile `protobuf:"bytes,3,opt,name=hardware" json:"hardware,omitempty"`
+	Load1       float64          `protobuf:"fixed64,4,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5       float64          `protobuf:"fixed64,5,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15      float64          `protobuf:"fixed64,6,opt,name=load15,proto3" json:"load15,omitempty"`
+	RingVersion int64            `protobuf:"varint,7,opt,name=ringVersion,proto3" json:"ringVersion,omitempty"`
+}
+
+func (m *NodeStatusReport) Reset()                    { *m = NodeStatusReport{} }
+func (m *NodeStatusReport) String() string            { return proto1.CompactTextString(m) }
+func (*NodeStatusReport) ProtoMessage()               {}
+func (*NodeStatusReport) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{16} }
+
+func (m *NodeStatusReport) GetHardware() *HardwareProfile {
+	if m != nil {
+		return m.Hardware
+	}
+	return nil
+}
+
+type CapacityProposal struct {
+	Id          uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Restore     bool                  `protobuf:"varint,2,opt,name=restore,proto3" json:"restore,omitempty"`
+	Capacity    uint32                `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Proposed    uint32                `protobuf:"varint,4,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	Fill        float64               `protobuf:"fixed64,5,opt,name=fill,proto3" json:"fill,omitempty"`
+	State       CapacityProposalState `protobuf:"varint,6,opt,name=state,proto3,enum=proto.CapacityProposalState" json:"state,omitempty"`
+	Error       string                `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Caller      string                `protobuf:"bytes,8,opt,name=caller,proto3" json:"caller,omitempty"`
+	Created     int64                 `protobuf:"varint,9,opt,name=created,proto3" json:"created,omitempty"`
+	Decided     int64                 `protobuf:"varint,10,opt,name=decided,proto3" json:"decided,omitempty"`
+	RingVersion int64                 `protobuf:"varint,11,opt,name=ringVersion,proto3" json:"ringVersion,omitempty"`
+}
+
+func (m *CapacityProposal) Reset()                    { *m = CapacityProposal{} }
+func (m *CapacityProposal) String() string            { return proto1.CompactTextString(m) }
+func (*CapacityProposal) ProtoMessage()               {}
+func (*CapacityProposal) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{17} }
+
+type CapacityPolicyStatus struct {
+	Mode    string              `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Pending []*CapacityProposal `protobuf:"bytes,2,rep,name=pending" json:"pending,omitempty"`
+	History []*CapacityProposal `protobuf:"bytes,3,rep,name=history" json:"history,omitempty"`
+}
+
+func (m *CapacityPolicyStatus) Reset()                    { *m = CapacityPolicyStatus{} }
+func (m *CapacityPolicyStatus) String() string            { return proto1.CompactTextString(m) }
+func (*CapacityPolicyStatus) ProtoMessage()               {}
+func (*CapacityPolicyStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{18} }
+
+func (m *CapacityPolicyStatus) GetPending() []*CapacityProposal {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *CapacityPolicyStatus) GetHistory() []*CapacityProposal {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type SimulatedNodes struct {
+	Count    uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Capacity uint32   `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Tiers    []string `protobuf:"bytes,3,rep,name=tiers" json:"tiers,omitempty"`
+	Meta     string   `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (m *SimulatedNodes) Reset()                    { *m = SimulatedNodes{} }
+func (m *SimulatedNodes) String() string            { return proto1.CompactTextString(m) }
+func (*SimulatedNodes) ProtoMessage()               {}
+func (*SimulatedNodes) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{19} }
+
+type SimulateRequest struct {
+	Add      []*SimulatedNodes `protobuf:"bytes,1,rep,name=add" json:"add,omitempty"`
+	Remove   []uint64          `protobuf:"varint,2,rep,packed,name=remove" json:"remove,omitempty"`
+	Capacity []*Node           `protobuf:"bytes,3,rep,name=capacity" json:"capacity,omitempty"`
+	Path     string            `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *SimulateRequest) Reset()                    { *m = SimulateRequest{} }
+func (m *SimulateRequest) String() string            { return proto1.CompactTextString(m) }
+func (*SimulateRequest) ProtoMessage()               {}
+func (*SimulateRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{20} }
+
+func (m *SimulateRequest) GetAdd() []*SimulatedNodes {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *SimulateRequest) GetCapacity() []*Node {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+type NodeProjection struct {
+	Id            uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          string  `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Simulated     bool    `protobuf:"varint,3,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	Removed       bool    `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	Capacity      uint32  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Assigned      uint64  `protobuf:"varint,6,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	Projected     uint64  `protobuf:"varint,7,opt,name=projected,proto3" json:"projected,omitempty"`
+	Reported      bool    `protobuf:"varint,8,opt,name=reported,proto3" json:"reported,omitempty"`
+	Fill          float64 `protobuf:"fixed64,9,opt,name=fill,proto3" json:"fill,omitempty"`
+	ProjectedFill float64 `protobuf:"fixed64,10,opt,name=projectedFill,proto3" json:"projectedFill,omitempty"`
+}
+
+func (m *NodeProjection) Reset()                    { *m = NodeProjection{} }
+func (m *NodeProjection) String() string            { return proto1.CompactTextString(m) }
+func (*NodeProjection) ProtoMessage()               {}
+func (*NodeProjection) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{21} }
+
+type SimulateResult struct {
+	Before       *RingStats        `protobuf:"bytes,1,opt,name=before" json:"before,omitempty"`
+	After        *RingStats        `protobuf:"bytes,2,opt,name=after" json:"after,omitempty"`
+	Replicas     uint64            `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Moved        uint64            `protobuf:"varint,4,opt,name=moved,proto3" json:"moved,omitempty"`
+	MovedPercent float64           `protobuf:"fixed64,5,opt,name=movedPercent,proto3" json:"movedPercent,omitempty"`
+	Nodes        []*NodeProjection `protobuf:"bytes,6,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *SimulateResult) Reset()                    { *m = SimulateResult{} }
+func (m *SimulateResult) String() string            { return proto1.CompactTextString(m) }
+func (*SimulateResult) ProtoMessage()               {}
+func (*SimulateResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{22} }
+
+func (m *SimulateResult) GetBefore() *RingStats {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *SimulateResult) GetAfter() *RingStats {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *SimulateResult) GetNodes() []*NodeProjection {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type RegisterMultiRequest struct {
+	Node     *RegisterRequest `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	Services []string         `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	Rollback bool             `protobuf:"varint,3,opt,name=rollback,proto3" json:"rollback,omitempty"`
+}
+
+func (m *RegisterMultiRequest) Reset()                    { *m = RegisterMultiRequest{} }
+func (m *RegisterMultiRequest) String() string            { return proto1.CompactTextString(m) }
+func (*RegisterMultiRequest) ProtoMessage()               {}
+func (*RegisterMultiRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{23} }
+
+func (m *RegisterMultiRequest) GetNode() *RegisterRequest {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type ServiceRegistration struct {
+	Service       string      `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Config        *NodeConfig `protobuf:"bytes,2,opt,name=config" json:"config,omitempty"`
+	Error         string      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Added         bool        `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"`
+	Skipped       bool        `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	RolledBack    bool        `protobuf:"varint,6,opt,name=rolledBack,proto3" json:"rolledBack,omitempty"`
+	RollbackError string      `protobuf:"bytes,7,opt,name=rollbackError,proto3" json:"rollbackError,omitempty"`
+}
+
+func (m *ServiceRegistration) Reset()                    { *m = ServiceRegistration{} }
+func (m *ServiceRegistration) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceRegistration) ProtoMessage()               {}
+func (*ServiceRegistration) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{24} }
+
+func (m *ServiceRegistration) GetConfig() *NodeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type RegisterMultiResult struct {
+	Ok      bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Results []*ServiceRegistration `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *RegisterMultiResult) Reset()                    { *m = RegisterMultiResult{} }
+func (m *RegisterMultiResult) String() string            { return proto1.CompactTextString(m) }
+func (*RegisterMultiResult) ProtoMessage()               {}
+func (*RegisterMultiResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{25} }
+
+func (m *RegisterMultiResult) GetResults() []*ServiceRegistration {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ServiceInfo struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port    int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Master  bool   `protobuf:"varint,4,opt,name=master,proto3" json:"master,omitempty"`
+	Nodes   uint32 `protobuf:"varint,5,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Default bool   `protobuf:"varint,6,opt,name=default,proto3" json:"default,omitempty"`
+}
+
+func (m *ServiceInfo) Reset()                    { *m = ServiceInfo{} }
+func (m *ServiceInfo) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceInfo) ProtoMessage()               {}
+func (*ServiceInfo) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{26} }
+
+type ServiceList struct {
+	Services []*ServiceInfo `protobuf:"bytes,1,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *ServiceList) Reset()                    { *m = ServiceList{} }
+func (m *ServiceList) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceList) ProtoMessage()               {}
+func (*ServiceList) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{27} }
+
+func (m *ServiceList) GetServices() []*ServiceInfo {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceReload struct {
+	Service         string   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Applied         []string `protobuf:"bytes,2,rep,name=applied" json:"applied,omitempty"`
+	RestartRequired []string `protobuf:"bytes,3,rep,name=restartRequired" json:"restartRequired,omitempty"`
+	Errors          []string `protobuf:"bytes,4,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *ServiceReload) Reset()                    { *m = ServiceReload{} }
+func (m *ServiceReload) String() string            { return proto1.CompactTextString(m) }
+func (*ServiceReload) ProtoMessage()               {}
+func (*ServiceReload) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{28} }
+
+type ConfigReloadResult struct {
+	Services []*ServiceReload `protobuf:"bytes,1,rep,name=services" json:"services,omitempty"`
+	LogLevel string           `protobuf:"bytes,2,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+}
+
+func (m *ConfigReloadResult) Reset()                    { *m = ConfigReloadResult{} }
+func (m *ConfigReloadResult) String() string            { return proto1.CompactTextString(m) }
+func (*ConfigReloadResult) ProtoMessage()               {}
+func (*ConfigReloadResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{29} }
+
+func (m *ConfigReloadResult) GetServices() []*ServiceReload {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
 type Disk struct {
 	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
 	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -197,7 +849,7 @@ type Disk struct {
 func (m *Disk) Reset()                    { *m = Disk{} }
 func (m *Disk) String() string            { return proto1.CompactTextString(m) }
 func (*Disk) ProtoMessage()               {}
-func (*Disk) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{10} }
+func (*Disk) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{30} }
 
 type NodeConfig struct {
 	Localid uint64 `protobuf:"varint,1,opt,name=localid,proto3" json:"localid,omitempty"`
@@ -207,17 +859,63 @@ type NodeConfig struct {
 func (m *NodeConfig) Reset()                    { *m = NodeConfig{} }
 func (m *NodeConfig) String() string            { return proto1.CompactTextString(m) }
 func (*NodeConfig) ProtoMessage()               {}
-func (*NodeConfig) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{11} }
+func (*NodeConfig) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{31} }
 
 type Ring struct {
-	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Ring    []byte `protobuf:"bytes,2,opt,name=ring,proto3" json:"ring,omitempty"`
+	Version int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Ring    []byte     `protobuf:"bytes,2,opt,name=ring,proto3" json:"ring,omitempty"`
+	Delta   *RingDelta `protobuf:"bytes,3,opt,name=delta" json:"delta,omitempty"`
 }
 
 func (m *Ring) Reset()                    { *m = Ring{} }
 func (m *Ring) String() string            { return proto1.CompactTextString(m) }
 func (*Ring) ProtoMessage()               {}
-func (*Ring) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{12} }
+func (*Ring) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{32} }
+
+func (m *Ring) GetDelta() *RingDelta {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+type RingDelta struct {
+	BaseVersion  int64                  `protobuf:"varint,1,opt,name=baseVersion,proto3" json:"baseVersion,omitempty"`
+	Nodes        []*Node                `protobuf:"bytes,2,rep,name=nodes" json:"nodes,omitempty"`
+	RemovedNodes []uint64               `protobuf:"varint,3,rep,packed,name=removedNodes" json:"removedNodes,omitempty"`
+	Partitions   []*PartitionAssignment `protobuf:"bytes,4,rep,name=partitions" json:"partitions,omitempty"`
+	ConfChanged  bool                   `protobuf:"varint,5,opt,name=confChanged,proto3" json:"confChanged,omitempty"`
+	Conf         []byte                 `protobuf:"bytes,6,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (m *RingDelta) Reset()                    { *m = RingDelta{} }
+func (m *RingDelta) String() string            { return proto1.CompactTextString(m) }
+func (*RingDelta) ProtoMessage()               {}
+func (*RingDelta) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{33} }
+
+func (m *RingDelta) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *RingDelta) GetPartitions() []*PartitionAssignment {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+type PartitionAssignment struct {
+	Partition uint32   `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Nodes     []uint64 `protobuf:"varint,2,rep,packed,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *PartitionAssignment) Reset()                    { *m = PartitionAssignment{} }
+func (m *PartitionAssignment) String() string            { return proto1.CompactTextString(m) }
+func (*PartitionAssignment) ProtoMessage()               {}
+func (*PartitionAssignment) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{34} }
 
 type SearchResult struct {
 	Nodes []*Node `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
@@ -226,7 +924,7 @@ type SearchResult struct {
 func (m *SearchResult) Reset()                    { *m = SearchResult{} }
 func (m *SearchResult) String() string            { return proto1.CompactTextString(m) }
 func (*SearchResult) ProtoMessage()               {}
-func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{13} }
+func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{35} }
 
 func (m *SearchResult) GetNodes() []*Node {
 	if m != nil {
@@ -235,47 +933,528 @@ func (m *SearchResult) GetNodes() []*Node {
 	return nil
 }
 
-type NodeSoftwareVersion struct {
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+type NodeQuery struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (m *NodeSoftwareVersion) Reset()                    { *m = NodeSoftwareVersion{} }
-func (m *NodeSoftwareVersion) String() string            { return proto1.CompactTextString(m) }
-func (*NodeSoftwareVersion) ProtoMessage()               {}
-func (*NodeSoftwareVersion) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{14} }
+func (m *NodeQuery) Reset()                    { *m = NodeQuery{} }
+func (m *NodeQuery) String() string            { return proto1.CompactTextString(m) }
+func (*NodeQuery) ProtoMessage()               {}
+func (*NodeQuery) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{36} }
 
-type NodeUpgrade struct {
-	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+type NodeQueryResult struct {
+	Version         int64             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Nodes           []*NodeQueryMatch `protobuf:"bytes,2,rep,name=nodes" json:"nodes,omitempty"`
+	PartitionCounts bool              `protobuf:"varint,3,opt,name=partitionCounts,proto3" json:"partitionCounts,omitempty"`
 }
 
-func (m *NodeUpgrade) Reset()                    { *m = NodeUpgrade{} }
-func (m *NodeUpgrade) String() string            { return proto1.CompactTextString(m) }
-func (*NodeUpgrade) ProtoMessage()               {}
-func (*NodeUpgrade) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{15} }
+func (m *NodeQueryResult) Reset()                    { *m = NodeQueryResult{} }
+func (m *NodeQueryResult) String() string            { return proto1.CompactTextString(m) }
+func (*NodeQueryResult) ProtoMessage()               {}
+func (*NodeQueryResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{37} }
 
-type NodeUpgradeStatus struct {
-	Status bool   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+func (m *NodeQueryResult) GetNodes() []*NodeQueryMatch {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
 }
 
-func (m *NodeUpgradeStatus) Reset()                    { *m = NodeUpgradeStatus{} }
-func (m *NodeUpgradeStatus) String() string            { return proto1.CompactTextString(m) }
-func (*NodeUpgradeStatus) ProtoMessage()               {}
-func (*NodeUpgradeStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{16} }
-
-type RingMsg struct {
-	Version  int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Ring     []byte `protobuf:"bytes,2,opt,name=ring,proto3" json:"ring,omitempty"`
-	Builder  []byte `protobuf:"bytes,3,opt,name=builder,proto3" json:"builder,omitempty"`
-	Deadline int64  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Rollback int64  `protobuf:"varint,5,opt,name=rollback,proto3" json:"rollback,omitempty"`
+type NodeQueryMatch struct {
+	Node       *Node  `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	Partitions uint64 `protobuf:"varint,2,opt,name=partitions,proto3" json:"partitions,omitempty"`
 }
 
-func (m *RingMsg) Reset()                    { *m = RingMsg{} }
+func (m *NodeQueryMatch) Reset()                    { *m = NodeQueryMatch{} }
+func (m *NodeQueryMatch) String() string            { return proto1.CompactTextString(m) }
+func (*NodeQueryMatch) ProtoMessage()               {}
+func (*NodeQueryMatch) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{38} }
+
+func (m *NodeQueryMatch) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type PartitionLookup struct {
+	Partition uint32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Previous  bool   `protobuf:"varint,2,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (m *PartitionLookup) Reset()                    { *m = PartitionLookup{} }
+func (m *PartitionLookup) String() string            { return proto1.CompactTextString(m) }
+func (*PartitionLookup) ProtoMessage()               {}
+func (*PartitionLookup) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{39} }
+
+type KeyLookup struct {
+	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Previous bool   `protobuf:"varint,2,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (m *KeyLookup) Reset()                    { *m = KeyLookup{} }
+func (m *KeyLookup) String() string            { return proto1.CompactTextString(m) }
+func (*KeyLookup) ProtoMessage()               {}
+func (*KeyLookup) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{40} }
+
+type PartitionLookupResult struct {
+	Version           int64               `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Partition         uint32              `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Replicas          []*PartitionReplica `protobuf:"bytes,3,rep,name=replicas" json:"replicas,omitempty"`
+	PreviousVersion   int64               `protobuf:"varint,4,opt,name=previousVersion,proto3" json:"previousVersion,omitempty"`
+	PreviousPartition uint32              `protobuf:"varint,5,opt,name=previousPartition,proto3" json:"previousPartition,omitempty"`
+	PreviousReplicas  []*PartitionReplica `protobuf:"bytes,6,rep,name=previousReplicas" json:"previousReplicas,omitempty"`
+}
+
+func (m *PartitionLookupResult) Reset()                    { *m = PartitionLookupResult{} }
+func (m *PartitionLookupResult) String() string            { return proto1.CompactTextString(m) }
+func (*PartitionLookupResult) ProtoMessage()               {}
+func (*PartitionLookupResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{41} }
+
+func (m *PartitionLookupResult) GetReplicas() []*PartitionReplica {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *PartitionLookupResult) GetPreviousReplicas() []*PartitionReplica {
+	if m != nil {
+		return m.PreviousReplicas
+	}
+	return nil
+}
+
+type PartitionReplica struct {
+	Id        uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
+	Meta      string   `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (m *PartitionReplica) Reset()                    { *m = PartitionReplica{} }
+func (m *PartitionReplica) String() string            { return proto1.CompactTextString(m) }
+func (*PartitionReplica) ProtoMessage()               {}
+func (*PartitionReplica) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{42} }
+
+type RingStats struct {
+	Version           int64          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Partitions        uint64         `protobuf:"varint,2,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	Replicas          int32          `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	ActiveNodes       uint32         `protobuf:"varint,4,opt,name=activeNodes,proto3" json:"activeNodes,omitempty"`
+	InactiveNodes     uint32         `protobuf:"varint,5,opt,name=inactiveNodes,proto3" json:"inactiveNodes,omitempty"`
+	ZeroCapacityNodes uint32         `protobuf:"varint,6,opt,name=zeroCapacityNodes,proto3" json:"zeroCapacityNodes,omitempty"`
+	MaxOverWeight     float64        `protobuf:"fixed64,7,opt,name=maxOverWeight,proto3" json:"maxOverWeight,omitempty"`
+	MaxUnderWeight    float64        `protobuf:"fixed64,8,opt,name=maxUnderWeight,proto3" json:"maxUnderWeight,omitempty"`
+	Nodes             []*NodeBalance `protobuf:"bytes,9,rep,name=nodes" json:"nodes,omitempty"`
+	Tiers             []*TierBalance `protobuf:"bytes,10,rep,name=tiers" json:"tiers,omitempty"`
+}
+
+func (m *RingStats) Reset()                    { *m = RingStats{} }
+func (m *RingStats) String() string            { return proto1.CompactTextString(m) }
+func (*RingStats) ProtoMessage()               {}
+func (*RingStats) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{43} }
+
+func (m *RingStats) GetNodes() []*NodeBalance {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *RingStats) GetTiers() []*TierBalance {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+type NodeBalance struct {
+	Id       uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta     string  `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Active   bool    `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Capacity uint32  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Desired  float64 `protobuf:"fixed64,5,opt,name=desired,proto3" json:"desired,omitempty"`
+	Assigned uint64  `protobuf:"varint,6,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	Weight   float64 `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *NodeBalance) Reset()                    { *m = NodeBalance{} }
+func (m *NodeBalance) String() string            { return proto1.CompactTextString(m) }
+func (*NodeBalance) ProtoMessage()               {}
+func (*NodeBalance) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{44} }
+
+type TierBalance struct {
+	Level    int32   `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Tier     string  `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	Nodes    uint32  `protobuf:"varint,3,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Capacity uint64  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Desired  float64 `protobuf:"fixed64,5,opt,name=desired,proto3" json:"desired,omitempty"`
+	Assigned uint64  `protobuf:"varint,6,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	Weight   float64 `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *TierBalance) Reset()                    { *m = TierBalance{} }
+func (m *TierBalance) String() string            { return proto1.CompactTextString(m) }
+func (*TierBalance) ProtoMessage()               {}
+func (*TierBalance) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{45} }
+
+type NodeSoftwareVersion struct {
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *NodeSoftwareVersion) Reset()                    { *m = NodeSoftwareVersion{} }
+func (m *NodeSoftwareVersion) String() string            { return proto1.CompactTextString(m) }
+func (*NodeSoftwareVersion) ProtoMessage()               {}
+func (*NodeSoftwareVersion) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{46} }
+
+type SoftwareVersions struct {
+	Versions []*SoftwareVersionGroup `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+	Failed   []*NodeVersion          `protobuf:"bytes,2,rep,name=failed" json:"failed,omitempty"`
+	Nodes    uint32                  `protobuf:"varint,3,opt,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (m *SoftwareVersions) Reset()                    { *m = SoftwareVersions{} }
+func (m *SoftwareVersions) String() string            { return proto1.CompactTextString(m) }
+func (*SoftwareVersions) ProtoMessage()               {}
+func (*SoftwareVersions) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{47} }
+
+func (m *SoftwareVersions) GetVersions() []*SoftwareVersionGroup {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *SoftwareVersions) GetFailed() []*NodeVersion {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type SoftwareVersionGroup struct {
+	Version string         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Nodes   []*NodeVersion `protobuf:"bytes,2,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *SoftwareVersionGroup) Reset()                    { *m = SoftwareVersionGroup{} }
+func (m *SoftwareVersionGroup) String() string            { return proto1.CompactTextString(m) }
+func (*SoftwareVersionGroup) ProtoMessage()               {}
+func (*SoftwareVersionGroup) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{48} }
+
+func (m *SoftwareVersionGroup) GetNodes() []*NodeVersion {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type NodeVersion struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Error   string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *NodeVersion) Reset()                    { *m = NodeVersion{} }
+func (m *NodeVersion) String() string            { return proto1.CompactTextString(m) }
+func (*NodeVersion) ProtoMessage()               {}
+func (*NodeVersion) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{49} }
+
+type UpgradeRequest struct {
+	Version       string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	BatchSize     uint32   `protobuf:"varint,2,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
+	Tier          int32    `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	HealthTimeout int32    `protobuf:"varint,4,opt,name=healthTimeout,proto3" json:"healthTimeout,omitempty"`
+	Canaries      []uint64 `protobuf:"varint,5,rep,packed,name=canaries" json:"canaries,omitempty"`
+	Soak          int32    `protobuf:"varint,6,opt,name=soak,proto3" json:"soak,omitempty"`
+}
+
+func (m *UpgradeRequest) Reset()                    { *m = UpgradeRequest{} }
+func (m *UpgradeRequest) String() string            { return proto1.CompactTextString(m) }
+func (*UpgradeRequest) ProtoMessage()               {}
+func (*UpgradeRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{50} }
+
+type UpgradeStatus struct {
+	Version   string         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	State     UpgradeState   `protobuf:"varint,2,opt,name=state,proto3,enum=proto.UpgradeState" json:"state,omitempty"`
+	BatchSize uint32         `protobuf:"varint,3,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
+	Tier      int32          `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Batch     uint32         `protobuf:"varint,5,opt,name=batch,proto3" json:"batch,omitempty"`
+	Batches   uint32         `protobuf:"varint,6,opt,name=batches,proto3" json:"batches,omitempty"`
+	Nodes     []*UpgradeNode `protobuf:"bytes,7,rep,name=nodes" json:"nodes,omitempty"`
+	Error     string         `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Started   int64          `protobuf:"varint,9,opt,name=started,proto3" json:"started,omitempty"`
+	Finished  int64          `protobuf:"varint,10,opt,name=finished,proto3" json:"finished,omitempty"`
+	SoakUntil int64          `protobuf:"varint,11,opt,name=soakUntil,proto3" json:"soakUntil,omitempty"`
+}
+
+func (m *UpgradeStatus) Reset()                    { *m = UpgradeStatus{} }
+func (m *UpgradeStatus) String() string            { return proto1.CompactTextString(m) }
+func (*UpgradeStatus) ProtoMessage()               {}
+func (*UpgradeStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{51} }
+
+func (m *UpgradeStatus) GetNodes() []*UpgradeNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type UpgradeNode struct {
+	Id              uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address         string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Domain          string           `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Batch           uint32           `protobuf:"varint,4,opt,name=batch,proto3" json:"batch,omitempty"`
+	State           UpgradeNodeState `protobuf:"varint,5,opt,name=state,proto3,enum=proto.UpgradeNodeState" json:"state,omitempty"`
+	PreviousVersion string           `protobuf:"bytes,6,opt,name=previousVersion,proto3" json:"previousVersion,omitempty"`
+	Version         string           `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	Error           string           `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Canary          bool             `protobuf:"varint,9,opt,name=canary,proto3" json:"canary,omitempty"`
+}
+
+func (m *UpgradeNode) Reset()                    { *m = UpgradeNode{} }
+func (m *UpgradeNode) String() string            { return proto1.CompactTextString(m) }
+func (*UpgradeNode) ProtoMessage()               {}
+func (*UpgradeNode) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{52} }
+
+type NodeControlRequest struct {
+	Ids             []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	Query           string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Concurrency     uint32   `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Tier            int32    `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	HealthTimeout   int32    `protobuf:"varint,5,opt,name=healthTimeout,proto3" json:"healthTimeout,omitempty"`
+	ContinueOnError bool     `protobuf:"varint,6,opt,name=continueOnError,proto3" json:"continueOnError,omitempty"`
+}
+
+func (m *NodeControlRequest) Reset()                    { *m = NodeControlRequest{} }
+func (m *NodeControlRequest) String() string            { return proto1.CompactTextString(m) }
+func (*NodeControlRequest) ProtoMessage()               {}
+func (*NodeControlRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{53} }
+
+type NodeControlResult struct {
+	Nodes   []*NodeControlStatus `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+	Failed  uint32               `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped uint32               `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (m *NodeControlResult) Reset()                    { *m = NodeControlResult{} }
+func (m *NodeControlResult) String() string            { return proto1.CompactTextString(m) }
+func (*NodeControlResult) ProtoMessage()               {}
+func (*NodeControlResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{54} }
+
+func (m *NodeControlResult) GetNodes() []*NodeControlStatus {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type NodeControlStatus struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Domain  string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Status  bool   `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Skipped bool   `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Error   string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *NodeControlStatus) Reset()                    { *m = NodeControlStatus{} }
+func (m *NodeControlStatus) String() string            { return proto1.CompactTextString(m) }
+func (*NodeControlStatus) ProtoMessage()               {}
+func (*NodeControlStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{55} }
+
+type MaintenanceRequest struct {
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason          string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration        int64  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Drain           bool   `protobuf:"varint,4,opt,name=drain,proto3" json:"drain,omitempty"`
+	DrainTarget     uint32 `protobuf:"varint,5,opt,name=drainTarget,proto3" json:"drainTarget,omitempty"`
+	DrainStep       uint32 `protobuf:"varint,6,opt,name=drainStep,proto3" json:"drainStep,omitempty"`
+	DrainInterval   int32  `protobuf:"varint,7,opt,name=drainInterval,proto3" json:"drainInterval,omitempty"`
+	RestoreCapacity bool   `protobuf:"varint,8,opt,name=restoreCapacity,proto3" json:"restoreCapacity,omitempty"`
+}
+
+func (m *MaintenanceRequest) Reset()                    { *m = MaintenanceRequest{} }
+func (m *MaintenanceRequest) String() string            { return proto1.CompactTextString(m) }
+func (*MaintenanceRequest) ProtoMessage()               {}
+func (*MaintenanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{56} }
+
+type MaintenanceStatus struct {
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason           string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Caller           string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	Started          int64  `protobuf:"varint,4,opt,name=started,proto3" json:"started,omitempty"`
+	Expires          int64  `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	Draining         bool   `protobuf:"varint,6,opt,name=draining,proto3" json:"draining,omitempty"`
+	Capacity         uint32 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	OriginalCapacity uint32 `protobuf:"varint,8,opt,name=originalCapacity,proto3" json:"originalCapacity,omitempty"`
+	DrainTarget      uint32 `protobuf:"varint,9,opt,name=drainTarget,proto3" json:"drainTarget,omitempty"`
+	DrainStep        uint32 `protobuf:"varint,10,opt,name=drainStep,proto3" json:"drainStep,omitempty"`
+	DrainInterval    int32  `protobuf:"varint,11,opt,name=drainInterval,proto3" json:"drainInterval,omitempty"`
+	RestoreCapacity  bool   `protobuf:"varint,12,opt,name=restoreCapacity,proto3" json:"restoreCapacity,omitempty"`
+}
+
+func (m *MaintenanceStatus) Reset()                    { *m = MaintenanceStatus{} }
+func (m *MaintenanceStatus) String() string            { return proto1.CompactTextString(m) }
+func (*MaintenanceStatus) ProtoMessage()               {}
+func (*MaintenanceStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{57} }
+
+type MaintenanceList struct {
+	Nodes []*MaintenanceStatus `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *MaintenanceList) Reset()                    { *m = MaintenanceList{} }
+func (m *MaintenanceList) String() string            { return proto1.CompactTextString(m) }
+func (*MaintenanceList) ProtoMessage()               {}
+func (*MaintenanceList) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{58} }
+
+func (m *MaintenanceList) GetNodes() []*MaintenanceStatus {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type RampRequest struct {
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target          uint32 `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	Step            uint32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	Interval        int32  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	WaitConverged   bool   `protobuf:"varint,5,opt,name=waitConverged,proto3" json:"waitConverged,omitempty"`
+	ConvergeTimeout int32  `protobuf:"varint,6,opt,name=convergeTimeout,proto3" json:"convergeTimeout,omitempty"`
+}
+
+func (m *RampRequest) Reset()                    { *m = RampRequest{} }
+func (m *RampRequest) String() string            { return proto1.CompactTextString(m) }
+func (*RampRequest) ProtoMessage()               {}
+func (*RampRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{59} }
+
+type RampStatus struct {
+	Id            uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         RampState `protobuf:"varint,2,opt,name=state,proto3,enum=proto.RampState" json:"state,omitempty"`
+	Start         uint32    `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Target        uint32    `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`
+	Step          uint32    `protobuf:"varint,5,opt,name=step,proto3" json:"step,omitempty"`
+	Interval      int32     `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	WaitConverged bool      `protobuf:"varint,7,opt,name=waitConverged,proto3" json:"waitConverged,omitempty"`
+	Capacity      uint32    `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Steps         uint32    `protobuf:"varint,9,opt,name=steps,proto3" json:"steps,omitempty"`
+	StepsDone     uint32    `protobuf:"varint,10,opt,name=stepsDone,proto3" json:"stepsDone,omitempty"`
+	RingVersion   int64     `protobuf:"varint,11,opt,name=ringVersion,proto3" json:"ringVersion,omitempty"`
+	NextStep      int64     `protobuf:"varint,12,opt,name=nextStep,proto3" json:"nextStep,omitempty"`
+	Error         string    `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	Caller        string    `protobuf:"bytes,14,opt,name=caller,proto3" json:"caller,omitempty"`
+	Started       int64     `protobuf:"varint,15,opt,name=started,proto3" json:"started,omitempty"`
+	Finished      int64     `protobuf:"varint,16,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (m *RampStatus) Reset()                    { *m = RampStatus{} }
+func (m *RampStatus) String() string            { return proto1.CompactTextString(m) }
+func (*RampStatus) ProtoMessage()               {}
+func (*RampStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{60} }
+
+type RampList struct {
+	Ramps []*RampStatus `protobuf:"bytes,1,rep,name=ramps" json:"ramps,omitempty"`
+}
+
+func (m *RampList) Reset()                    { *m = RampList{} }
+func (m *RampList) String() string            { return proto1.CompactTextString(m) }
+func (*RampList) ProtoMessage()               {}
+func (*RampList) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{61} }
+
+func (m *RampList) GetRamps() []*RampStatus {
+	if m != nil {
+		return m.Ramps
+	}
+	return nil
+}
+
+type DecommissionRequest struct {
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ramp            bool   `protobuf:"varint,2,opt,name=ramp,proto3" json:"ramp,omitempty"`
+	RampStep        uint32 `protobuf:"varint,3,opt,name=rampStep,proto3" json:"rampStep,omitempty"`
+	RampInterval    int32  `protobuf:"varint,4,opt,name=rampInterval,proto3" json:"rampInterval,omitempty"`
+	ConvergeTimeout int32  `protobuf:"varint,5,opt,name=convergeTimeout,proto3" json:"convergeTimeout,omitempty"`
+	StopBackend     bool   `protobuf:"varint,6,opt,name=stopBackend,proto3" json:"stopBackend,omitempty"`
+}
+
+func (m *DecommissionRequest) Reset()                    { *m = DecommissionRequest{} }
+func (m *DecommissionRequest) String() string            { return proto1.CompactTextString(m) }
+func (*DecommissionRequest) ProtoMessage()               {}
+func (*DecommissionRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{62} }
+
+type DecommissionStatus struct {
+	Id              uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address         string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	State           DecommissionState `protobuf:"varint,3,opt,name=state,proto3,enum=proto.DecommissionState" json:"state,omitempty"`
+	Failed          bool              `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error           string            `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Ramp            bool              `protobuf:"varint,6,opt,name=ramp,proto3" json:"ramp,omitempty"`
+	RampStep        uint32            `protobuf:"varint,7,opt,name=rampStep,proto3" json:"rampStep,omitempty"`
+	RampInterval    int32             `protobuf:"varint,8,opt,name=rampInterval,proto3" json:"rampInterval,omitempty"`
+	ConvergeTimeout int32             `protobuf:"varint,9,opt,name=convergeTimeout,proto3" json:"convergeTimeout,omitempty"`
+	StopBackend     bool              `protobuf:"varint,10,opt,name=stopBackend,proto3" json:"stopBackend,omitempty"`
+	RingVersion     int64             `protobuf:"varint,11,opt,name=ringVersion,proto3" json:"ringVersion,omitempty"`
+	Caller          string            `protobuf:"bytes,12,opt,name=caller,proto3" json:"caller,omitempty"`
+	Started         int64             `protobuf:"varint,13,opt,name=started,proto3" json:"started,omitempty"`
+	Updated         int64             `protobuf:"varint,14,opt,name=updated,proto3" json:"updated,omitempty"`
+	Finished        int64             `protobuf:"varint,15,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (m *DecommissionStatus) Reset()                    { *m = DecommissionStatus{} }
+func (m *DecommissionStatus) String() string            { return proto1.CompactTextString(m) }
+func (*DecommissionStatus) ProtoMessage()               {}
+func (*DecommissionStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{63} }
+
+type DecommissionList struct {
+	Nodes []*DecommissionStatus `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *DecommissionList) Reset()                    { *m = DecommissionList{} }
+func (m *DecommissionList) String() string            { return proto1.CompactTextString(m) }
+func (*DecommissionList) ProtoMessage()               {}
+func (*DecommissionList) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{64} }
+
+func (m *DecommissionList) GetNodes() []*DecommissionStatus {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type NodeUpgrade struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *NodeUpgrade) Reset()                    { *m = NodeUpgrade{} }
+func (m *NodeUpgrade) String() string            { return proto1.CompactTextString(m) }
+func (*NodeUpgrade) ProtoMessage()               {}
+func (*NodeUpgrade) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{65} }
+
+type NodeUpgradeStatus struct {
+	Status bool   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *NodeUpgradeStatus) Reset()                    { *m = NodeUpgradeStatus{} }
+func (m *NodeUpgradeStatus) String() string            { return proto1.CompactTextString(m) }
+func (*NodeUpgradeStatus) ProtoMessage()               {}
+func (*NodeUpgradeStatus) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{66} }
+
+type RingMsg struct {
+	Version  int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Ring     []byte `protobuf:"bytes,2,opt,name=ring,proto3" json:"ring,omitempty"`
+	Builder  []byte `protobuf:"bytes,3,opt,name=builder,proto3" json:"builder,omitempty"`
+	Deadline int64  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Rollback int64  `protobuf:"varint,5,opt,name=rollback,proto3" json:"rollback,omitempty"`
+}
+
+func (m *RingMsg) Reset()                    { *m = RingMsg{} }
 func (m *RingMsg) String() string            { return proto1.CompactTextString(m) }
 func (*RingMsg) ProtoMessage()               {}
-func (*RingMsg) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{17} }
+func (*RingMsg) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{67} }
 
 type StoreResult struct {
 	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -287,7 +1466,7 @@ type StoreResult struct {
 func (m *StoreResult) Reset()                    { *m = StoreResult{} }
 func (m *StoreResult) String() string            { return proto1.CompactTextString(m) }
 func (*StoreResult) ProtoMessage()               {}
-func (*StoreResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{18} }
+func (*StoreResult) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{68} }
 
 type StatusRequest struct {
 	Ring    bool `protobuf:"varint,1,opt,name=ring,proto3" json:"ring,omitempty"`
@@ -297,7 +1476,7 @@ type StatusRequest struct {
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (m *StatusRequest) String() string            { return proto1.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{19} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{69} }
 
 type StatusMsg struct {
 	Version      int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -309,7 +1488,7 @@ type StatusMsg struct {
 func (m *StatusMsg) Reset()                    { *m = StatusMsg{} }
 func (m *StatusMsg) String() string            { return proto1.CompactTextString(m) }
 func (*StatusMsg) ProtoMessage()               {}
-func (*StatusMsg) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{20} }
+func (*StatusMsg) Descriptor() ([]byte, []int) { return fileDescriptorSyndicateApi, []int{70} }
 
 func init() {
 	proto1.RegisterType((*EmptyMsg)(nil), "proto.EmptyMsg")
@@ -320,19 +1499,75 @@ func init() {
 	proto1.RegisterType((*RingConf)(nil), "proto.RingConf")
 	proto1.RegisterType((*Conf)(nil), "proto.Conf")
 	proto1.RegisterType((*SubscriberID)(nil), "proto.SubscriberID")
+	proto1.RegisterType((*RingEventFilter)(nil), "proto.RingEventFilter")
+	proto1.RegisterType((*RingEvent)(nil), "proto.RingEvent")
+	proto1.RegisterType((*SubscriberList)(nil), "proto.SubscriberList")
+	proto1.RegisterType((*SubscriberInfo)(nil), "proto.SubscriberInfo")
 	proto1.RegisterType((*RegisterRequest)(nil), "proto.RegisterRequest")
+	proto1.RegisterType((*ReplaceNodeRequest)(nil), "proto.ReplaceNodeRequest")
 	proto1.RegisterType((*HardwareProfile)(nil), "proto.HardwareProfile")
+	proto1.RegisterType((*NodeHardware)(nil), "proto.NodeHardware")
+	proto1.RegisterType((*NodeStatusReport)(nil), "proto.NodeStatusReport")
+	proto1.RegisterType((*CapacityProposal)(nil), "proto.CapacityProposal")
+	proto1.RegisterType((*CapacityPolicyStatus)(nil), "proto.CapacityPolicyStatus")
+	proto1.RegisterType((*SimulatedNodes)(nil), "proto.SimulatedNodes")
+	proto1.RegisterType((*SimulateRequest)(nil), "proto.SimulateRequest")
+	proto1.RegisterType((*NodeProjection)(nil), "proto.NodeProjection")
+	proto1.RegisterType((*SimulateResult)(nil), "proto.SimulateResult")
+	proto1.RegisterType((*RegisterMultiRequest)(nil), "proto.RegisterMultiRequest")
+	proto1.RegisterType((*ServiceRegistration)(nil), "proto.ServiceRegistration")
+	proto1.RegisterType((*RegisterMultiResult)(nil), "proto.RegisterMultiResult")
+	proto1.RegisterType((*ServiceInfo)(nil), "proto.ServiceInfo")
+	proto1.RegisterType((*ServiceList)(nil), "proto.ServiceList")
+	proto1.RegisterType((*ServiceReload)(nil), "proto.ServiceReload")
+	proto1.RegisterType((*ConfigReloadResult)(nil), "proto.ConfigReloadResult")
 	proto1.RegisterType((*Disk)(nil), "proto.Disk")
 	proto1.RegisterType((*NodeConfig)(nil), "proto.NodeConfig")
 	proto1.RegisterType((*Ring)(nil), "proto.Ring")
+	proto1.RegisterType((*RingDelta)(nil), "proto.RingDelta")
+	proto1.RegisterType((*PartitionAssignment)(nil), "proto.PartitionAssignment")
 	proto1.RegisterType((*SearchResult)(nil), "proto.SearchResult")
+	proto1.RegisterType((*NodeQuery)(nil), "proto.NodeQuery")
+	proto1.RegisterType((*NodeQueryResult)(nil), "proto.NodeQueryResult")
+	proto1.RegisterType((*NodeQueryMatch)(nil), "proto.NodeQueryMatch")
+	proto1.RegisterType((*PartitionLookup)(nil), "proto.PartitionLookup")
+	proto1.RegisterType((*KeyLookup)(nil), "proto.KeyLookup")
+	proto1.RegisterType((*PartitionLookupResult)(nil), "proto.PartitionLookupResult")
+	proto1.RegisterType((*PartitionReplica)(nil), "proto.PartitionReplica")
+	proto1.RegisterType((*RingStats)(nil), "proto.RingStats")
+	proto1.RegisterType((*NodeBalance)(nil), "proto.NodeBalance")
+	proto1.RegisterType((*TierBalance)(nil), "proto.TierBalance")
 	proto1.RegisterType((*NodeSoftwareVersion)(nil), "proto.NodeSoftwareVersion")
+	proto1.RegisterType((*SoftwareVersions)(nil), "proto.SoftwareVersions")
+	proto1.RegisterType((*SoftwareVersionGroup)(nil), "proto.SoftwareVersionGroup")
+	proto1.RegisterType((*NodeVersion)(nil), "proto.NodeVersion")
+	proto1.RegisterType((*UpgradeRequest)(nil), "proto.UpgradeRequest")
+	proto1.RegisterType((*UpgradeStatus)(nil), "proto.UpgradeStatus")
+	proto1.RegisterType((*UpgradeNode)(nil), "proto.UpgradeNode")
+	proto1.RegisterType((*NodeControlRequest)(nil), "proto.NodeControlRequest")
+	proto1.RegisterType((*NodeControlResult)(nil), "proto.NodeControlResult")
+	proto1.RegisterType((*NodeControlStatus)(nil), "proto.NodeControlStatus")
+	proto1.RegisterType((*MaintenanceRequest)(nil), "proto.MaintenanceRequest")
+	proto1.RegisterType((*MaintenanceStatus)(nil), "proto.MaintenanceStatus")
+	proto1.RegisterType((*MaintenanceList)(nil), "proto.MaintenanceList")
+	proto1.RegisterType((*RampRequest)(nil), "proto.RampRequest")
+	proto1.RegisterType((*RampStatus)(nil), "proto.RampStatus")
+	proto1.RegisterType((*RampList)(nil), "proto.RampList")
+	proto1.RegisterType((*DecommissionRequest)(nil), "proto.DecommissionRequest")
+	proto1.RegisterType((*DecommissionStatus)(nil), "proto.DecommissionStatus")
+	proto1.RegisterType((*DecommissionList)(nil), "proto.DecommissionList")
 	proto1.RegisterType((*NodeUpgrade)(nil), "proto.NodeUpgrade")
 	proto1.RegisterType((*NodeUpgradeStatus)(nil), "proto.NodeUpgradeStatus")
 	proto1.RegisterType((*RingMsg)(nil), "proto.RingMsg")
 	proto1.RegisterType((*StoreResult)(nil), "proto.StoreResult")
 	proto1.RegisterType((*StatusRequest)(nil), "proto.StatusRequest")
 	proto1.RegisterType((*StatusMsg)(nil), "proto.StatusMsg")
+	proto1.RegisterEnum("proto.RingEventType", RingEventType_name, RingEventType_value)
+	proto1.RegisterEnum("proto.CapacityProposalState", CapacityProposalState_name, CapacityProposalState_value)
+	proto1.RegisterEnum("proto.UpgradeState", UpgradeState_name, UpgradeState_value)
+	proto1.RegisterEnum("proto.UpgradeNodeState", UpgradeNodeState_name, UpgradeNodeState_value)
+	proto1.RegisterEnum("proto.RampState", RampState_name, RampState_value)
+	proto1.RegisterEnum("proto.DecommissionState", DecommissionState_name, DecommissionState_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -360,10 +1595,47 @@ type SyndicateClient interface {
 	GetNodeConfig(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RingConf, error)
 	GetNodeSoftwareVersion(ctx context.Context, in *Node, opts ...grpc.CallOption) (*NodeSoftwareVersion, error)
 	NodeUpgradeSoftwareVersion(ctx context.Context, in *NodeUpgrade, opts ...grpc.CallOption) (*NodeUpgradeStatus, error)
+	GetAllSoftwareVersions(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*SoftwareVersions, error)
+	UpgradeCluster(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeStatus, error)
+	GetUpgradeStatus(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error)
+	WatchUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (Syndicate_WatchUpgradeClient, error)
+	PauseUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error)
+	ResumeUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error)
+	AbortUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error)
+	StartNodes(ctx context.Context, in *NodeControlRequest, opts ...grpc.CallOption) (*NodeControlResult, error)
+	StopNodes(ctx context.Context, in *NodeControlRequest, opts ...grpc.CallOption) (*NodeControlResult, error)
+	RestartNodes(ctx context.Context, in *NodeControlRequest, opts ...grpc.CallOption) (*NodeControlResult, error)
+	SetMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceStatus, error)
+	ClearMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceStatus, error)
+	ListMaintenance(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*MaintenanceList, error)
+	RampCapacity(ctx context.Context, in *RampRequest, opts ...grpc.CallOption) (*RampStatus, error)
+	GetRampStatus(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*RampList, error)
+	PauseRamp(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RampStatus, error)
+	ResumeRamp(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RampStatus, error)
+	AbortRamp(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RampStatus, error)
+	DecommissionNode(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionStatus, error)
+	GetDecommissionStatus(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*DecommissionList, error)
 	SearchNodes(ctx context.Context, in *Node, opts ...grpc.CallOption) (*SearchResult, error)
+	QueryNodes(ctx context.Context, in *NodeQuery, opts ...grpc.CallOption) (*NodeQueryResult, error)
+	LookupPartition(ctx context.Context, in *PartitionLookup, opts ...grpc.CallOption) (*PartitionLookupResult, error)
+	LookupKey(ctx context.Context, in *KeyLookup, opts ...grpc.CallOption) (*PartitionLookupResult, error)
+	GetRingStats(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*RingStats, error)
 	GetRing(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*Ring, error)
 	GetRingStream(ctx context.Context, in *SubscriberID, opts ...grpc.CallOption) (Syndicate_GetRingStreamClient, error)
+	WatchRingEvents(ctx context.Context, in *RingEventFilter, opts ...grpc.CallOption) (Syndicate_WatchRingEventsClient, error)
+	ListSubscribers(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*SubscriberList, error)
+	DisconnectSubscriber(ctx context.Context, in *SubscriberID, opts ...grpc.CallOption) (*EmptyMsg, error)
 	RegisterNode(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*NodeConfig, error)
+	ReplaceNode(ctx context.Context, in *ReplaceNodeRequest, opts ...grpc.CallOption) (*NodeConfig, error)
+	GetNodeHardware(ctx context.Context, in *Node, opts ...grpc.CallOption) (*NodeHardware, error)
+	ReportNodeStatus(ctx context.Context, in *NodeStatusReport, opts ...grpc.CallOption) (*RingStatus, error)
+	GetCapacityPolicy(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*CapacityPolicyStatus, error)
+	ApproveCapacityProposal(ctx context.Context, in *Node, opts ...grpc.CallOption) (*CapacityProposal, error)
+	RejectCapacityProposal(ctx context.Context, in *Node, opts ...grpc.CallOption) (*CapacityProposal, error)
+	SimulateRing(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResult, error)
+	ListServices(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*ServiceList, error)
+	RegisterNodeMulti(ctx context.Context, in *RegisterMultiRequest, opts ...grpc.CallOption) (*RegisterMultiResult, error)
+	ReloadConfig(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*ConfigReloadResult, error)
 }
 
 type syndicateClient struct {
@@ -500,30 +1772,39 @@ func (c *syndicateClient) NodeUpgradeSoftwareVersion(ctx context.Context, in *No
 	return out, nil
 }
 
-func (c *syndicateClient) SearchNodes(ctx context.Context, in *Node, opts ...grpc.CallOption) (*SearchResult, error) {
-	out := new(SearchResult)
-	err := grpc.Invoke(ctx, "/proto.Syndicate/SearchNodes", in, out, c.cc, opts...)
+func (c *syndicateClient) GetAllSoftwareVersions(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*SoftwareVersions, error) {
+	out := new(SoftwareVersions)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetAllSoftwareVersions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *syndicateClient) GetRing(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*Ring, error) {
-	out := new(Ring)
-	err := grpc.Invoke(ctx, "/proto.Syndicate/GetRing", in, out, c.cc, opts...)
+func (c *syndicateClient) UpgradeCluster(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeStatus, error) {
+	out := new(UpgradeStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/UpgradeCluster", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *syndicateClient) GetRingStream(ctx context.Context, in *SubscriberID, opts ...grpc.CallOption) (Syndicate_GetRingStreamClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Syndicate_serviceDesc.Streams[0], c.cc, "/proto.Syndicate/GetRingStream", opts...)
+func (c *syndicateClient) GetUpgradeStatus(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error) {
+	out := new(UpgradeStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetUpgradeStatus", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &syndicateGetRingStreamClient{stream}
+	return out, nil
+}
+
+func (c *syndicateClient) WatchUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (Syndicate_WatchUpgradeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Syndicate_serviceDesc.Streams[0], c.cc, "/proto.Syndicate/WatchUpgrade", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &syndicateWatchUpgradeClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -533,1813 +1814,16388 @@ func (c *syndicateClient) GetRingStream(ctx context.Context, in *SubscriberID, o
 	return x, nil
 }
 
-type Syndicate_GetRingStreamClient interface {
-	Recv() (*Ring, error)
+type Syndicate_WatchUpgradeClient interface {
+	Recv() (*UpgradeStatus, error)
 	grpc.ClientStream
 }
 
-type syndicateGetRingStreamClient struct {
+type syndicateWatchUpgradeClient struct {
 	grpc.ClientStream
 }
 
-func (x *syndicateGetRingStreamClient) Recv() (*Ring, error) {
-	m := new(Ring)
+func (x *syndicateWatchUpgradeClient) Recv() (*UpgradeStatus, error) {
+	m := new(UpgradeStatus)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *syndicateClient) RegisterNode(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*NodeConfig, error) {
-	out := new(NodeConfig)
-	err := grpc.Invoke(ctx, "/proto.Syndicate/RegisterNode", in, out, c.cc, opts...)
+func (c *syndicateClient) PauseUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error) {
+	out := new(UpgradeStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/PauseUpgrade", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Syndicate service
-
-type SyndicateServer interface {
-	AddNode(context.Context, *Node) (*RingStatus, error)
-	RemoveNode(context.Context, *Node) (*RingStatus, error)
-	ModNode(context.Context, *ModifyMsg) (*RingStatus, error)
-	SetConf(context.Context, *Conf) (*RingStatus, error)
-	SetReplicas(context.Context, *RingOpts) (*RingStatus, error)
-	SetActive(context.Context, *Node) (*RingStatus, error)
-	SetCapacity(context.Context, *Node) (*RingStatus, error)
-	ReplaceAddresses(context.Context, *Node) (*RingStatus, error)
-	ReplaceTiers(context.Context, *Node) (*RingStatus, error)
-	GetVersion(context.Context, *EmptyMsg) (*RingStatus, error)
-	GetGlobalConfig(context.Context, *EmptyMsg) (*RingConf, error)
-	GetNodeConfig(context.Context, *Node) (*RingConf, error)
-	GetNodeSoftwareVersion(context.Context, *Node) (*NodeSoftwareVersion, error)
-	NodeUpgradeSoftwareVersion(context.Context, *NodeUpgrade) (*NodeUpgradeStatus, error)
-	SearchNodes(context.Context, *Node) (*SearchResult, error)
-	GetRing(context.Context, *EmptyMsg) (*Ring, error)
-	GetRingStream(*SubscriberID, Syndicate_GetRingStreamServer) error
-	RegisterNode(context.Context, *RegisterRequest) (*NodeConfig, error)
-}
-
-func RegisterSyndicateServer(s *grpc.Server, srv SyndicateServer) {
-	s.RegisterService(&_Syndicate_serviceDesc, srv)
+func (c *syndicateClient) ResumeUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error) {
+	out := new(UpgradeStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ResumeUpgrade", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Syndicate_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) AbortUpgrade(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*UpgradeStatus, error) {
+	out := new(UpgradeStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/AbortUpgrade", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).AddNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/AddNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).AddNode(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) StartNodes(ctx context.Context, in *NodeControlRequest, opts ...grpc.CallOption) (*NodeControlResult, error) {
+	out := new(NodeControlResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/StartNodes", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).RemoveNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/RemoveNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).RemoveNode(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_ModNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) StopNodes(ctx context.Context, in *NodeControlRequest, opts ...grpc.CallOption) (*NodeControlResult, error) {
+	out := new(NodeControlResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/StopNodes", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).ModNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/ModNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).ModNode(ctx, req.(*ModifyMsg))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_SetConf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Conf)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) RestartNodes(ctx context.Context, in *NodeControlRequest, opts ...grpc.CallOption) (*NodeControlResult, error) {
+	out := new(NodeControlResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/RestartNodes", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).SetConf(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/SetConf",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).SetConf(ctx, req.(*Conf))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_SetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RingOpts)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) SetMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceStatus, error) {
+	out := new(MaintenanceStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/SetMaintenance", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).SetReplicas(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/SetReplicas",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).SetReplicas(ctx, req.(*RingOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_SetActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) ClearMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceStatus, error) {
+	out := new(MaintenanceStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ClearMaintenance", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).SetActive(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/SetActive",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).SetActive(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_SetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) ListMaintenance(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*MaintenanceList, error) {
+	out := new(MaintenanceList)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ListMaintenance", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).SetCapacity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/SetCapacity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).SetCapacity(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_ReplaceAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) RampCapacity(ctx context.Context, in *RampRequest, opts ...grpc.CallOption) (*RampStatus, error) {
+	out := new(RampStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/RampCapacity", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).ReplaceAddresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/ReplaceAddresses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).ReplaceAddresses(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_ReplaceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) GetRampStatus(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*RampList, error) {
+	out := new(RampList)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetRampStatus", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).ReplaceTiers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/ReplaceTiers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).ReplaceTiers(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) PauseRamp(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RampStatus, error) {
+	out := new(RampStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/PauseRamp", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).GetVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/GetVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).GetVersion(ctx, req.(*EmptyMsg))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_GetGlobalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) ResumeRamp(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RampStatus, error) {
+	out := new(RampStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ResumeRamp", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).GetGlobalConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/GetGlobalConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).GetGlobalConfig(ctx, req.(*EmptyMsg))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_GetNodeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) AbortRamp(ctx context.Context, in *Node, opts ...grpc.CallOption) (*RampStatus, error) {
+	out := new(RampStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/AbortRamp", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).GetNodeConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/GetNodeConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).GetNodeConfig(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_GetNodeSoftwareVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) DecommissionNode(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionStatus, error) {
+	out := new(DecommissionStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/DecommissionNode", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).GetNodeSoftwareVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/GetNodeSoftwareVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).GetNodeSoftwareVersion(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_NodeUpgradeSoftwareVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeUpgrade)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) GetDecommissionStatus(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*DecommissionList, error) {
+	out := new(DecommissionList)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetDecommissionStatus", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).NodeUpgradeSoftwareVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/NodeUpgradeSoftwareVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).NodeUpgradeSoftwareVersion(ctx, req.(*NodeUpgrade))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_SearchNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Node)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) SearchNodes(ctx context.Context, in *Node, opts ...grpc.CallOption) (*SearchResult, error) {
+	out := new(SearchResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/SearchNodes", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).SearchNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/SearchNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).SearchNodes(ctx, req.(*Node))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_GetRing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) QueryNodes(ctx context.Context, in *NodeQuery, opts ...grpc.CallOption) (*NodeQueryResult, error) {
+	out := new(NodeQueryResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/QueryNodes", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).GetRing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/GetRing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).GetRing(ctx, req.(*EmptyMsg))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Syndicate_GetRingStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscriberID)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *syndicateClient) LookupPartition(ctx context.Context, in *PartitionLookup, opts ...grpc.CallOption) (*PartitionLookupResult, error) {
+	out := new(PartitionLookupResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/LookupPartition", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SyndicateServer).GetRingStream(m, &syndicateGetRingStreamServer{stream})
+	return out, nil
 }
 
-type Syndicate_GetRingStreamServer interface {
-	Send(*Ring) error
-	grpc.ServerStream
+func (c *syndicateClient) LookupKey(ctx context.Context, in *KeyLookup, opts ...grpc.CallOption) (*PartitionLookupResult, error) {
+	out := new(PartitionLookupResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/LookupKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type syndicateGetRingStreamServer struct {
-	grpc.ServerStream
+func (c *syndicateClient) GetRingStats(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*RingStats, error) {
+	out := new(RingStats)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetRingStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *syndicateGetRingStreamServer) Send(m *Ring) error {
-	return x.ServerStream.SendMsg(m)
+func (c *syndicateClient) GetRing(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*Ring, error) {
+	out := new(Ring)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetRing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Syndicate_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) GetRingStream(ctx context.Context, in *SubscriberID, opts ...grpc.CallOption) (Syndicate_GetRingStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Syndicate_serviceDesc.Streams[1], c.cc, "/proto.Syndicate/GetRingStream", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SyndicateServer).RegisterNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Syndicate/RegisterNode",
+	x := &syndicateGetRingStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SyndicateServer).RegisterNode(ctx, req.(*RegisterRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-var _Syndicate_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.Syndicate",
-	HandlerType: (*SyndicateServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddNode",
-			Handler:    _Syndicate_AddNode_Handler,
-		},
-		{
-			MethodName: "RemoveNode",
-			Handler:    _Syndicate_RemoveNode_Handler,
-		},
-		{
-			MethodName: "ModNode",
-			Handler:    _Syndicate_ModNode_Handler,
-		},
-		{
-			MethodName: "SetConf",
-			Handler:    _Syndicate_SetConf_Handler,
-		},
-		{
-			MethodName: "SetReplicas",
-			Handler:    _Syndicate_SetReplicas_Handler,
-		},
-		{
-			MethodName: "SetActive",
-			Handler:    _Syndicate_SetActive_Handler,
-		},
-		{
-			MethodName: "SetCapacity",
-			Handler:    _Syndicate_SetCapacity_Handler,
-		},
-		{
-			MethodName: "ReplaceAddresses",
-			Handler:    _Syndicate_ReplaceAddresses_Handler,
-		},
-		{
-			MethodName: "ReplaceTiers",
-			Handler:    _Syndicate_ReplaceTiers_Handler,
-		},
-		{
-			MethodName: "GetVersion",
-			Handler:    _Syndicate_GetVersion_Handler,
-		},
-		{
-			MethodName: "GetGlobalConfig",
-			Handler:    _Syndicate_GetGlobalConfig_Handler,
-		},
-		{
-			MethodName: "GetNodeConfig",
-			Handler:    _Syndicate_GetNodeConfig_Handler,
-		},
-		{
-			MethodName: "GetNodeSoftwareVersion",
-			Handler:    _Syndicate_GetNodeSoftwareVersion_Handler,
-		},
-		{
-			MethodName: "NodeUpgradeSoftwareVersion",
-			Handler:    _Syndicate_NodeUpgradeSoftwareVersion_Handler,
-		},
-		{
-			MethodName: "SearchNodes",
-			Handler:    _Syndicate_SearchNodes_Handler,
-		},
-		{
-			MethodName: "GetRing",
-			Handler:    _Syndicate_GetRing_Handler,
-		},
-		{
-			MethodName: "RegisterNode",
-			Handler:    _Syndicate_RegisterNode_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetRingStream",
-			Handler:       _Syndicate_GetRingStream_Handler,
-			ServerStreams: true,
-		},
-	},
+type Syndicate_GetRingStreamClient interface {
+	Recv() (*Ring, error)
+	grpc.ClientStream
 }
 
-// Client API for RingDist service
+type syndicateGetRingStreamClient struct {
+	grpc.ClientStream
+}
 
-type RingDistClient interface {
-	Store(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error)
-	Revert(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error)
-	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusMsg, error)
-	Setup(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error)
+func (x *syndicateGetRingStreamClient) Recv() (*Ring, error) {
+	m := new(Ring)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type ringDistClient struct {
-	cc *grpc.ClientConn
+func (c *syndicateClient) WatchRingEvents(ctx context.Context, in *RingEventFilter, opts ...grpc.CallOption) (Syndicate_WatchRingEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Syndicate_serviceDesc.Streams[2], c.cc, "/proto.Syndicate/WatchRingEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &syndicateWatchRingEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func NewRingDistClient(cc *grpc.ClientConn) RingDistClient {
-	return &ringDistClient{cc}
+type Syndicate_WatchRingEventsClient interface {
+	Recv() (*RingEvent, error)
+	grpc.ClientStream
 }
 
-func (c *ringDistClient) Store(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error) {
-	out := new(StoreResult)
-	err := grpc.Invoke(ctx, "/proto.RingDist/Store", in, out, c.cc, opts...)
+type syndicateWatchRingEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *syndicateWatchRingEventsClient) Recv() (*RingEvent, error) {
+	m := new(RingEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *syndicateClient) ListSubscribers(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*SubscriberList, error) {
+	out := new(SubscriberList)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ListSubscribers", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ringDistClient) Revert(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error) {
-	out := new(StoreResult)
-	err := grpc.Invoke(ctx, "/proto.RingDist/Revert", in, out, c.cc, opts...)
+func (c *syndicateClient) DisconnectSubscriber(ctx context.Context, in *SubscriberID, opts ...grpc.CallOption) (*EmptyMsg, error) {
+	out := new(EmptyMsg)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/DisconnectSubscriber", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ringDistClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusMsg, error) {
-	out := new(StatusMsg)
-	err := grpc.Invoke(ctx, "/proto.RingDist/Status", in, out, c.cc, opts...)
+func (c *syndicateClient) RegisterNode(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*NodeConfig, error) {
+	out := new(NodeConfig)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/RegisterNode", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ringDistClient) Setup(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error) {
-	out := new(StoreResult)
-	err := grpc.Invoke(ctx, "/proto.RingDist/Setup", in, out, c.cc, opts...)
+func (c *syndicateClient) ReplaceNode(ctx context.Context, in *ReplaceNodeRequest, opts ...grpc.CallOption) (*NodeConfig, error) {
+	out := new(NodeConfig)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ReplaceNode", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for RingDist service
-
-type RingDistServer interface {
-	Store(context.Context, *RingMsg) (*StoreResult, error)
-	Revert(context.Context, *RingMsg) (*StoreResult, error)
-	Status(context.Context, *StatusRequest) (*StatusMsg, error)
-	Setup(context.Context, *RingMsg) (*StoreResult, error)
+func (c *syndicateClient) GetNodeHardware(ctx context.Context, in *Node, opts ...grpc.CallOption) (*NodeHardware, error) {
+	out := new(NodeHardware)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetNodeHardware", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterRingDistServer(s *grpc.Server, srv RingDistServer) {
-	s.RegisterService(&_RingDist_serviceDesc, srv)
+func (c *syndicateClient) ReportNodeStatus(ctx context.Context, in *NodeStatusReport, opts ...grpc.CallOption) (*RingStatus, error) {
+	out := new(RingStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ReportNodeStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _RingDist_Store_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RingMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) GetCapacityPolicy(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*CapacityPolicyStatus, error) {
+	out := new(CapacityPolicyStatus)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/GetCapacityPolicy", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RingDistServer).Store(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.RingDist/Store",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RingDistServer).Store(ctx, req.(*RingMsg))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _RingDist_Revert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RingMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) ApproveCapacityProposal(ctx context.Context, in *Node, opts ...grpc.CallOption) (*CapacityProposal, error) {
+	out := new(CapacityProposal)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ApproveCapacityProposal", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RingDistServer).Revert(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.RingDist/Revert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RingDistServer).Revert(ctx, req.(*RingMsg))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _RingDist_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatusRequest)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) RejectCapacityProposal(ctx context.Context, in *Node, opts ...grpc.CallOption) (*CapacityProposal, error) {
+	out := new(CapacityProposal)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/RejectCapacityProposal", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RingDistServer).Status(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.RingDist/Status",
+	return out, nil
+}
+
+func (c *syndicateClient) SimulateRing(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResult, error) {
+	out := new(SimulateResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/SimulateRing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RingDistServer).Status(ctx, req.(*StatusRequest))
+	return out, nil
+}
+
+func (c *syndicateClient) ListServices(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*ServiceList, error) {
+	out := new(ServiceList)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ListServices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _RingDist_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RingMsg)
-	if err := dec(in); err != nil {
+func (c *syndicateClient) RegisterNodeMulti(ctx context.Context, in *RegisterMultiRequest, opts ...grpc.CallOption) (*RegisterMultiResult, error) {
+	out := new(RegisterMultiResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/RegisterNodeMulti", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RingDistServer).Setup(ctx, in)
+	return out, nil
+}
+
+func (c *syndicateClient) ReloadConfig(ctx context.Context, in *EmptyMsg, opts ...grpc.CallOption) (*ConfigReloadResult, error) {
+	out := new(ConfigReloadResult)
+	err := grpc.Invoke(ctx, "/proto.Syndicate/ReloadConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Syndicate service
+
+type SyndicateServer interface {
+	AddNode(context.Context, *Node) (*RingStatus, error)
+	RemoveNode(context.Context, *Node) (*RingStatus, error)
+	ModNode(context.Context, *ModifyMsg) (*RingStatus, error)
+	SetConf(context.Context, *Conf) (*RingStatus, error)
+	SetReplicas(context.Context, *RingOpts) (*RingStatus, error)
+	SetActive(context.Context, *Node) (*RingStatus, error)
+	SetCapacity(context.Context, *Node) (*RingStatus, error)
+	ReplaceAddresses(context.Context, *Node) (*RingStatus, error)
+	ReplaceTiers(context.Context, *Node) (*RingStatus, error)
+	GetVersion(context.Context, *EmptyMsg) (*RingStatus, error)
+	GetGlobalConfig(context.Context, *EmptyMsg) (*RingConf, error)
+	GetNodeConfig(context.Context, *Node) (*RingConf, error)
+	GetNodeSoftwareVersion(context.Context, *Node) (*NodeSoftwareVersion, error)
+	NodeUpgradeSoftwareVersion(context.Context, *NodeUpgrade) (*NodeUpgradeStatus, error)
+	GetAllSoftwareVersions(context.Context, *EmptyMsg) (*SoftwareVersions, error)
+	UpgradeCluster(context.Context, *UpgradeRequest) (*UpgradeStatus, error)
+	GetUpgradeStatus(context.Context, *EmptyMsg) (*UpgradeStatus, error)
+	WatchUpgrade(*EmptyMsg, Syndicate_WatchUpgradeServer) error
+	PauseUpgrade(context.Context, *EmptyMsg) (*UpgradeStatus, error)
+	ResumeUpgrade(context.Context, *EmptyMsg) (*UpgradeStatus, error)
+	AbortUpgrade(context.Context, *EmptyMsg) (*UpgradeStatus, error)
+	StartNodes(context.Context, *NodeControlRequest) (*NodeControlResult, error)
+	StopNodes(context.Context, *NodeControlRequest) (*NodeControlResult, error)
+	RestartNodes(context.Context, *NodeControlRequest) (*NodeControlResult, error)
+	SetMaintenance(context.Context, *MaintenanceRequest) (*MaintenanceStatus, error)
+	ClearMaintenance(context.Context, *MaintenanceRequest) (*MaintenanceStatus, error)
+	ListMaintenance(context.Context, *EmptyMsg) (*MaintenanceList, error)
+	RampCapacity(context.Context, *RampRequest) (*RampStatus, error)
+	GetRampStatus(context.Context, *EmptyMsg) (*RampList, error)
+	PauseRamp(context.Context, *Node) (*RampStatus, error)
+	ResumeRamp(context.Context, *Node) (*RampStatus, error)
+	AbortRamp(context.Context, *Node) (*RampStatus, error)
+	DecommissionNode(context.Context, *DecommissionRequest) (*DecommissionStatus, error)
+	GetDecommissionStatus(context.Context, *EmptyMsg) (*DecommissionList, error)
+	SearchNodes(context.Context, *Node) (*SearchResult, error)
+	QueryNodes(context.Context, *NodeQuery) (*NodeQueryResult, error)
+	LookupPartition(context.Context, *PartitionLookup) (*PartitionLookupResult, error)
+	LookupKey(context.Context, *KeyLookup) (*PartitionLookupResult, error)
+	GetRingStats(context.Context, *EmptyMsg) (*RingStats, error)
+	GetRing(context.Context, *EmptyMsg) (*Ring, error)
+	GetRingStream(*SubscriberID, Syndicate_GetRingStreamServer) error
+	WatchRingEvents(*RingEventFilter, Syndicate_WatchRingEventsServer) error
+	ListSubscribers(context.Context, *EmptyMsg) (*SubscriberList, error)
+	DisconnectSubscriber(context.Context, *SubscriberID) (*EmptyMsg, error)
+	RegisterNode(context.Context, *RegisterRequest) (*NodeConfig, error)
+	ReplaceNode(context.Context, *ReplaceNodeRequest) (*NodeConfig, error)
+	GetNodeHardware(context.Context, *Node) (*NodeHardware, error)
+	ReportNodeStatus(context.Context, *NodeStatusReport) (*RingStatus, error)
+	GetCapacityPolicy(context.Context, *EmptyMsg) (*CapacityPolicyStatus, error)
+	ApproveCapacityProposal(context.Context, *Node) (*CapacityProposal, error)
+	RejectCapacityProposal(context.Context, *Node) (*CapacityProposal, error)
+	SimulateRing(context.Context, *SimulateRequest) (*SimulateResult, error)
+	ListServices(context.Context, *EmptyMsg) (*ServiceList, error)
+	RegisterNodeMulti(context.Context, *RegisterMultiRequest) (*RegisterMultiResult, error)
+	ReloadConfig(context.Context, *EmptyMsg) (*ConfigReloadResult, error)
+}
+
+func RegisterSyndicateServer(s *grpc.Server, srv SyndicateServer) {
+	s.RegisterService(&_Syndicate_serviceDesc, srv)
+}
+
+func _Syndicate_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicateServer).AddNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.RingDist/Setup",
+		FullMethod: "/proto.Syndicate/AddNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RingDistServer).Setup(ctx, req.(*RingMsg))
+		return srv.(SyndicateServer).AddNode(ctx, req.(*Node))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _RingDist_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.RingDist",
-	HandlerType: (*RingDistServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Store",
-			Handler:    _RingDist_Store_Handler,
-		},
-		{
-			MethodName: "Revert",
-			Handler:    _RingDist_Revert_Handler,
-		},
-		{
-			MethodName: "Status",
-			Handler:    _RingDist_Status_Handler,
-		},
-		{
-			MethodName: "Setup",
-			Handler:    _RingDist_Setup_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{},
+func _Syndicate_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicateServer).RemoveNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/RemoveNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).RemoveNode(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EmptyMsg) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_ModNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).ModNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ModNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ModNode(ctx, req.(*ModifyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EmptyMsg) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func _Syndicate_SetConf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Conf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicateServer).SetConf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SetConf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SetConf(ctx, req.(*Conf))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingStatus) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_SetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RingOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).SetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SetReplicas(ctx, req.(*RingOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingStatus) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status {
-		data[i] = 0x8
-		i++
-		if m.Status {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+func _Syndicate_SetActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Version != 0 {
-		data[i] = 0x10
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	if interceptor == nil {
+		return srv.(SyndicateServer).SetActive(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SetActive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SetActive(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingOpts) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_SetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).SetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SetCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SetCapacity(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingOpts) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Replicas != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Replicas))
+func _Syndicate_ReplaceAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).ReplaceAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ReplaceAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ReplaceAddresses(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Node) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_ReplaceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).ReplaceTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ReplaceTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ReplaceTiers(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Node) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+func _Syndicate_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Active {
-		data[i] = 0x10
-		i++
-		if m.Active {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetVersion(ctx, in)
 	}
-	if m.Capacity != 0 {
-		data[i] = 0x18
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetVersion",
 	}
-	if len(m.Tiers) > 0 {
-		for _, s := range m.Tiers {
-			data[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetVersion(ctx, req.(*EmptyMsg))
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			data[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Syndicate_GetGlobalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Meta) > 0 {
-		data[i] = 0x32
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Meta)))
-		i += copy(data[i:], m.Meta)
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetGlobalConfig(ctx, in)
 	}
-	if len(m.Conf) > 0 {
-		data[i] = 0x3a
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Conf)))
-		i += copy(data[i:], m.Conf)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetGlobalConfig",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetGlobalConfig(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyMsg) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_GetNodeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
-}
-
-func (m *ModifyMsg) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Key)))
-		i += copy(data[i:], m.Key)
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetNodeConfig(ctx, in)
 	}
-	if len(m.Value) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Value)))
-		i += copy(data[i:], m.Value)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetNodeConfig",
 	}
-	if m.Id != 0 {
-		data[i] = 0x18
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetNodeConfig(ctx, req.(*Node))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingConf) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_GetNodeSoftwareVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetNodeSoftwareVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetNodeSoftwareVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetNodeSoftwareVersion(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingConf) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Status.Size()))
-		n1, err := m.Status.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+func _Syndicate_NodeUpgradeSoftwareVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Conf != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Conf.Size()))
-		n2, err := m.Conf.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if interceptor == nil {
+		return srv.(SyndicateServer).NodeUpgradeSoftwareVersion(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/NodeUpgradeSoftwareVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).NodeUpgradeSoftwareVersion(ctx, req.(*NodeUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Conf) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_GetAllSoftwareVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetAllSoftwareVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetAllSoftwareVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetAllSoftwareVersions(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Conf) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Conf) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Conf)))
-		i += copy(data[i:], m.Conf)
+func _Syndicate_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RestartRequired {
-		data[i] = 0x10
-		i++
-		if m.RestartRequired {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(SyndicateServer).UpgradeCluster(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/UpgradeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).UpgradeCluster(ctx, req.(*UpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubscriberID) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_GetUpgradeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetUpgradeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetUpgradeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetUpgradeStatus(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubscriberID) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Id)))
-		i += copy(data[i:], m.Id)
+func _Syndicate_WatchUpgrade_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(SyndicateServer).WatchUpgrade(m, &syndicateWatchUpgradeServer{stream})
 }
 
-func (m *RegisterRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
-	}
-	return data[:n], nil
+type Syndicate_WatchUpgradeServer interface {
+	Send(*UpgradeStatus) error
+	grpc.ServerStream
 }
 
-func (m *RegisterRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hostname) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Hostname)))
-		i += copy(data[i:], m.Hostname)
+type syndicateWatchUpgradeServer struct {
+	grpc.ServerStream
+}
+
+func (x *syndicateWatchUpgradeServer) Send(m *UpgradeStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Syndicate_PauseUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			data[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	if interceptor == nil {
+		return srv.(SyndicateServer).PauseUpgrade(ctx, in)
 	}
-	if len(m.Tiers) > 0 {
-		for _, s := range m.Tiers {
-			data[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/PauseUpgrade",
 	}
-	if m.Hardware != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Hardware.Size()))
-		n3, err := m.Hardware.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).PauseUpgrade(ctx, req.(*EmptyMsg))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HardwareProfile) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_ResumeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).ResumeUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ResumeUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ResumeUpgrade(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HardwareProfile) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Memtotal != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Memtotal))
+func _Syndicate_AbortUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Memfree != 0 {
-		data[i] = 0x10
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Memfree))
+	if interceptor == nil {
+		return srv.(SyndicateServer).AbortUpgrade(ctx, in)
 	}
-	if m.Cpus != 0 {
-		data[i] = 0x18
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Cpus))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/AbortUpgrade",
 	}
-	if len(m.Disks) > 0 {
-		for _, msg := range m.Disks {
-			data[i] = 0x22
-			i++
-			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).AbortUpgrade(ctx, req.(*EmptyMsg))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Disk) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_StartNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeControlRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).StartNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/StartNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).StartNodes(ctx, req.(*NodeControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Disk) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Device) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Device)))
-		i += copy(data[i:], m.Device)
+func _Syndicate_StopNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Path) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Path)))
-		i += copy(data[i:], m.Path)
+	if interceptor == nil {
+		return srv.(SyndicateServer).StopNodes(ctx, in)
 	}
-	if m.Size_ != 0 {
-		data[i] = 0x18
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Size_))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/StopNodes",
 	}
-	if m.Used != 0 {
-		data[i] = 0x20
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Used))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).StopNodes(ctx, req.(*NodeControlRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeConfig) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_RestartNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeControlRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).RestartNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/RestartNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).RestartNodes(ctx, req.(*NodeControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeConfig) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Localid != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Localid))
+func _Syndicate_SetMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Ring) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ring)))
-		i += copy(data[i:], m.Ring)
+	if interceptor == nil {
+		return srv.(SyndicateServer).SetMaintenance(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SetMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SetMaintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Ring) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_ClearMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).ClearMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ClearMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ClearMaintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Ring) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+func _Syndicate_ListMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Ring) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ring)))
-		i += copy(data[i:], m.Ring)
+	if interceptor == nil {
+		return srv.(SyndicateServer).ListMaintenance(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ListMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ListMaintenance(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SearchResult) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_RampCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RampRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).RampCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/RampCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).RampCapacity(ctx, req.(*RampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SearchResult) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, msg := range m.Nodes {
-			data[i] = 0xa
-			i++
-			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func _Syndicate_GetRampStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetRampStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetRampStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetRampStatus(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeSoftwareVersion) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_PauseRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
-}
-
-func (m *NodeSoftwareVersion) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
-		i += copy(data[i:], m.Version)
+	if interceptor == nil {
+		return srv.(SyndicateServer).PauseRamp(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/PauseRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).PauseRamp(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeUpgrade) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_ResumeRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
-}
-
-func (m *NodeUpgrade) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	if interceptor == nil {
+		return srv.(SyndicateServer).ResumeRamp(ctx, in)
 	}
-	if len(m.Version) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
-		i += copy(data[i:], m.Version)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ResumeRamp",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ResumeRamp(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeUpgradeStatus) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_AbortRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).AbortRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/AbortRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).AbortRamp(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeUpgradeStatus) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status {
-		data[i] = 0x8
-		i++
-		if m.Status {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+func _Syndicate_DecommissionNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Msg) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Msg)))
-		i += copy(data[i:], m.Msg)
+	if interceptor == nil {
+		return srv.(SyndicateServer).DecommissionNode(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/DecommissionNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).DecommissionNode(ctx, req.(*DecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingMsg) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_GetDecommissionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetDecommissionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetDecommissionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetDecommissionStatus(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingMsg) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
-	}
-	if len(m.Ring) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ring)))
-		i += copy(data[i:], m.Ring)
+func _Syndicate_SearchNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Builder) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Builder)))
-		i += copy(data[i:], m.Builder)
+	if interceptor == nil {
+		return srv.(SyndicateServer).SearchNodes(ctx, in)
 	}
-	if m.Deadline != 0 {
-		data[i] = 0x20
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Deadline))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SearchNodes",
 	}
-	if m.Rollback != 0 {
-		data[i] = 0x28
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Rollback))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SearchNodes(ctx, req.(*Node))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StoreResult) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_QueryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).QueryNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/QueryNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).QueryNodes(ctx, req.(*NodeQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StoreResult) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+func _Syndicate_LookupPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionLookup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Ring {
-		data[i] = 0x10
-		i++
-		if m.Ring {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(SyndicateServer).LookupPartition(ctx, in)
 	}
-	if m.Builder {
-		data[i] = 0x18
-		i++
-		if m.Builder {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/LookupPartition",
 	}
-	if len(m.ErrMsg) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.ErrMsg)))
-		i += copy(data[i:], m.ErrMsg)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).LookupPartition(ctx, req.(*PartitionLookup))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StatusRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_LookupKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyLookup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
+	if interceptor == nil {
+		return srv.(SyndicateServer).LookupKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/LookupKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).LookupKey(ctx, req.(*KeyLookup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StatusRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Ring {
-		data[i] = 0x8
-		i++
-		if m.Ring {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+func _Syndicate_GetRingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Builder {
-		data[i] = 0x10
-		i++
-		if m.Builder {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetRingStats(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetRingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetRingStats(ctx, req.(*EmptyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StatusMsg) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
+func _Syndicate_GetRing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return data[:n], nil
-}
-
-func (m *StatusMsg) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		data[i] = 0x8
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetRing(ctx, in)
 	}
-	if len(m.Ringstats) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ringstats)))
-		i += copy(data[i:], m.Ringstats)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetRing",
 	}
-	if len(m.Builderstats) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Builderstats)))
-		i += copy(data[i:], m.Builderstats)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetRing(ctx, req.(*EmptyMsg))
 	}
-	if len(m.Master) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Master)))
-		i += copy(data[i:], m.Master)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Syndicate_GetRingStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscriberID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(SyndicateServer).GetRingStream(m, &syndicateGetRingStreamServer{stream})
 }
 
-func encodeFixed64SyndicateApi(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
+type Syndicate_GetRingStreamServer interface {
+	Send(*Ring) error
+	grpc.ServerStream
 }
-func encodeFixed32SyndicateApi(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
+
+type syndicateGetRingStreamServer struct {
+	grpc.ServerStream
 }
-func encodeVarintSyndicateApi(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (x *syndicateGetRingStreamServer) Send(m *Ring) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Syndicate_WatchRingEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RingEventFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	return srv.(SyndicateServer).WatchRingEvents(m, &syndicateWatchRingEventsServer{stream})
 }
-func (m *EmptyMsg) Size() (n int) {
-	var l int
-	_ = l
-	return n
+
+type Syndicate_WatchRingEventsServer interface {
+	Send(*RingEvent) error
+	grpc.ServerStream
 }
 
-func (m *RingStatus) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
-	}
-	if m.Version != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Version))
-	}
-	return n
+type syndicateWatchRingEventsServer struct {
+	grpc.ServerStream
 }
 
-func (m *RingOpts) Size() (n int) {
-	var l int
-	_ = l
-	if m.Replicas != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Replicas))
-	}
-	return n
+func (x *syndicateWatchRingEventsServer) Send(m *RingEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *Node) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Id))
+func _Syndicate_ListSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Active {
-		n += 2
+	if interceptor == nil {
+		return srv.(SyndicateServer).ListSubscribers(ctx, in)
 	}
-	if m.Capacity != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ListSubscribers",
 	}
-	if len(m.Tiers) > 0 {
-		for _, s := range m.Tiers {
-			l = len(s)
-			n += 1 + l + sovSyndicateApi(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ListSubscribers(ctx, req.(*EmptyMsg))
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovSyndicateApi(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Syndicate_DisconnectSubscriber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriberID)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).DisconnectSubscriber(ctx, in)
 	}
-	l = len(m.Conf)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/DisconnectSubscriber",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).DisconnectSubscriber(ctx, req.(*SubscriberID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyMsg) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+func _Syndicate_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).RegisterNode(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/RegisterNode",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).RegisterNode(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingConf) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovSyndicateApi(uint64(l))
+func _Syndicate_ReplaceNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Conf != nil {
-		l = m.Conf.Size()
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).ReplaceNode(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ReplaceNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ReplaceNode(ctx, req.(*ReplaceNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Conf) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Conf)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+func _Syndicate_GetNodeHardware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RestartRequired {
-		n += 2
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetNodeHardware(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetNodeHardware",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetNodeHardware(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubscriberID) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
-	}
-	return n
-}
-
-func (m *RegisterRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+func _Syndicate_ReportNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeStatusReport)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovSyndicateApi(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(SyndicateServer).ReportNodeStatus(ctx, in)
 	}
-	if len(m.Tiers) > 0 {
-		for _, s := range m.Tiers {
-			l = len(s)
-			n += 1 + l + sovSyndicateApi(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ReportNodeStatus",
 	}
-	if m.Hardware != nil {
-		l = m.Hardware.Size()
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ReportNodeStatus(ctx, req.(*NodeStatusReport))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HardwareProfile) Size() (n int) {
-	var l int
-	_ = l
-	if m.Memtotal != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Memtotal))
+func _Syndicate_GetCapacityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Memfree != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Memfree))
+	if interceptor == nil {
+		return srv.(SyndicateServer).GetCapacityPolicy(ctx, in)
 	}
-	if m.Cpus != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Cpus))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/GetCapacityPolicy",
 	}
-	if len(m.Disks) > 0 {
-		for _, e := range m.Disks {
-			l = e.Size()
-			n += 1 + l + sovSyndicateApi(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).GetCapacityPolicy(ctx, req.(*EmptyMsg))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Disk) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Device)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
-	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Size_))
+func _Syndicate_ApproveCapacityProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Used != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Used))
+	if interceptor == nil {
+		return srv.(SyndicateServer).ApproveCapacityProposal(ctx, in)
 	}
-	return n
-}
-
-func (m *NodeConfig) Size() (n int) {
-	var l int
-	_ = l
-	if m.Localid != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Localid))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ApproveCapacityProposal",
 	}
-	l = len(m.Ring)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ApproveCapacityProposal(ctx, req.(*Node))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Ring) Size() (n int) {
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Version))
+func _Syndicate_RejectCapacityProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Ring)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).RejectCapacityProposal(ctx, in)
 	}
-	return n
-}
-
-func (m *SearchResult) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovSyndicateApi(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/RejectCapacityProposal",
 	}
-	return n
-}
-
-func (m *NodeSoftwareVersion) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).RejectCapacityProposal(ctx, req.(*Node))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeUpgrade) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Id))
+func _Syndicate_SimulateRing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).SimulateRing(ctx, in)
 	}
-	return n
-}
-
-func (m *NodeUpgradeStatus) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/SimulateRing",
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).SimulateRing(ctx, req.(*SimulateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RingMsg) Size() (n int) {
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Version))
-	}
-	l = len(m.Ring)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+func _Syndicate_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Builder)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).ListServices(ctx, in)
 	}
-	if m.Deadline != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Deadline))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ListServices",
 	}
-	if m.Rollback != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Rollback))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ListServices(ctx, req.(*EmptyMsg))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StoreResult) Size() (n int) {
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Version))
-	}
-	if m.Ring {
-		n += 2
-	}
-	if m.Builder {
-		n += 2
+func _Syndicate_RegisterNodeMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMultiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ErrMsg)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).RegisterNodeMulti(ctx, in)
 	}
-	return n
-}
-
-func (m *StatusRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Ring {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/RegisterNodeMulti",
 	}
-	if m.Builder {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).RegisterNodeMulti(ctx, req.(*RegisterMultiRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StatusMsg) Size() (n int) {
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovSyndicateApi(uint64(m.Version))
+func _Syndicate_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Ringstats)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	if interceptor == nil {
+		return srv.(SyndicateServer).ReloadConfig(ctx, in)
 	}
-	l = len(m.Builderstats)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Syndicate/ReloadConfig",
 	}
-	l = len(m.Master)
-	if l > 0 {
-		n += 1 + l + sovSyndicateApi(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicateServer).ReloadConfig(ctx, req.(*EmptyMsg))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovSyndicateApi(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
-func sozSyndicateApi(x uint64) (n int) {
-	return sovSyndicateApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EmptyMsg) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
+var _Syndicate_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Syndicate",
+	HandlerType: (*SyndicateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddNode",
+			Handler:    _Syndicate_AddNode_Handler,
+		},
+		{
+			MethodName: "RemoveNode",
+			Handler:    _Syndicate_RemoveNode_Handler,
+		},
+		{
+			MethodName: "ModNode",
+			Handler:    _Syndicate_ModNode_Handler,
+		},
+		{
+			MethodName: "SetConf",
+			Handler:    _Syndicate_SetConf_Handler,
+		},
+		{
+			MethodName: "SetReplicas",
+			Handler:    _Syndicate_SetReplicas_Handler,
+		},
+		{
+			MethodName: "SetActive",
+			Handler:    _Syndicate_SetActive_Handler,
+		},
+		{
+			MethodName: "SetCapacity",
+			Handler:    _Syndicate_SetCapacity_Handler,
+		},
+		{
+			MethodName: "ReplaceAddresses",
+			Handler:    _Syndicate_ReplaceAddresses_Handler,
+		},
+		{
+			MethodName: "ReplaceTiers",
+			Handler:    _Syndicate_ReplaceTiers_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _Syndicate_GetVersion_Handler,
+		},
+		{
+			MethodName: "GetGlobalConfig",
+			Handler:    _Syndicate_GetGlobalConfig_Handler,
+		},
+		{
+			MethodName: "GetNodeConfig",
+			Handler:    _Syndicate_GetNodeConfig_Handler,
+		},
+		{
+			MethodName: "GetNodeSoftwareVersion",
+			Handler:    _Syndicate_GetNodeSoftwareVersion_Handler,
+		},
+		{
+			MethodName: "NodeUpgradeSoftwareVersion",
+			Handler:    _Syndicate_NodeUpgradeSoftwareVersion_Handler,
+		},
+		{
+			MethodName: "GetAllSoftwareVersions",
+			Handler:    _Syndicate_GetAllSoftwareVersions_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _Syndicate_UpgradeCluster_Handler,
+		},
+		{
+			MethodName: "GetUpgradeStatus",
+			Handler:    _Syndicate_GetUpgradeStatus_Handler,
+		},
+		{
+			MethodName: "PauseUpgrade",
+			Handler:    _Syndicate_PauseUpgrade_Handler,
+		},
+		{
+			MethodName: "ResumeUpgrade",
+			Handler:    _Syndicate_ResumeUpgrade_Handler,
+		},
+		{
+			MethodName: "AbortUpgrade",
+			Handler:    _Syndicate_AbortUpgrade_Handler,
+		},
+		{
+			MethodName: "StartNodes",
+			Handler:    _Syndicate_StartNodes_Handler,
+		},
+		{
+			MethodName: "StopNodes",
+			Handler:    _Syndicate_StopNodes_Handler,
+		},
+		{
+			MethodName: "RestartNodes",
+			Handler:    _Syndicate_RestartNodes_Handler,
+		},
+		{
+			MethodName: "SetMaintenance",
+			Handler:    _Syndicate_SetMaintenance_Handler,
+		},
+		{
+			MethodName: "ClearMaintenance",
+			Handler:    _Syndicate_ClearMaintenance_Handler,
+		},
+		{
+			MethodName: "ListMaintenance",
+			Handler:    _Syndicate_ListMaintenance_Handler,
+		},
+		{
+			MethodName: "RampCapacity",
+			Handler:    _Syndicate_RampCapacity_Handler,
+		},
+		{
+			MethodName: "GetRampStatus",
+			Handler:    _Syndicate_GetRampStatus_Handler,
+		},
+		{
+			MethodName: "PauseRamp",
+			Handler:    _Syndicate_PauseRamp_Handler,
+		},
+		{
+			MethodName: "ResumeRamp",
+			Handler:    _Syndicate_ResumeRamp_Handler,
+		},
+		{
+			MethodName: "AbortRamp",
+			Handler:    _Syndicate_AbortRamp_Handler,
+		},
+		{
+			MethodName: "DecommissionNode",
+			Handler:    _Syndicate_DecommissionNode_Handler,
+		},
+		{
+			MethodName: "GetDecommissionStatus",
+			Handler:    _Syndicate_GetDecommissionStatus_Handler,
+		},
+		{
+			MethodName: "SearchNodes",
+			Handler:    _Syndicate_SearchNodes_Handler,
+		},
+		{
+			MethodName: "QueryNodes",
+			Handler:    _Syndicate_QueryNodes_Handler,
+		},
+		{
+			MethodName: "LookupPartition",
+			Handler:    _Syndicate_LookupPartition_Handler,
+		},
+		{
+			MethodName: "LookupKey",
+			Handler:    _Syndicate_LookupKey_Handler,
+		},
+		{
+			MethodName: "GetRingStats",
+			Handler:    _Syndicate_GetRingStats_Handler,
+		},
+		{
+			MethodName: "GetRing",
+			Handler:    _Syndicate_GetRing_Handler,
+		},
+		{
+			MethodName: "ListSubscribers",
+			Handler:    _Syndicate_ListSubscribers_Handler,
+		},
+		{
+			MethodName: "DisconnectSubscriber",
+			Handler:    _Syndicate_DisconnectSubscriber_Handler,
+		},
+		{
+			MethodName: "RegisterNode",
+			Handler:    _Syndicate_RegisterNode_Handler,
+		},
+		{
+			MethodName: "ReplaceNode",
+			Handler:    _Syndicate_ReplaceNode_Handler,
+		},
+		{
+			MethodName: "GetNodeHardware",
+			Handler:    _Syndicate_GetNodeHardware_Handler,
+		},
+		{
+			MethodName: "ReportNodeStatus",
+			Handler:    _Syndicate_ReportNodeStatus_Handler,
+		},
+		{
+			MethodName: "GetCapacityPolicy",
+			Handler:    _Syndicate_GetCapacityPolicy_Handler,
+		},
+		{
+			MethodName: "ApproveCapacityProposal",
+			Handler:    _Syndicate_ApproveCapacityProposal_Handler,
+		},
+		{
+			MethodName: "RejectCapacityProposal",
+			Handler:    _Syndicate_RejectCapacityProposal_Handler,
+		},
+		{
+			MethodName: "SimulateRing",
+			Handler:    _Syndicate_SimulateRing_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _Syndicate_ListServices_Handler,
+		},
+		{
+			MethodName: "RegisterNodeMulti",
+			Handler:    _Syndicate_RegisterNodeMulti_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _Syndicate_ReloadConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUpgrade",
+			Handler:       _Syndicate_WatchUpgrade_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetRingStream",
+			Handler:       _Syndicate_GetRingStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRingEvents",
+			Handler:       _Syndicate_WatchRingEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+}
+
+// Client API for RingDist service
+
+type RingDistClient interface {
+	Store(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error)
+	Revert(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error)
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusMsg, error)
+	Setup(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error)
+}
+
+type ringDistClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRingDistClient(cc *grpc.ClientConn) RingDistClient {
+	return &ringDistClient{cc}
+}
+
+func (c *ringDistClient) Store(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error) {
+	out := new(StoreResult)
+	err := grpc.Invoke(ctx, "/proto.RingDist/Store", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringDistClient) Revert(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error) {
+	out := new(StoreResult)
+	err := grpc.Invoke(ctx, "/proto.RingDist/Revert", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringDistClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusMsg, error) {
+	out := new(StatusMsg)
+	err := grpc.Invoke(ctx, "/proto.RingDist/Status", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringDistClient) Setup(ctx context.Context, in *RingMsg, opts ...grpc.CallOption) (*StoreResult, error) {
+	out := new(StoreResult)
+	err := grpc.Invoke(ctx, "/proto.RingDist/Setup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RingDist service
+
+type RingDistServer interface {
+	Store(context.Context, *RingMsg) (*StoreResult, error)
+	Revert(context.Context, *RingMsg) (*StoreResult, error)
+	Status(context.Context, *StatusRequest) (*StatusMsg, error)
+	Setup(context.Context, *RingMsg) (*StoreResult, error)
+}
+
+func RegisterRingDistServer(s *grpc.Server, srv RingDistServer) {
+	s.RegisterService(&_RingDist_serviceDesc, srv)
+}
+
+func _RingDist_Store_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RingMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingDistServer).Store(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RingDist/Store",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingDistServer).Store(ctx, req.(*RingMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingDist_Revert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RingMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingDistServer).Revert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RingDist/Revert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingDistServer).Revert(ctx, req.(*RingMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingDist_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingDistServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RingDist/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingDistServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingDist_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RingMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingDistServer).Setup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RingDist/Setup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingDistServer).Setup(ctx, req.(*RingMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RingDist_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.RingDist",
+	HandlerType: (*RingDistServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Store",
+			Handler:    _RingDist_Store_Handler,
+		},
+		{
+			MethodName: "Revert",
+			Handler:    _RingDist_Revert_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _RingDist_Status_Handler,
+		},
+		{
+			MethodName: "Setup",
+			Handler:    _RingDist_Setup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func (m *EmptyMsg) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmptyMsg) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RingStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status {
+		data[i] = 0x8
+		i++
+		if m.Status {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *RingOpts) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingOpts) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Replicas != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Replicas))
+	}
+	return i, nil
+}
+
+func (m *Node) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Node) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if m.Active {
+		data[i] = 0x10
+		i++
+		if m.Active {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			data[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Meta) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Meta)))
+		i += copy(data[i:], m.Meta)
+	}
+	if len(m.Conf) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Conf)))
+		i += copy(data[i:], m.Conf)
+	}
+	return i, nil
+}
+
+func (m *ModifyMsg) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ModifyMsg) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Key)))
+		i += copy(data[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Value)))
+		i += copy(data[i:], m.Value)
+	}
+	if m.Id != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	return i, nil
+}
+
+func (m *RingConf) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingConf) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Status.Size()))
+		n1, err := m.Status.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Conf != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Conf.Size()))
+		n2, err := m.Conf.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *Conf) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Conf) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conf) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Conf)))
+		i += copy(data[i:], m.Conf)
+	}
+	if m.RestartRequired {
+		data[i] = 0x10
+		i++
+		if m.RestartRequired {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SubscriberID) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SubscriberID) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Id)))
+		i += copy(data[i:], m.Id)
+	}
+	if m.Version != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if m.Deltas {
+		data[i] = 0x18
+		i++
+		if m.Deltas {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RingEventFilter) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingEventFilter) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		data4 := make([]byte, len(m.Types)*10)
+		var j3 int
+		for _, num1 := range m.Types {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				data4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			data4[j3] = uint8(num)
+			j3++
+		}
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j3))
+		i += copy(data[i:], data4[:j3])
+	}
+	if len(m.Nodes) > 0 {
+		data6 := make([]byte, len(m.Nodes)*10)
+		var j5 int
+		for _, num := range m.Nodes {
+			for num >= 1<<7 {
+				data6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			data6[j5] = uint8(num)
+			j5++
+		}
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j5))
+		i += copy(data[i:], data6[:j5])
+	}
+	return i, nil
+}
+
+func (m *RingEvent) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Type))
+	}
+	if m.Version != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if m.PreviousVersion != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.PreviousVersion))
+	}
+	if m.Node != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Node))
+	}
+	if m.OldNode != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.OldNode.Size()))
+		n7, err := m.OldNode.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.NewNode != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.NewNode.Size()))
+		n8, err := m.NewNode.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.OldConf) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.OldConf)))
+		i += copy(data[i:], m.OldConf)
+	}
+	if len(m.NewConf) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.NewConf)))
+		i += copy(data[i:], m.NewConf)
+	}
+	if m.OldReplicas != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.OldReplicas))
+	}
+	if m.NewReplicas != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.NewReplicas))
+	}
+	return i, nil
+}
+
+func (m *SubscriberList) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SubscriberList) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if len(m.Subscribers) > 0 {
+		for _, msg := range m.Subscribers {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SubscriberInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SubscriberInfo) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Id)))
+		i += copy(data[i:], m.Id)
+	}
+	if len(m.Peer) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Peer)))
+		i += copy(data[i:], m.Peer)
+	}
+	if m.Connected != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Connected))
+	}
+	if m.LastVersion != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.LastVersion))
+	}
+	if m.LastSend != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.LastSend))
+	}
+	if m.Deltas {
+		data[i] = 0x30
+		i++
+		if m.Deltas {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RegisterRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RegisterRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hostname) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Hostname)))
+		i += copy(data[i:], m.Hostname)
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.Hardware != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Hardware.Size()))
+		n9, err := m.Hardware.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *ReplaceNodeRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ReplaceNodeRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Hostname) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Hostname)))
+		i += copy(data[i:], m.Hostname)
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.Hardware != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Hardware.Size()))
+		n10, err := m.Hardware.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *HardwareProfile) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *HardwareProfile) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Memtotal != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Memtotal))
+	}
+	if m.Memfree != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Memfree))
+	}
+	if m.Cpus != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Cpus))
+	}
+	if len(m.Disks) > 0 {
+		for _, msg := range m.Disks {
+			data[i] = 0x22
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NodeHardware) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeHardware) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Hostname) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Hostname)))
+		i += copy(data[i:], m.Hostname)
+	}
+	if m.Hardware != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Hardware.Size()))
+		n11, err := m.Hardware.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Updated != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *NodeStatusReport) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeStatusReport) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Hostname) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Hostname)))
+		i += copy(data[i:], m.Hostname)
+	}
+	if m.Hardware != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Hardware.Size()))
+		n12, err := m.Hardware.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Load1 != 0 {
+		data[i] = 0x21
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Load1))))
+	}
+	if m.Load5 != 0 {
+		data[i] = 0x29
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Load5))))
+	}
+	if m.Load15 != 0 {
+		data[i] = 0x31
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Load15))))
+	}
+	if m.RingVersion != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RingVersion))
+	}
+	return i, nil
+}
+
+func (m *CapacityProposal) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CapacityProposal) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if m.Restore {
+		data[i] = 0x10
+		i++
+		if m.Restore {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if m.Proposed != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Proposed))
+	}
+	if m.Fill != 0 {
+		data[i] = 0x29
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Fill))))
+	}
+	if m.State != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.State))
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if len(m.Caller) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Caller)))
+		i += copy(data[i:], m.Caller)
+	}
+	if m.Created != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Created))
+	}
+	if m.Decided != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Decided))
+	}
+	if m.RingVersion != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RingVersion))
+	}
+	return i, nil
+}
+
+func (m *CapacityPolicyStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CapacityPolicyStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mode) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Mode)))
+		i += copy(data[i:], m.Mode)
+	}
+	if len(m.Pending) > 0 {
+		for _, msg := range m.Pending {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SimulatedNodes) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SimulatedNodes) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Count))
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Meta) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Meta)))
+		i += copy(data[i:], m.Meta)
+	}
+	return i, nil
+}
+
+func (m *SimulateRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SimulateRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Add) > 0 {
+		for _, msg := range m.Add {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Remove) > 0 {
+		data14 := make([]byte, len(m.Remove)*10)
+		var j13 int
+		for _, num := range m.Remove {
+			for num >= 1<<7 {
+				data14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			data14[j13] = uint8(num)
+			j13++
+		}
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j13))
+		i += copy(data[i:], data14[:j13])
+	}
+	if len(m.Capacity) > 0 {
+		for _, msg := range m.Capacity {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Path) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Path)))
+		i += copy(data[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *NodeProjection) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeProjection) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Meta) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Meta)))
+		i += copy(data[i:], m.Meta)
+	}
+	if m.Simulated {
+		data[i] = 0x18
+		i++
+		if m.Simulated {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Removed {
+		data[i] = 0x20
+		i++
+		if m.Removed {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if m.Assigned != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Assigned))
+	}
+	if m.Projected != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Projected))
+	}
+	if m.Reported {
+		data[i] = 0x40
+		i++
+		if m.Reported {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Fill != 0 {
+		data[i] = 0x49
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Fill))))
+	}
+	if m.ProjectedFill != 0 {
+		data[i] = 0x51
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.ProjectedFill))))
+	}
+	return i, nil
+}
+
+func (m *SimulateResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SimulateResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Before != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Before.Size()))
+		n15, err := m.Before.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.After != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.After.Size()))
+		n16, err := m.After.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Replicas != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Replicas))
+	}
+	if m.Moved != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Moved))
+	}
+	if m.MovedPercent != 0 {
+		data[i] = 0x29
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.MovedPercent))))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0x32
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RegisterMultiRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RegisterMultiRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Node != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Node.Size()))
+		n17, err := m.Node.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.Rollback {
+		data[i] = 0x18
+		i++
+		if m.Rollback {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ServiceRegistration) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ServiceRegistration) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Service)))
+		i += copy(data[i:], m.Service)
+	}
+	if m.Config != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Config.Size()))
+		n18, err := m.Config.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if m.Added {
+		data[i] = 0x20
+		i++
+		if m.Added {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Skipped {
+		data[i] = 0x28
+		i++
+		if m.Skipped {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.RolledBack {
+		data[i] = 0x30
+		i++
+		if m.RolledBack {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.RollbackError) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.RollbackError)))
+		i += copy(data[i:], m.RollbackError)
+	}
+	return i, nil
+}
+
+func (m *RegisterMultiResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RegisterMultiResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Ok {
+		data[i] = 0x8
+		i++
+		if m.Ok {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ServiceInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ServiceInfo) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
+	if m.Port != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Port))
+	}
+	if m.Version != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if m.Master {
+		data[i] = 0x20
+		i++
+		if m.Master {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Nodes != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Nodes))
+	}
+	if m.Default {
+		data[i] = 0x30
+		i++
+		if m.Default {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ServiceList) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ServiceList) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, msg := range m.Services {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ServiceReload) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ServiceReload) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Service)))
+		i += copy(data[i:], m.Service)
+	}
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.RestartRequired) > 0 {
+		for _, s := range m.RestartRequired {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ConfigReloadResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ConfigReloadResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, msg := range m.Services {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.LogLevel) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.LogLevel)))
+		i += copy(data[i:], m.LogLevel)
+	}
+	return i, nil
+}
+
+func (m *Disk) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Disk) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Device) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Device)))
+		i += copy(data[i:], m.Device)
+	}
+	if len(m.Path) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Path)))
+		i += copy(data[i:], m.Path)
+	}
+	if m.Size_ != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Size_))
+	}
+	if m.Used != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Used))
+	}
+	return i, nil
+}
+
+func (m *NodeConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeConfig) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Localid != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Localid))
+	}
+	if len(m.Ring) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ring)))
+		i += copy(data[i:], m.Ring)
+	}
+	return i, nil
+}
+
+func (m *Ring) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Ring) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if len(m.Ring) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ring)))
+		i += copy(data[i:], m.Ring)
+	}
+	if m.Delta != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Delta.Size()))
+		n19, err := m.Delta.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+
+func (m *RingDelta) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingDelta) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.BaseVersion != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.BaseVersion))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RemovedNodes) > 0 {
+		data21 := make([]byte, len(m.RemovedNodes)*10)
+		var j20 int
+		for _, num := range m.RemovedNodes {
+			for num >= 1<<7 {
+				data21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			data21[j20] = uint8(num)
+			j20++
+		}
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j20))
+		i += copy(data[i:], data21[:j20])
+	}
+	if len(m.Partitions) > 0 {
+		for _, msg := range m.Partitions {
+			data[i] = 0x22
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ConfChanged {
+		data[i] = 0x28
+		i++
+		if m.ConfChanged {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.Conf) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Conf)))
+		i += copy(data[i:], m.Conf)
+	}
+	return i, nil
+}
+
+func (m *PartitionAssignment) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PartitionAssignment) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Partition))
+	}
+	if len(m.Nodes) > 0 {
+		data23 := make([]byte, len(m.Nodes)*10)
+		var j22 int
+		for _, num := range m.Nodes {
+			for num >= 1<<7 {
+				data23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			data23[j22] = uint8(num)
+			j22++
+		}
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j22))
+		i += copy(data[i:], data23[:j22])
+	}
+	return i, nil
+}
+
+func (m *SearchResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SearchResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NodeQuery) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeQuery) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Query) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Query)))
+		i += copy(data[i:], m.Query)
+	}
+	return i, nil
+}
+
+func (m *NodeQueryResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeQueryResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.PartitionCounts {
+		data[i] = 0x18
+		i++
+		if m.PartitionCounts {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NodeQueryMatch) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeQueryMatch) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Node != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Node.Size()))
+		n24, err := m.Node.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.Partitions != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Partitions))
+	}
+	return i, nil
+}
+
+func (m *PartitionLookup) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PartitionLookup) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Partition))
+	}
+	if m.Previous {
+		data[i] = 0x10
+		i++
+		if m.Previous {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *KeyLookup) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *KeyLookup) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Key)))
+		i += copy(data[i:], m.Key)
+	}
+	if m.Previous {
+		data[i] = 0x10
+		i++
+		if m.Previous {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PartitionLookupResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PartitionLookupResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if m.Partition != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Partition))
+	}
+	if len(m.Replicas) > 0 {
+		for _, msg := range m.Replicas {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.PreviousVersion != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.PreviousVersion))
+	}
+	if m.PreviousPartition != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.PreviousPartition))
+	}
+	if len(m.PreviousReplicas) > 0 {
+		for _, msg := range m.PreviousReplicas {
+			data[i] = 0x32
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PartitionReplica) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PartitionReplica) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Meta) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Meta)))
+		i += copy(data[i:], m.Meta)
+	}
+	return i, nil
+}
+
+func (m *RingStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingStats) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if m.Partitions != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Partitions))
+	}
+	if m.Replicas != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Replicas))
+	}
+	if m.ActiveNodes != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.ActiveNodes))
+	}
+	if m.InactiveNodes != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.InactiveNodes))
+	}
+	if m.ZeroCapacityNodes != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.ZeroCapacityNodes))
+	}
+	if m.MaxOverWeight != 0 {
+		data[i] = 0x39
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.MaxOverWeight))))
+	}
+	if m.MaxUnderWeight != 0 {
+		data[i] = 0x41
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.MaxUnderWeight))))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0x4a
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, msg := range m.Tiers {
+			data[i] = 0x52
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NodeBalance) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeBalance) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Meta) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Meta)))
+		i += copy(data[i:], m.Meta)
+	}
+	if m.Active {
+		data[i] = 0x18
+		i++
+		if m.Active {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if m.Desired != 0 {
+		data[i] = 0x29
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Desired))))
+	}
+	if m.Assigned != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Assigned))
+	}
+	if m.Weight != 0 {
+		data[i] = 0x39
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Weight))))
+	}
+	return i, nil
+}
+
+func (m *TierBalance) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TierBalance) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Level != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Level))
+	}
+	if len(m.Tier) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Tier)))
+		i += copy(data[i:], m.Tier)
+	}
+	if m.Nodes != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Nodes))
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if m.Desired != 0 {
+		data[i] = 0x29
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Desired))))
+	}
+	if m.Assigned != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Assigned))
+	}
+	if m.Weight != 0 {
+		data[i] = 0x39
+		i++
+		i = encodeFixed64SyndicateApi(data, i, uint64(math.Float64bits(float64(m.Weight))))
+	}
+	return i, nil
+}
+
+func (m *NodeSoftwareVersion) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeSoftwareVersion) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	return i, nil
+}
+
+func (m *SoftwareVersions) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SoftwareVersions) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, msg := range m.Versions {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Failed) > 0 {
+		for _, msg := range m.Failed {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Nodes != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Nodes))
+	}
+	return i, nil
+}
+
+func (m *SoftwareVersionGroup) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SoftwareVersionGroup) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0x12
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NodeVersion) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeVersion) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Address) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Address)))
+		i += copy(data[i:], m.Address)
+	}
+	if len(m.Version) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *UpgradeRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UpgradeRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	if m.BatchSize != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.BatchSize))
+	}
+	if m.Tier != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Tier))
+	}
+	if m.HealthTimeout != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.HealthTimeout))
+	}
+	if len(m.Canaries) > 0 {
+		data26 := make([]byte, len(m.Canaries)*10)
+		var j25 int
+		for _, num := range m.Canaries {
+			for num >= 1<<7 {
+				data26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			data26[j25] = uint8(num)
+			j25++
+		}
+		data[i] = 0x2a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j25))
+		i += copy(data[i:], data26[:j25])
+	}
+	if m.Soak != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Soak))
+	}
+	return i, nil
+}
+
+func (m *UpgradeStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UpgradeStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	if m.State != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.State))
+	}
+	if m.BatchSize != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.BatchSize))
+	}
+	if m.Tier != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Tier))
+	}
+	if m.Batch != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Batch))
+	}
+	if m.Batches != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Batches))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if m.Started != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Started))
+	}
+	if m.Finished != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Finished))
+	}
+	if m.SoakUntil != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.SoakUntil))
+	}
+	return i, nil
+}
+
+func (m *UpgradeNode) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UpgradeNode) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Address) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Address)))
+		i += copy(data[i:], m.Address)
+	}
+	if len(m.Domain) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Domain)))
+		i += copy(data[i:], m.Domain)
+	}
+	if m.Batch != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Batch))
+	}
+	if m.State != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.State))
+	}
+	if len(m.PreviousVersion) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.PreviousVersion)))
+		i += copy(data[i:], m.PreviousVersion)
+	}
+	if len(m.Version) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if m.Canary {
+		data[i] = 0x48
+		i++
+		if m.Canary {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NodeControlRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeControlRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		data28 := make([]byte, len(m.Ids)*10)
+		var j27 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				data28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			data28[j27] = uint8(num)
+			j27++
+		}
+		data[i] = 0xa
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(j27))
+		i += copy(data[i:], data28[:j27])
+	}
+	if len(m.Query) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Query)))
+		i += copy(data[i:], m.Query)
+	}
+	if m.Concurrency != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Concurrency))
+	}
+	if m.Tier != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Tier))
+	}
+	if m.HealthTimeout != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.HealthTimeout))
+	}
+	if m.ContinueOnError {
+		data[i] = 0x30
+		i++
+		if m.ContinueOnError {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NodeControlResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeControlResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Failed != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Failed))
+	}
+	if m.Skipped != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Skipped))
+	}
+	return i, nil
+}
+
+func (m *NodeControlStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeControlStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Address) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Address)))
+		i += copy(data[i:], m.Address)
+	}
+	if len(m.Domain) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Domain)))
+		i += copy(data[i:], m.Domain)
+	}
+	if m.Status {
+		data[i] = 0x20
+		i++
+		if m.Status {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Skipped {
+		data[i] = 0x28
+		i++
+		if m.Skipped {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *MaintenanceRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MaintenanceRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Reason) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Reason)))
+		i += copy(data[i:], m.Reason)
+	}
+	if m.Duration != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Duration))
+	}
+	if m.Drain {
+		data[i] = 0x20
+		i++
+		if m.Drain {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.DrainTarget != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.DrainTarget))
+	}
+	if m.DrainStep != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.DrainStep))
+	}
+	if m.DrainInterval != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.DrainInterval))
+	}
+	if m.RestoreCapacity {
+		data[i] = 0x40
+		i++
+		if m.RestoreCapacity {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MaintenanceStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MaintenanceStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Reason) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Reason)))
+		i += copy(data[i:], m.Reason)
+	}
+	if len(m.Caller) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Caller)))
+		i += copy(data[i:], m.Caller)
+	}
+	if m.Started != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Started))
+	}
+	if m.Expires != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Expires))
+	}
+	if m.Draining {
+		data[i] = 0x30
+		i++
+		if m.Draining {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if m.OriginalCapacity != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.OriginalCapacity))
+	}
+	if m.DrainTarget != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.DrainTarget))
+	}
+	if m.DrainStep != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.DrainStep))
+	}
+	if m.DrainInterval != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.DrainInterval))
+	}
+	if m.RestoreCapacity {
+		data[i] = 0x60
+		i++
+		if m.RestoreCapacity {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MaintenanceList) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MaintenanceList) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RampRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RampRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if m.Target != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Target))
+	}
+	if m.Step != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Step))
+	}
+	if m.Interval != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Interval))
+	}
+	if m.WaitConverged {
+		data[i] = 0x28
+		i++
+		if m.WaitConverged {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.ConvergeTimeout != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.ConvergeTimeout))
+	}
+	return i, nil
+}
+
+func (m *RampStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RampStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if m.State != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.State))
+	}
+	if m.Start != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Start))
+	}
+	if m.Target != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Target))
+	}
+	if m.Step != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Step))
+	}
+	if m.Interval != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Interval))
+	}
+	if m.WaitConverged {
+		data[i] = 0x38
+		i++
+		if m.WaitConverged {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Capacity))
+	}
+	if m.Steps != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Steps))
+	}
+	if m.StepsDone != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.StepsDone))
+	}
+	if m.RingVersion != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RingVersion))
+	}
+	if m.NextStep != 0 {
+		data[i] = 0x60
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.NextStep))
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if len(m.Caller) > 0 {
+		data[i] = 0x72
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Caller)))
+		i += copy(data[i:], m.Caller)
+	}
+	if m.Started != 0 {
+		data[i] = 0x78
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Started))
+	}
+	if m.Finished != 0 {
+		data[i] = 0x80
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Finished))
+	}
+	return i, nil
+}
+
+func (m *RampList) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RampList) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ramps) > 0 {
+		for _, msg := range m.Ramps {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DecommissionRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DecommissionRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if m.Ramp {
+		data[i] = 0x10
+		i++
+		if m.Ramp {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.RampStep != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RampStep))
+	}
+	if m.RampInterval != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RampInterval))
+	}
+	if m.ConvergeTimeout != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.ConvergeTimeout))
+	}
+	if m.StopBackend {
+		data[i] = 0x30
+		i++
+		if m.StopBackend {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DecommissionStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DecommissionStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Address) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Address)))
+		i += copy(data[i:], m.Address)
+	}
+	if m.State != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.State))
+	}
+	if m.Failed {
+		data[i] = 0x20
+		i++
+		if m.Failed {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if m.Ramp {
+		data[i] = 0x30
+		i++
+		if m.Ramp {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.RampStep != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RampStep))
+	}
+	if m.RampInterval != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RampInterval))
+	}
+	if m.ConvergeTimeout != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.ConvergeTimeout))
+	}
+	if m.StopBackend {
+		data[i] = 0x50
+		i++
+		if m.StopBackend {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.RingVersion != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.RingVersion))
+	}
+	if len(m.Caller) > 0 {
+		data[i] = 0x62
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Caller)))
+		i += copy(data[i:], m.Caller)
+	}
+	if m.Started != 0 {
+		data[i] = 0x68
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Started))
+	}
+	if m.Updated != 0 {
+		data[i] = 0x70
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Updated))
+	}
+	if m.Finished != 0 {
+		data[i] = 0x78
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Finished))
+	}
+	return i, nil
+}
+
+func (m *DecommissionList) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DecommissionList) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			data[i] = 0xa
+			i++
+			i = encodeVarintSyndicateApi(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NodeUpgrade) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeUpgrade) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Id))
+	}
+	if len(m.Version) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	return i, nil
+}
+
+func (m *NodeUpgradeStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeUpgradeStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status {
+		data[i] = 0x8
+		i++
+		if m.Status {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.Msg) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Msg)))
+		i += copy(data[i:], m.Msg)
+	}
+	return i, nil
+}
+
+func (m *RingMsg) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RingMsg) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if len(m.Ring) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ring)))
+		i += copy(data[i:], m.Ring)
+	}
+	if len(m.Builder) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Builder)))
+		i += copy(data[i:], m.Builder)
+	}
+	if m.Deadline != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Deadline))
+	}
+	if m.Rollback != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Rollback))
+	}
+	return i, nil
+}
+
+func (m *StoreResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StoreResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if m.Ring {
+		data[i] = 0x10
+		i++
+		if m.Ring {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Builder {
+		data[i] = 0x18
+		i++
+		if m.Builder {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.ErrMsg) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.ErrMsg)))
+		i += copy(data[i:], m.ErrMsg)
+	}
+	return i, nil
+}
+
+func (m *StatusRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Ring {
+		data[i] = 0x8
+		i++
+		if m.Ring {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Builder {
+		data[i] = 0x10
+		i++
+		if m.Builder {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *StatusMsg) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatusMsg) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(m.Version))
+	}
+	if len(m.Ringstats) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Ringstats)))
+		i += copy(data[i:], m.Ringstats)
+	}
+	if len(m.Builderstats) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Builderstats)))
+		i += copy(data[i:], m.Builderstats)
+	}
+	if len(m.Master) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintSyndicateApi(data, i, uint64(len(m.Master)))
+		i += copy(data[i:], m.Master)
+	}
+	return i, nil
+}
+
+func encodeFixed64SyndicateApi(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32SyndicateApi(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintSyndicateApi(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EmptyMsg) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RingStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *RingOpts) Size() (n int) {
+	var l int
+	_ = l
+	if m.Replicas != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Replicas))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Conf)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ModifyMsg) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *RingConf) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Conf != nil {
+		l = m.Conf.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Conf) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Conf)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.RestartRequired {
+		n += 2
+	}
+	return n
+}
+
+func (m *SubscriberID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if m.Deltas {
+		n += 2
+	}
+	return n
+}
+
+func (m *RingEventFilter) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	if len(m.Nodes) > 0 {
+		l = 0
+		for _, e := range m.Nodes {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RingEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Type))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if m.PreviousVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.PreviousVersion))
+	}
+	if m.Node != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Node))
+	}
+	if m.OldNode != nil {
+		l = m.OldNode.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.NewNode != nil {
+		l = m.NewNode.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.OldConf)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.NewConf)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.OldReplicas != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.OldReplicas))
+	}
+	if m.NewReplicas != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.NewReplicas))
+	}
+	return n
+}
+
+func (m *SubscriberList) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if len(m.Subscribers) > 0 {
+		for _, e := range m.Subscribers {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscriberInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Connected != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Connected))
+	}
+	if m.LastVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.LastVersion))
+	}
+	if m.LastSend != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.LastSend))
+	}
+	if m.Deltas {
+		n += 2
+	}
+	return n
+}
+
+func (m *RegisterRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.Hardware != nil {
+		l = m.Hardware.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplaceNodeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.Hardware != nil {
+		l = m.Hardware.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *HardwareProfile) Size() (n int) {
+	var l int
+	_ = l
+	if m.Memtotal != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Memtotal))
+	}
+	if m.Memfree != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Memfree))
+	}
+	if m.Cpus != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Cpus))
+	}
+	if len(m.Disks) > 0 {
+		for _, e := range m.Disks {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeHardware) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Hardware != nil {
+		l = m.Hardware.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *NodeStatusReport) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Hardware != nil {
+		l = m.Hardware.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Load1 != 0 {
+		n += 9
+	}
+	if m.Load5 != 0 {
+		n += 9
+	}
+	if m.Load15 != 0 {
+		n += 9
+	}
+	if m.RingVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RingVersion))
+	}
+	return n
+}
+
+func (m *CapacityProposal) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	if m.Restore {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if m.Proposed != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Proposed))
+	}
+	if m.Fill != 0 {
+		n += 9
+	}
+	if m.State != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.State))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Created))
+	}
+	if m.Decided != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Decided))
+	}
+	if m.RingVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RingVersion))
+	}
+	return n
+}
+
+func (m *CapacityPolicyStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedNodes) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Count))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Add) > 0 {
+		for _, e := range m.Add {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		l = 0
+		for _, e := range m.Remove {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	if len(m.Capacity) > 0 {
+		for _, e := range m.Capacity {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeProjection) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Simulated {
+		n += 2
+	}
+	if m.Removed {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if m.Assigned != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Assigned))
+	}
+	if m.Projected != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Projected))
+	}
+	if m.Reported {
+		n += 2
+	}
+	if m.Fill != 0 {
+		n += 9
+	}
+	if m.ProjectedFill != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SimulateResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Replicas != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Replicas))
+	}
+	if m.Moved != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Moved))
+	}
+	if m.MovedPercent != 0 {
+		n += 9
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterMultiRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.Rollback {
+		n += 2
+	}
+	return n
+}
+
+func (m *ServiceRegistration) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Added {
+		n += 2
+	}
+	if m.Skipped {
+		n += 2
+	}
+	if m.RolledBack {
+		n += 2
+	}
+	l = len(m.RollbackError)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterMultiResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Port))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if m.Master {
+		n += 2
+	}
+	if m.Nodes != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Nodes))
+	}
+	if m.Default {
+		n += 2
+	}
+	return n
+}
+
+func (m *ServiceList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceReload) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.RestartRequired) > 0 {
+		for _, s := range m.RestartRequired {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigReloadResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	l = len(m.LogLevel)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Disk) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Size_))
+	}
+	if m.Used != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *NodeConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.Localid != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Localid))
+	}
+	l = len(m.Ring)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Ring) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	l = len(m.Ring)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Delta != nil {
+		l = m.Delta.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RingDelta) Size() (n int) {
+	var l int
+	_ = l
+	if m.BaseVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.BaseVersion))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.RemovedNodes) > 0 {
+		l = 0
+		for _, e := range m.RemovedNodes {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.ConfChanged {
+		n += 2
+	}
+	l = len(m.Conf)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *PartitionAssignment) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Partition))
+	}
+	if len(m.Nodes) > 0 {
+		l = 0
+		for _, e := range m.Nodes {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SearchResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeQueryResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.PartitionCounts {
+		n += 2
+	}
+	return n
+}
+
+func (m *NodeQueryMatch) Size() (n int) {
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.Size()
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Partitions != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Partitions))
+	}
+	return n
+}
+
+func (m *PartitionLookup) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Partition))
+	}
+	if m.Previous {
+		n += 2
+	}
+	return n
+}
+
+func (m *KeyLookup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Previous {
+		n += 2
+	}
+	return n
+}
+
+func (m *PartitionLookupResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Partition))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.PreviousVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.PreviousVersion))
+	}
+	if m.PreviousPartition != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.PreviousPartition))
+	}
+	if len(m.PreviousReplicas) > 0 {
+		for _, e := range m.PreviousReplicas {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PartitionReplica) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RingStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if m.Partitions != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Partitions))
+	}
+	if m.Replicas != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Replicas))
+	}
+	if m.ActiveNodes != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.ActiveNodes))
+	}
+	if m.InactiveNodes != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.InactiveNodes))
+	}
+	if m.ZeroCapacityNodes != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.ZeroCapacityNodes))
+	}
+	if m.MaxOverWeight != 0 {
+		n += 9
+	}
+	if m.MaxUnderWeight != 0 {
+		n += 9
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeBalance) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if m.Desired != 0 {
+		n += 9
+	}
+	if m.Assigned != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Assigned))
+	}
+	if m.Weight != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *TierBalance) Size() (n int) {
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Level))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Nodes != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Nodes))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if m.Desired != 0 {
+		n += 9
+	}
+	if m.Assigned != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Assigned))
+	}
+	if m.Weight != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *NodeSoftwareVersion) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SoftwareVersions) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if len(m.Failed) > 0 {
+		for _, e := range m.Failed {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.Nodes != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Nodes))
+	}
+	return n
+}
+
+func (m *SoftwareVersionGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeVersion) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.BatchSize))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Tier))
+	}
+	if m.HealthTimeout != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.HealthTimeout))
+	}
+	if len(m.Canaries) > 0 {
+		l = 0
+		for _, e := range m.Canaries {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	if m.Soak != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Soak))
+	}
+	return n
+}
+
+func (m *UpgradeStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.State))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.BatchSize))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Tier))
+	}
+	if m.Batch != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Batch))
+	}
+	if m.Batches != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Batches))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Started != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Started))
+	}
+	if m.Finished != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Finished))
+	}
+	if m.SoakUntil != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.SoakUntil))
+	}
+	return n
+}
+
+func (m *UpgradeNode) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Batch != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Batch))
+	}
+	if m.State != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.State))
+	}
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Canary {
+		n += 2
+	}
+	return n
+}
+
+func (m *NodeControlRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovSyndicateApi(uint64(e))
+		}
+		n += 1 + sovSyndicateApi(uint64(l)) + l
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Concurrency))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Tier))
+	}
+	if m.HealthTimeout != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.HealthTimeout))
+	}
+	if m.ContinueOnError {
+		n += 2
+	}
+	return n
+}
+
+func (m *NodeControlResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	if m.Failed != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Failed))
+	}
+	if m.Skipped != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Skipped))
+	}
+	return n
+}
+
+func (m *NodeControlStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Status {
+		n += 2
+	}
+	if m.Skipped {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Duration))
+	}
+	if m.Drain {
+		n += 2
+	}
+	if m.DrainTarget != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.DrainTarget))
+	}
+	if m.DrainStep != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.DrainStep))
+	}
+	if m.DrainInterval != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.DrainInterval))
+	}
+	if m.RestoreCapacity {
+		n += 2
+	}
+	return n
+}
+
+func (m *MaintenanceStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Started != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Started))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Expires))
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if m.OriginalCapacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.OriginalCapacity))
+	}
+	if m.DrainTarget != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.DrainTarget))
+	}
+	if m.DrainStep != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.DrainStep))
+	}
+	if m.DrainInterval != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.DrainInterval))
+	}
+	if m.RestoreCapacity {
+		n += 2
+	}
+	return n
+}
+
+func (m *MaintenanceList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RampRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	if m.Target != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Target))
+	}
+	if m.Step != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Step))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Interval))
+	}
+	if m.WaitConverged {
+		n += 2
+	}
+	if m.ConvergeTimeout != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.ConvergeTimeout))
+	}
+	return n
+}
+
+func (m *RampStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	if m.State != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.State))
+	}
+	if m.Start != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Start))
+	}
+	if m.Target != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Target))
+	}
+	if m.Step != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Step))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Interval))
+	}
+	if m.WaitConverged {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Capacity))
+	}
+	if m.Steps != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Steps))
+	}
+	if m.StepsDone != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.StepsDone))
+	}
+	if m.RingVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RingVersion))
+	}
+	if m.NextStep != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.NextStep))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Started != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Started))
+	}
+	if m.Finished != 0 {
+		n += 2 + sovSyndicateApi(uint64(m.Finished))
+	}
+	return n
+}
+
+func (m *RampList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ramps) > 0 {
+		for _, e := range m.Ramps {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecommissionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	if m.Ramp {
+		n += 2
+	}
+	if m.RampStep != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RampStep))
+	}
+	if m.RampInterval != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RampInterval))
+	}
+	if m.ConvergeTimeout != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.ConvergeTimeout))
+	}
+	if m.StopBackend {
+		n += 2
+	}
+	return n
+}
+
+func (m *DecommissionStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.State))
+	}
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Ramp {
+		n += 2
+	}
+	if m.RampStep != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RampStep))
+	}
+	if m.RampInterval != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RampInterval))
+	}
+	if m.ConvergeTimeout != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.ConvergeTimeout))
+	}
+	if m.StopBackend {
+		n += 2
+	}
+	if m.RingVersion != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.RingVersion))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Started != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Started))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Updated))
+	}
+	if m.Finished != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Finished))
+	}
+	return n
+}
+
+func (m *DecommissionList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovSyndicateApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeUpgrade) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Id))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeUpgradeStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RingMsg) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	l = len(m.Ring)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Deadline))
+	}
+	if m.Rollback != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Rollback))
+	}
+	return n
+}
+
+func (m *StoreResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	if m.Ring {
+		n += 2
+	}
+	if m.Builder {
+		n += 2
+	}
+	l = len(m.ErrMsg)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ring {
+		n += 2
+	}
+	if m.Builder {
+		n += 2
+	}
+	return n
+}
+
+func (m *StatusMsg) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSyndicateApi(uint64(m.Version))
+	}
+	l = len(m.Ringstats)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Builderstats)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	l = len(m.Master)
+	if l > 0 {
+		n += 1 + l + sovSyndicateApi(uint64(l))
+	}
+	return n
+}
+
+func sovSyndicateApi(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSyndicateApi(x uint64) (n int) {
+	return sovSyndicateApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmptyMsg) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmptyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmptyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingOpts) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingOpts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingOpts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Replicas |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conf = append(m.Conf[:0], data[iNdEx:postIndex]...)
+			if m.Conf == nil {
+				m.Conf = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModifyMsg) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModifyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModifyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingConf) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingConf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingConf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &RingStatus{}
+			}
+			if err := m.Status.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conf == nil {
+				m.Conf = &Conf{}
+			}
+			if err := m.Conf.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Conf) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Conf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Conf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conf = append(m.Conf[:0], data[iNdEx:postIndex]...)
+			if m.Conf == nil {
+				m.Conf = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartRequired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberID) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deltas = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingEventFilter) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingEventFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingEventFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v RingEventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (RingEventType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v RingEventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (RingEventType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nodes = append(m.Nodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nodes = append(m.Nodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (RingEventType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			m.PreviousVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PreviousVersion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			m.Node = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Node |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldNode == nil {
+				m.OldNode = &Node{}
+			}
+			if err := m.OldNode.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewNode == nil {
+				m.NewNode = &Node{}
+			}
+			if err := m.NewNode.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldConf = append(m.OldConf[:0], data[iNdEx:postIndex]...)
+			if m.OldConf == nil {
+				m.OldConf = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConf = append(m.NewConf[:0], data[iNdEx:postIndex]...)
+			if m.NewConf == nil {
+				m.NewConf = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldReplicas", wireType)
+			}
+			m.OldReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OldReplicas |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReplicas", wireType)
+			}
+			m.NewReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NewReplicas |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberList) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscribers = append(m.Subscribers, &SubscriberInfo{})
+			if err := m.Subscribers[len(m.Subscribers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
+			}
+			m.Connected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Connected |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVersion", wireType)
+			}
+			m.LastVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.LastVersion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSend", wireType)
+			}
+			m.LastSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.LastSend |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deltas = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hardware", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hardware == nil {
+				m.Hardware = &HardwareProfile{}
+			}
+			if err := m.Hardware.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplaceNodeRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplaceNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplaceNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hardware", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hardware == nil {
+				m.Hardware = &HardwareProfile{}
+			}
+			if err := m.Hardware.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HardwareProfile) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardwareProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardwareProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memtotal", wireType)
+			}
+			m.Memtotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Memtotal |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memfree", wireType)
+			}
+			m.Memfree = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Memfree |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
+			}
+			m.Cpus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Cpus |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disks = append(m.Disks, &Disk{})
+			if err := m.Disks[len(m.Disks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeHardware) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeHardware: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeHardware: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hardware", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hardware == nil {
+				m.Hardware = &HardwareProfile{}
+			}
+			if err := m.Hardware.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatusReport) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatusReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatusReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hardware", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hardware == nil {
+				m.Hardware = &HardwareProfile{}
+			}
+			if err := m.Hardware.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load1", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Load1 = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load5", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Load5 = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load15", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Load15 = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RingVersion", wireType)
+			}
+			m.RingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RingVersion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CapacityProposal) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapacityProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapacityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restore = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			m.Proposed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Proposed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fill", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Fill = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.State |= (CapacityProposalState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decided", wireType)
+			}
+			m.Decided = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Decided |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RingVersion", wireType)
+			}
+			m.RingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RingVersion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CapacityPolicyStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapacityPolicyStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapacityPolicyStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &CapacityProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &CapacityProposal{})
+			if err := m.History[len(m.History)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedNodes) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, &SimulatedNodes{})
+			if err := m.Add[len(m.Add)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Remove = append(m.Remove, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Remove = append(m.Remove, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capacity = append(m.Capacity, &Node{})
+			if err := m.Capacity[len(m.Capacity)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeProjection) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Simulated = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assigned", wireType)
+			}
+			m.Assigned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Assigned |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projected", wireType)
+			}
+			m.Projected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Projected |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reported = bool(v != 0)
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fill", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Fill = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedFill", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.ProjectedFill = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &RingStats{}
+			}
+			if err := m.Before.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &RingStats{}
+			}
+			if err := m.After.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Replicas |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moved", wireType)
+			}
+			m.Moved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Moved |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.MovedPercent = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeProjection{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterMultiRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterMultiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterMultiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &RegisterRequest{}
+			}
+			if err := m.Node.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceRegistration) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &NodeConfig{}
+			}
+			if err := m.Config.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RolledBack = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollbackError = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterMultiResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterMultiResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterMultiResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &ServiceRegistration{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Port |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Nodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Default = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceList) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceInfo{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceReload) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceReload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceReload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartRequired = append(m.RestartRequired, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigReloadResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigReloadResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigReloadResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceReload{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLevel = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Disk) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Disk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Disk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Used |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeConfig) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Localid", wireType)
+			}
+			m.Localid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Localid |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ring", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ring = append(m.Ring[:0], data[iNdEx:postIndex]...)
+			if m.Ring == nil {
+				m.Ring = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ring) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ring", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ring = append(m.Ring[:0], data[iNdEx:postIndex]...)
+			if m.Ring == nil {
+				m.Ring = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delta == nil {
+				m.Delta = &RingDelta{}
+			}
+			if err := m.Delta.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingDelta) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVersion", wireType)
+			}
+			m.BaseVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BaseVersion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemovedNodes = append(m.RemovedNodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemovedNodes = append(m.RemovedNodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedNodes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionAssignment{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfChanged = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conf = append(m.Conf[:0], data[iNdEx:postIndex]...)
+			if m.Conf == nil {
+				m.Conf = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionAssignment) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nodes = append(m.Nodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nodes = append(m.Nodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeQuery) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeQueryResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeQueryMatch{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionCounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartitionCounts = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeQueryMatch) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQueryMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQueryMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &Node{}
+			}
+			if err := m.Node.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			m.Partitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Partitions |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionLookup) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionLookup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionLookup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Previous = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyLookup) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyLookup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyLookup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], data[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Previous = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionLookupResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionLookupResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionLookupResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &PartitionReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			m.PreviousVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PreviousVersion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPartition", wireType)
+			}
+			m.PreviousPartition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PreviousPartition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReplicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousReplicas = append(m.PreviousReplicas, &PartitionReplica{})
+			if err := m.PreviousReplicas[len(m.PreviousReplicas)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionReplica) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionReplica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingStats) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			m.Partitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Partitions |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Replicas |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveNodes", wireType)
+			}
+			m.ActiveNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ActiveNodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveNodes", wireType)
+			}
+			m.InactiveNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.InactiveNodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroCapacityNodes", wireType)
+			}
+			m.ZeroCapacityNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ZeroCapacityNodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOverWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.MaxOverWeight = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnderWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.MaxUnderWeight = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeBalance{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, &TierBalance{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeBalance) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desired", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Desired = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assigned", wireType)
+			}
+			m.Assigned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Assigned |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Weight = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TierBalance) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TierBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TierBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Level |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Nodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desired", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Desired = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assigned", wireType)
+			}
+			m.Assigned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Assigned |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Weight = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSoftwareVersion) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSoftwareVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSoftwareVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SoftwareVersions) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SoftwareVersions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SoftwareVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &SoftwareVersionGroup{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failed = append(m.Failed, &NodeVersion{})
+			if err := m.Failed[len(m.Failed)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Nodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SoftwareVersionGroup) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SoftwareVersionGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SoftwareVersionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeVersion{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeVersion) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BatchSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Tier |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthTimeout", wireType)
+			}
+			m.HealthTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.HealthTimeout |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Canaries = append(m.Canaries, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Canaries = append(m.Canaries, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canaries", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soak", wireType)
+			}
+			m.Soak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Soak |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.State |= (UpgradeState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BatchSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Tier |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			m.Batch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Batch |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			m.Batches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Batches |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &UpgradeNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			m.Started = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Started |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			m.Finished = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Finished |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoakUntil", wireType)
+			}
+			m.SoakUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.SoakUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeNode) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			m.Batch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Batch |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.State |= (UpgradeNodeState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersion = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canary = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeControlRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeControlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeControlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSyndicateApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSyndicateApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSyndicateApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Concurrency |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Tier |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthTimeout", wireType)
+			}
+			m.HealthTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.HealthTimeout |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueOnError = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeControlResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeControlResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeControlResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeControlStatus{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Failed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			m.Skipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Skipped |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeControlStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeControlStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeControlStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drain = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainTarget", wireType)
+			}
+			m.DrainTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DrainTarget |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainStep", wireType)
+			}
+			m.DrainStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DrainStep |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainInterval", wireType)
+			}
+			m.DrainInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DrainInterval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreCapacity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestoreCapacity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyndicateApi(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyndicateApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			m.Started = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Started |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Expires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalCapacity", wireType)
+			}
+			m.OriginalCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OriginalCapacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainTarget", wireType)
+			}
+			m.DrainTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DrainTarget |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainStep", wireType)
+			}
+			m.DrainStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DrainStep |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainInterval", wireType)
+			}
+			m.DrainInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DrainInterval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreCapacity", wireType)
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EmptyMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmptyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.RestoreCapacity = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSyndicateApi(data[iNdEx:])
@@ -2361,7 +18217,7 @@ func (m *EmptyMsg) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *RingStatus) Unmarshal(data []byte) error {
+func (m *MaintenanceList) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,17 +18240,17 @@ func (m *RingStatus) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RingStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2404,31 +18260,23 @@ func (m *RingStatus) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSyndicateApi
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSyndicateApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Version |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &MaintenanceStatus{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSyndicateApi(data[iNdEx:])
@@ -2450,7 +18298,7 @@ func (m *RingStatus) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *RingOpts) Unmarshal(data []byte) error {
+func (m *RampRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2473,17 +18321,17 @@ func (m *RingOpts) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RingOpts: wiretype end group for non-group")
+			return fmt.Errorf("proto: RampRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RingOpts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Replicas = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2493,7 +18341,103 @@ func (m *RingOpts) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Replicas |= (int32(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Target |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Step |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Interval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitConverged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitConverged = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergeTimeout", wireType)
+			}
+			m.ConvergeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ConvergeTimeout |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2519,7 +18463,7 @@ func (m *RingOpts) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Node) Unmarshal(data []byte) error {
+func (m *RampStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2542,10 +18486,10 @@ func (m *Node) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: RampStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RampStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2569,9 +18513,9 @@ func (m *Node) Unmarshal(data []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2581,17 +18525,16 @@ func (m *Node) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.State |= (RampState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.Capacity = 0
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2601,16 +18544,16 @@ func (m *Node) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Capacity |= (uint32(b) & 0x7F) << shift
+				m.Start |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var stringLen uint64
+			m.Target = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2620,26 +18563,16 @@ func (m *Node) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Target |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tiers = append(m.Tiers, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var stringLen uint64
+			m.Step = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2649,26 +18582,16 @@ func (m *Node) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Step |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2678,26 +18601,55 @@ func (m *Node) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Interval |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitConverged", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Meta = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			m.WaitConverged = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var byteLen int
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			m.Steps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2707,78 +18659,16 @@ func (m *Node) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Steps |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conf = append(m.Conf[:0], data[iNdEx:postIndex]...)
-			if m.Conf == nil {
-				m.Conf = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ModifyMsg) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepsDone", wireType)
 			}
-			var stringLen uint64
+			m.StepsDone = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2788,26 +18678,16 @@ func (m *ModifyMsg) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.StepsDone |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RingVersion", wireType)
 			}
-			var stringLen uint64
+			m.RingVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2817,26 +18697,16 @@ func (m *ModifyMsg) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RingVersion |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStep", wireType)
 			}
-			m.Id = 0
+			m.NextStep = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2846,66 +18716,16 @@ func (m *ModifyMsg) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Id |= (uint64(b) & 0x7F) << shift
+				m.NextStep |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RingConf) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RingConf: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RingConf: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2915,30 +18735,26 @@ func (m *RingConf) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSyndicateApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &RingStatus{}
-			}
-			if err := m.Status.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -2948,80 +18764,26 @@ func (m *RingConf) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSyndicateApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Conf == nil {
-				m.Conf = &Conf{}
-			}
-			if err := m.Conf.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Caller = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Conf) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Conf: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Conf: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var byteLen int
+			m.Started = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3031,28 +18793,16 @@ func (m *Conf) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Started |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conf = append(m.Conf[:0], data[iNdEx:postIndex]...)
-			if m.Conf == nil {
-				m.Conf = []byte{}
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var v int
+			m.Finished = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3062,12 +18812,11 @@ func (m *Conf) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Finished |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RestartRequired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSyndicateApi(data[iNdEx:])
@@ -3089,7 +18838,7 @@ func (m *Conf) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *SubscriberID) Unmarshal(data []byte) error {
+func (m *RampList) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3112,17 +18861,17 @@ func (m *SubscriberID) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriberID: wiretype end group for non-group")
+			return fmt.Errorf("proto: RampList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriberID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RampList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3132,20 +18881,22 @@ func (m *SubscriberID) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSyndicateApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(data[iNdEx:postIndex])
+			m.Ramps = append(m.Ramps, &RampStatus{})
+			if err := m.Ramps[len(m.Ramps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3168,7 +18919,7 @@ func (m *SubscriberID) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *RegisterRequest) Unmarshal(data []byte) error {
+func (m *DecommissionRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,17 +18942,17 @@ func (m *RegisterRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecommissionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3211,26 +18962,16 @@ func (m *RegisterRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hostname = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramp", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3240,26 +18981,17 @@ func (m *RegisterRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addrs = append(m.Addrs, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Ramp = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampStep", wireType)
 			}
-			var stringLen uint64
+			m.RampStep = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3269,26 +19001,16 @@ func (m *RegisterRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RampStep |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tiers = append(m.Tiers, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hardware", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampInterval", wireType)
 			}
-			var msglen int
+			m.RampInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3298,25 +19020,50 @@ func (m *RegisterRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RampInterval |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSyndicateApi
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergeTimeout", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ConvergeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ConvergeTimeout |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Hardware == nil {
-				m.Hardware = &HardwareProfile{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopBackend", wireType)
 			}
-			if err := m.Hardware.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.StopBackend = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSyndicateApi(data[iNdEx:])
@@ -3338,7 +19085,7 @@ func (m *RegisterRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *HardwareProfile) Unmarshal(data []byte) error {
+func (m *DecommissionStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3361,17 +19108,17 @@ func (m *HardwareProfile) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HardwareProfile: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecommissionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HardwareProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecommissionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memtotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Memtotal = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3381,16 +19128,16 @@ func (m *HardwareProfile) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Memtotal |= (uint64(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memfree", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Memfree = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3400,16 +19147,26 @@ func (m *HardwareProfile) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Memfree |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyndicateApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Cpus = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3419,16 +19176,16 @@ func (m *HardwareProfile) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Cpus |= (int64(b) & 0x7F) << shift
+				m.State |= (DecommissionState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3438,76 +19195,15 @@ func (m *HardwareProfile) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Disks = append(m.Disks, &Disk{})
-			if err := m.Disks[len(m.Disks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Disk) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Disk: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Disk: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Failed = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3532,13 +19228,52 @@ func (m *Disk) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Device = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ramp = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampStep", wireType)
+			}
+			m.RampStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyndicateApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RampStep |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampInterval", wireType)
 			}
-			var stringLen uint64
+			m.RampInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3548,26 +19283,16 @@ func (m *Disk) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RampInterval |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergeTimeout", wireType)
 			}
-			m.Size_ = 0
+			m.ConvergeTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3577,16 +19302,16 @@ func (m *Disk) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Size_ |= (uint64(b) & 0x7F) << shift
+				m.ConvergeTimeout |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopBackend", wireType)
 			}
-			m.Used = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3596,66 +19321,17 @@ func (m *Disk) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Used |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeConfig) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StopBackend = bool(v != 0)
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Localid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RingVersion", wireType)
 			}
-			m.Localid = 0
+			m.RingVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3665,16 +19341,16 @@ func (m *NodeConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Localid |= (uint64(b) & 0x7F) << shift
+				m.RingVersion |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ring", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3684,78 +19360,26 @@ func (m *NodeConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSyndicateApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ring = append(m.Ring[:0], data[iNdEx:postIndex]...)
-			if m.Ring == nil {
-				m.Ring = []byte{}
-			}
+			m.Caller = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Ring) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Ring: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ring: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.Version = 0
+			m.Started = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3765,16 +19389,16 @@ func (m *Ring) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Version |= (int64(b) & 0x7F) << shift
+				m.Started |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ring", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var byteLen int
+			m.Updated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3784,78 +19408,16 @@ func (m *Ring) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Updated |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ring = append(m.Ring[:0], data[iNdEx:postIndex]...)
-			if m.Ring == nil {
-				m.Ring = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSyndicateApi(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SearchResult) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSyndicateApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SearchResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var msglen int
+			m.Finished = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3865,23 +19427,11 @@ func (m *SearchResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Finished |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSyndicateApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nodes = append(m.Nodes, &Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSyndicateApi(data[iNdEx:])
@@ -3903,7 +19453,7 @@ func (m *SearchResult) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *NodeSoftwareVersion) Unmarshal(data []byte) error {
+func (m *DecommissionList) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3926,17 +19476,17 @@ func (m *NodeSoftwareVersion) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSoftwareVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecommissionList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSoftwareVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecommissionList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSyndicateApi
@@ -3946,20 +19496,22 @@ func (m *NodeSoftwareVersion) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSyndicateApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(data[iNdEx:postIndex])
+			m.Nodes = append(m.Nodes, &DecommissionStatus{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4838,68 +20390,261 @@ var (
 )
 
 var fileDescriptorSyndicateApi = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0x8f, 0xeb, 0x73, 0xec, 0x1b, 0xdb, 0xb5, 0x73, 0xa9, 0x8a, 0xb1, 0x50, 0x55, 0x4e, 0x02,
-	0x05, 0xaa, 0x86, 0xe0, 0xc0, 0x13, 0x2f, 0x84, 0xb4, 0x6a, 0x91, 0x08, 0x20, 0x9b, 0xf2, 0x06,
-	0x68, 0x7d, 0xb7, 0x76, 0x56, 0x39, 0xfb, 0x8e, 0xdd, 0xb5, 0x51, 0xf8, 0x24, 0x7c, 0x21, 0x24,
-	0x1e, 0x78, 0xe0, 0x23, 0x20, 0xf8, 0x22, 0xcc, 0xec, 0xee, 0x39, 0xeb, 0xab, 0xa9, 0xfc, 0x10,
-	0xb9, 0x3b, 0x37, 0xbf, 0xf9, 0xf3, 0x9b, 0x9d, 0xdf, 0x16, 0x8e, 0xd5, 0xed, 0x32, 0x15, 0x09,
-	0xd3, 0xfc, 0x27, 0x56, 0x88, 0xd3, 0x42, 0xe6, 0x3a, 0x8f, 0x1a, 0xe6, 0x27, 0x06, 0x68, 0x3d,
-	0x5f, 0x14, 0xfa, 0xf6, 0x4a, 0xcd, 0xe3, 0xa7, 0x00, 0x63, 0xb1, 0x9c, 0x4f, 0x34, 0xd3, 0x2b,
-	0x15, 0xdd, 0x87, 0x43, 0x65, 0xfe, 0x35, 0xa8, 0x3d, 0xae, 0x9d, 0xb4, 0xa2, 0x1e, 0x34, 0xd7,
-	0x5c, 0x2a, 0x91, 0x2f, 0x07, 0xf7, 0xd0, 0x50, 0x8f, 0xdf, 0x81, 0x16, 0xb9, 0x7f, 0x53, 0x68,
-	0x15, 0xf5, 0xa1, 0x25, 0x79, 0x91, 0x61, 0x12, 0xeb, 0xde, 0x88, 0x25, 0x04, 0x5f, 0xe7, 0x29,
-	0x8f, 0x00, 0xee, 0x89, 0xd4, 0xd8, 0x02, 0x0a, 0xc9, 0x12, 0x2d, 0xd6, 0xdc, 0x44, 0x68, 0x11,
-	0x2a, 0x61, 0x05, 0x4b, 0x84, 0xbe, 0x1d, 0xd4, 0xd1, 0xd2, 0x8d, 0xba, 0xd0, 0xd0, 0x02, 0xb3,
-	0x0c, 0x82, 0xc7, 0xf5, 0x93, 0x30, 0x3a, 0x82, 0x90, 0xa5, 0xa9, 0xe4, 0x4a, 0x71, 0x35, 0x68,
-	0x18, 0x53, 0x07, 0x82, 0x05, 0xd7, 0x6c, 0x70, 0x88, 0xfe, 0xe6, 0x94, 0xe4, 0xcb, 0xd9, 0xa0,
-	0x89, 0xa7, 0x4e, 0x7c, 0x0e, 0xe1, 0x55, 0x9e, 0x8a, 0x19, 0x75, 0x13, 0xb5, 0xa1, 0x7e, 0xc3,
-	0x6f, 0x4d, 0xe6, 0x90, 0xe2, 0xae, 0x59, 0xb6, 0xb2, 0x89, 0x43, 0x57, 0x14, 0xa5, 0x0c, 0xe2,
-	0x97, 0xb6, 0x8d, 0x4b, 0x0c, 0x13, 0xbd, 0xbb, 0xd5, 0x73, 0x7b, 0x74, 0x64, 0xc9, 0x3a, 0xf5,
-	0x68, 0x79, 0xdb, 0x65, 0xbc, 0x67, 0x1c, 0xda, 0xce, 0x81, 0xd0, 0xc8, 0x5f, 0x60, 0xa2, 0x94,
-	0x45, 0x51, 0x8c, 0x4e, 0xf4, 0x16, 0xf4, 0xb0, 0x01, 0xcd, 0xa4, 0x1e, 0xf3, 0x9f, 0x57, 0x42,
-	0xf2, 0xd4, 0x76, 0x1f, 0x0f, 0xa1, 0x33, 0x59, 0x4d, 0x55, 0x22, 0xc5, 0x94, 0xcb, 0x2f, 0x9f,
-	0x79, 0x4c, 0x85, 0xf1, 0x0d, 0xf4, 0xc6, 0x7c, 0x2e, 0x94, 0xe6, 0x92, 0x50, 0x88, 0x27, 0xb2,
-	0xae, 0x73, 0xa5, 0x97, 0x6c, 0xc1, 0xef, 0x9a, 0x22, 0x76, 0x14, 0xc6, 0xab, 0xdb, 0xa3, 0xe5,
-	0xae, 0x6e, 0x8e, 0x27, 0xe8, 0xcf, 0x64, 0xfa, 0x0b, 0x93, 0x1c, 0xd9, 0xa4, 0x62, 0x1f, 0xba,
-	0x62, 0x5f, 0x3a, 0xf3, 0xb7, 0x32, 0x9f, 0x89, 0x8c, 0xc7, 0x3f, 0x42, 0xaf, 0x62, 0xa2, 0x64,
-	0x0b, 0xbe, 0xd0, 0xb9, 0x66, 0x99, 0x9b, 0x1d, 0x8e, 0x1f, 0x2d, 0x33, 0xc9, 0x2d, 0x87, 0x81,
-	0xe9, 0xb2, 0x58, 0x29, 0xc3, 0x62, 0x3d, 0x1a, 0x42, 0x23, 0x15, 0xea, 0xc6, 0x0e, 0xee, 0x8e,
-	0x97, 0x67, 0x68, 0x8b, 0x3f, 0x87, 0x80, 0x7e, 0x69, 0xfc, 0x29, 0x5f, 0x8b, 0xa4, 0xac, 0x1f,
-	0x23, 0x14, 0x4c, 0x5f, 0xbb, 0x99, 0xe0, 0x49, 0x89, 0x5f, 0xb9, 0x9d, 0x0a, 0x9d, 0x56, 0x0a,
-	0xa9, 0x0a, 0xcc, 0x8c, 0x9e, 0x00, 0xd0, 0x65, 0x22, 0x76, 0xc5, 0x9c, 0x4a, 0xc9, 0xf2, 0x84,
-	0x65, 0x9b, 0x7b, 0x85, 0xce, 0x12, 0x27, 0x64, 0x02, 0x75, 0xe2, 0xf7, 0x20, 0xa0, 0x79, 0xf9,
-	0x17, 0xb6, 0x66, 0x6a, 0xdc, 0x76, 0xfb, 0x10, 0xe9, 0xe7, 0x4c, 0x26, 0xd7, 0x63, 0xae, 0x56,
-	0x99, 0xa6, 0x0e, 0x96, 0x98, 0x83, 0x46, 0xef, 0x77, 0x40, 0x79, 0xe3, 0xf7, 0xe1, 0x98, 0x7e,
-	0x27, 0xf9, 0x4c, 0x13, 0x4b, 0xdf, 0xdb, 0xb0, 0xd5, 0x0c, 0x21, 0xc6, 0x6c, 0x93, 0xdf, 0xab,
-	0x62, 0x2e, 0x59, 0xe5, 0xee, 0x57, 0xd6, 0x27, 0x8c, 0xcf, 0xe0, 0xc8, 0xf3, 0xfd, 0x9f, 0xa5,
-	0xc3, 0x4b, 0xbc, 0x50, 0x73, 0x87, 0xf8, 0x01, 0x9a, 0xd4, 0x18, 0x5d, 0xee, 0x37, 0xf7, 0x46,
-	0x9f, 0xa7, 0x2b, 0x91, 0xa5, 0x5c, 0x1a, 0x3a, 0x3b, 0x34, 0xcf, 0x94, 0xb3, 0x34, 0x13, 0x4b,
-	0x7b, 0x19, 0xea, 0x66, 0x63, 0xf3, 0x2c, 0x9b, 0xb2, 0xe4, 0x06, 0x37, 0x8b, 0xf6, 0xf9, 0x0a,
-	0xda, 0x13, 0x9d, 0x4b, 0xee, 0xf8, 0x78, 0x63, 0x8a, 0x56, 0x35, 0x45, 0x8b, 0x4a, 0x7f, 0x2e,
-	0x25, 0x16, 0x67, 0x12, 0x84, 0xf1, 0x29, 0x74, 0x6d, 0x53, 0xe5, 0x05, 0x2e, 0xf1, 0xb5, 0x2a,
-	0xde, 0xae, 0xc3, 0x2b, 0x08, 0xad, 0xff, 0xce, 0xfe, 0x50, 0x09, 0x08, 0x4c, 0xe4, 0x28, 0x77,
-	0x61, 0x1e, 0x40, 0xc7, 0x45, 0xb0, 0xd6, 0xba, 0xb1, 0x62, 0x19, 0x0b, 0x46, 0x7b, 0x63, 0xcb,
-	0x18, 0xfd, 0xde, 0xc4, 0xb8, 0xa5, 0xfe, 0x45, 0x4f, 0xa0, 0x79, 0x91, 0xa6, 0x46, 0x98, 0xfc,
-	0x01, 0x0f, 0x5f, 0x5f, 0xf4, 0xf8, 0x20, 0x3a, 0x45, 0x3d, 0xe4, 0x8b, 0x7c, 0xcd, 0xf7, 0xf4,
-	0x3f, 0x83, 0x26, 0xca, 0x8f, 0x71, 0xee, 0xbb, 0xef, 0x1b, 0x39, 0xda, 0x8d, 0xc0, 0x72, 0x26,
-	0x5c, 0x1b, 0xd1, 0xf0, 0x95, 0x64, 0xb7, 0xf3, 0x39, 0xce, 0x87, 0xa3, 0x88, 0x58, 0x99, 0x8d,
-	0x7a, 0x9e, 0x0f, 0x69, 0xf0, 0x6e, 0xd0, 0x53, 0xec, 0x9e, 0xeb, 0x0b, 0xa3, 0xba, 0x7b, 0xb4,
-	0xf0, 0x91, 0xc9, 0x71, 0xe9, 0x44, 0x79, 0x0f, 0xc0, 0x27, 0xd0, 0xa7, 0x8a, 0x58, 0xc2, 0x2f,
-	0x4a, 0xa1, 0xde, 0x8b, 0xa9, 0x8e, 0x43, 0x7d, 0x47, 0x8a, 0xb5, 0x07, 0x62, 0x04, 0xf0, 0x82,
-	0xeb, 0xcd, 0xe2, 0x39, 0x97, 0xf2, 0xe9, 0xda, 0x8d, 0xf9, 0x14, 0x7a, 0x88, 0x79, 0x91, 0xe5,
-	0x53, 0x96, 0x95, 0xd2, 0x51, 0x05, 0xfa, 0x2c, 0x1a, 0x11, 0x27, 0x0e, 0xba, 0x08, 0xf3, 0xf4,
-	0x66, 0xab, 0xba, 0x1d, 0x80, 0x4b, 0x78, 0xe8, 0x00, 0x55, 0x81, 0xd8, 0x42, 0x0e, 0xbd, 0x43,
-	0xc5, 0x11, 0x83, 0x7c, 0x05, 0x43, 0x5f, 0x0e, 0x2a, 0x81, 0x22, 0x0f, 0xeb, 0x5c, 0x86, 0x83,
-	0xd7, 0x6d, 0x9b, 0xd6, 0x3f, 0xa6, 0x39, 0x92, 0xb8, 0xd1, 0xc7, 0x0a, 0xbf, 0xc7, 0xee, 0xe0,
-	0xab, 0x1f, 0x42, 0x3e, 0x80, 0x26, 0x76, 0x61, 0x95, 0xb3, 0xca, 0x52, 0xdb, 0x6b, 0xda, 0x10,
-	0xdb, 0x75, 0xae, 0x13, 0x2d, 0x39, 0x5b, 0x44, 0x9b, 0x90, 0xde, 0x7b, 0x56, 0x01, 0x9d, 0xd5,
-	0xa2, 0xcf, 0x68, 0xea, 0xf6, 0x51, 0x33, 0x4b, 0x52, 0xbe, 0x47, 0x95, 0x97, 0x6e, 0x33, 0xcc,
-	0xbb, 0x11, 0xc4, 0x07, 0xa3, 0x3f, 0x6b, 0xf6, 0x9d, 0xc6, 0x97, 0x44, 0xe3, 0xad, 0x6e, 0x18,
-	0xa9, 0x8a, 0xee, 0x7b, 0x39, 0xa8, 0xd0, 0x92, 0x27, 0x4f, 0xc8, 0xcc, 0x22, 0x1f, 0x8e, 0x39,
-	0xea, 0x89, 0xde, 0xd3, 0x7f, 0x04, 0x87, 0x4e, 0x8f, 0x1f, 0x6c, 0xbe, 0x7b, 0x4a, 0x36, 0xec,
-	0x6f, 0x59, 0xe9, 0xbf, 0x4e, 0x07, 0xa6, 0x24, 0xae, 0x57, 0xc5, 0x7e, 0x29, 0xbe, 0xe8, 0xff,
-	0xf1, 0xcf, 0xa3, 0xda, 0x5f, 0xf8, 0xf7, 0x37, 0xfe, 0xfd, 0xf6, 0xef, 0xa3, 0x83, 0xe9, 0xa1,
-	0x71, 0x3b, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa4, 0xcc, 0x89, 0x79, 0xae, 0x09, 0x00, 0x00,
+	// 4096 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x3a, 0x4b, 0x73, 0x23, 0xd7,
+	0x5a, 0x6e, 0xeb, 0xfd, 0xe9, 0xdd, 0xb6, 0xc7, 0x1a, 0x27, 0xe4, 0x4e, 0x9a, 0x54, 0xae, 0xaf,
+	0xc3, 0x84, 0x64, 0x42, 0x08, 0x90, 0xc0, 0x5c, 0x8d, 0xa4, 0x71, 0x94, 0xb1, 0x25, 0x21, 0x79,
+	0x26, 0x40, 0xd5, 0x65, 0xe8, 0x51, 0x1f, 0xdb, 0x7d, 0xdd, 0xea, 0xee, 0xdb, 0xdd, 0xf2, 0x8c,
+	0x6f, 0x51, 0x6c, 0xa8, 0xfb, 0x1f, 0x58, 0xc0, 0x8a, 0x62, 0x41, 0x15, 0x0b, 0x56, 0x2c, 0x80,
+	0x1f, 0x70, 0x17, 0x50, 0xc5, 0x92, 0x1d, 0x54, 0x60, 0x4b, 0xb1, 0x64, 0x47, 0x51, 0xdf, 0x77,
+	0xce, 0xe9, 0xb7, 0x26, 0x1e, 0x6e, 0x56, 0xb6, 0xbe, 0xf3, 0xf8, 0xde, 0xcf, 0x3e, 0xb0, 0xe3,
+	0xdf, 0xd8, 0x86, 0xb9, 0xd4, 0x03, 0xf6, 0x5c, 0x77, 0xcd, 0x0f, 0x5d, 0xcf, 0x09, 0x1c, 0xb5,
+	0x44, 0x7f, 0x34, 0x80, 0xea, 0x68, 0xe5, 0x06, 0x37, 0xa7, 0xfe, 0x85, 0x76, 0x1f, 0x60, 0x6e,
+	0xda, 0x17, 0x8b, 0x40, 0x0f, 0xd6, 0xbe, 0xda, 0x82, 0xb2, 0x4f, 0xff, 0xf5, 0x94, 0x7b, 0xca,
+	0x61, 0x55, 0x6d, 0x43, 0xe5, 0x9a, 0x79, 0xbe, 0xe9, 0xd8, 0xbd, 0xed, 0x7b, 0xca, 0x61, 0x41,
+	0x7b, 0x1b, 0xaa, 0xb8, 0x7d, 0xea, 0x06, 0xbe, 0xda, 0x81, 0xaa, 0xc7, 0x5c, 0xcb, 0x5c, 0xea,
+	0x7c, 0x7b, 0x49, 0xf3, 0xa0, 0x38, 0x71, 0x0c, 0xa6, 0x02, 0x6c, 0x9b, 0x06, 0xc1, 0x8a, 0x78,
+	0xa5, 0xbe, 0x0c, 0xcc, 0x6b, 0x46, 0x37, 0x54, 0xf1, 0xd4, 0x52, 0x77, 0xf5, 0xa5, 0x19, 0xdc,
+	0xf4, 0x0a, 0xf7, 0x94, 0xc3, 0xa6, 0xda, 0x84, 0x52, 0x60, 0x32, 0xcf, 0xef, 0x15, 0xef, 0x15,
+	0x0e, 0x6b, 0x6a, 0x17, 0x6a, 0xba, 0x61, 0x78, 0xcc, 0xf7, 0x99, 0xdf, 0x2b, 0x11, 0xa8, 0x01,
+	0xc5, 0x15, 0x0b, 0xf4, 0x5e, 0xf9, 0x9e, 0xc2, 0x7f, 0x2d, 0x1d, 0xfb, 0xbc, 0x57, 0xb9, 0xa7,
+	0x1c, 0x36, 0xb4, 0x4f, 0xa0, 0x76, 0xea, 0x18, 0xe6, 0x39, 0x72, 0xa3, 0xd6, 0xa1, 0x70, 0xc5,
+	0x6e, 0x08, 0x73, 0x0d, 0xef, 0xbd, 0xd6, 0xad, 0x35, 0x47, 0x5c, 0x13, 0x44, 0x21, 0xca, 0xa2,
+	0xf6, 0x25, 0x67, 0x63, 0xe0, 0xd8, 0xe7, 0xea, 0xbb, 0x09, 0x9e, 0xeb, 0x0f, 0xba, 0x5c, 0x58,
+	0x1f, 0xc6, 0xc4, 0x72, 0x57, 0x60, 0xdc, 0xa6, 0x0d, 0x75, 0xb1, 0x01, 0x4f, 0x6b, 0xf7, 0xa1,
+	0x48, 0xb7, 0x48, 0xa2, 0xf0, 0x8e, 0x86, 0xba, 0x0f, 0x6d, 0x8f, 0xf9, 0x81, 0xee, 0x05, 0x73,
+	0xf6, 0x93, 0xb5, 0xe9, 0x31, 0x83, 0x73, 0xaf, 0x7d, 0x0e, 0x8d, 0xc5, 0xfa, 0x85, 0xbf, 0xf4,
+	0xcc, 0x17, 0xcc, 0x1b, 0x0f, 0x63, 0x92, 0xaa, 0x65, 0x84, 0x8d, 0xa2, 0x33, 0x98, 0x15, 0xe8,
+	0x3e, 0x51, 0x5d, 0xd5, 0x46, 0xd0, 0x46, 0xa2, 0x46, 0xd7, 0xcc, 0x0e, 0x1e, 0x9b, 0x56, 0xc0,
+	0x3c, 0xf5, 0x97, 0xa1, 0x14, 0xdc, 0xb8, 0x0c, 0x69, 0x2f, 0x1c, 0xb6, 0x1e, 0xec, 0xc6, 0x68,
+	0xa7, 0x6d, 0x67, 0x37, 0x2e, 0x43, 0x41, 0xd8, 0x8e, 0xc1, 0xfc, 0xde, 0xf6, 0xbd, 0xc2, 0x61,
+	0x51, 0xfb, 0x6f, 0x05, 0x6a, 0xe1, 0x06, 0x55, 0x83, 0x22, 0xde, 0x40, 0x34, 0x6c, 0xba, 0x20,
+	0x43, 0xd9, 0x3e, 0xb4, 0x5d, 0x8f, 0x5d, 0x9b, 0xce, 0xda, 0x7f, 0x26, 0x16, 0x0a, 0xb4, 0xd0,
+	0x80, 0x22, 0xa2, 0xea, 0x15, 0x49, 0xf7, 0x6f, 0x43, 0xc5, 0xb1, 0x0c, 0x34, 0x89, 0x5e, 0x29,
+	0x21, 0x3a, 0x04, 0xe1, 0xaa, 0xcd, 0x5e, 0xd2, 0x6a, 0x39, 0xbb, 0xda, 0xa6, 0xb3, 0x83, 0x50,
+	0xd1, 0x08, 0xb0, 0xd9, 0x4b, 0x02, 0x54, 0x09, 0xb0, 0x03, 0x75, 0xc7, 0x32, 0xe6, 0xd2, 0x04,
+	0x6b, 0x68, 0x82, 0x08, 0xb4, 0xd9, 0xcb, 0x10, 0x08, 0x64, 0x97, 0xa7, 0xd0, 0x8a, 0xa4, 0x7e,
+	0x62, 0xfa, 0x41, 0x9c, 0x23, 0x85, 0x08, 0x3f, 0x82, 0xba, 0x1f, 0x6e, 0xe1, 0x92, 0xaa, 0x3f,
+	0xd8, 0x13, 0x04, 0xc5, 0x54, 0x66, 0x9f, 0x3b, 0x5a, 0x00, 0xad, 0x24, 0x24, 0xa1, 0xc6, 0x06,
+	0x14, 0x5d, 0xc6, 0x3c, 0x61, 0x75, 0x5d, 0xa8, 0x2d, 0x1d, 0xdb, 0x66, 0xcb, 0x80, 0x19, 0x42,
+	0x46, 0x3b, 0x50, 0xb7, 0x74, 0x3f, 0x90, 0x82, 0x2b, 0x12, 0xb0, 0x03, 0x55, 0x04, 0x2e, 0x98,
+	0x6d, 0xf4, 0x4a, 0x29, 0xed, 0x97, 0x49, 0xfb, 0x57, 0xd0, 0x9e, 0xb3, 0x0b, 0xd3, 0x0f, 0x98,
+	0x87, 0x46, 0xc5, 0xfc, 0x00, 0x0f, 0x5d, 0x3a, 0x7e, 0x60, 0xeb, 0x2b, 0x16, 0xd9, 0x3c, 0x3a,
+	0x0f, 0x67, 0xa0, 0x16, 0xb9, 0x56, 0x81, 0x7e, 0x1e, 0x42, 0xf5, 0x52, 0xf7, 0x8c, 0x97, 0xba,
+	0xc7, 0x35, 0x54, 0x7f, 0x70, 0x47, 0x70, 0xf8, 0xa5, 0x00, 0xcf, 0x3c, 0xe7, 0xdc, 0xb4, 0x98,
+	0x76, 0x05, 0x2a, 0xca, 0x50, 0x5f, 0x32, 0x54, 0x86, 0xc4, 0x17, 0xf7, 0xeb, 0x38, 0xee, 0xed,
+	0x24, 0xee, 0x37, 0x45, 0xf6, 0x87, 0xd0, 0x4e, 0x81, 0xf0, 0xf6, 0x15, 0x5b, 0x05, 0x4e, 0xa0,
+	0x5b, 0x02, 0x5f, 0x1b, 0x2a, 0x2b, 0xb6, 0x3a, 0xf7, 0x18, 0x47, 0x57, 0x24, 0x8f, 0x73, 0xd7,
+	0xbe, 0x10, 0xea, 0x01, 0x94, 0x0c, 0xd3, 0xbf, 0xe2, 0x41, 0x24, 0x32, 0xa5, 0xa1, 0xe9, 0x5f,
+	0x69, 0x17, 0xd0, 0x40, 0x2e, 0x24, 0x8e, 0x6f, 0x61, 0x23, 0x4e, 0x77, 0xe1, 0x75, 0x74, 0x23,
+	0x49, 0x6b, 0xd7, 0xd0, 0x51, 0xb3, 0xa4, 0x44, 0xed, 0x2f, 0x14, 0xe8, 0x20, 0x26, 0x1e, 0x36,
+	0xe6, 0xcc, 0x75, 0xbc, 0xe0, 0x3b, 0xc3, 0xd6, 0x84, 0x92, 0xe5, 0xe8, 0xc6, 0xc7, 0x84, 0x4b,
+	0x91, 0x3f, 0x3f, 0x25, 0x6b, 0x51, 0xd0, 0x5a, 0x68, 0xf5, 0x53, 0xb2, 0x16, 0x05, 0x8d, 0xcc,
+	0x33, 0xed, 0x0b, 0x69, 0x64, 0x15, 0xa2, 0xef, 0x3f, 0x15, 0xe8, 0x0c, 0x44, 0xf0, 0x9d, 0x79,
+	0x8e, 0xeb, 0xf8, 0xba, 0x95, 0xa0, 0xaf, 0x0d, 0x15, 0x8c, 0x5b, 0x8e, 0xb7, 0x39, 0x5a, 0x77,
+	0xa0, 0xea, 0xd2, 0x51, 0xc1, 0x75, 0x13, 0x15, 0x71, 0x6e, 0x5a, 0x96, 0x20, 0xe4, 0x03, 0x28,
+	0x61, 0x38, 0xe5, 0x3e, 0xdd, 0x7a, 0xf0, 0xb6, 0x0c, 0x96, 0x29, 0xb4, 0x28, 0x22, 0xe2, 0x89,
+	0x79, 0x9e, 0xe3, 0x11, 0x7d, 0x35, 0x64, 0x62, 0xa9, 0x5b, 0x16, 0xf3, 0x7a, 0x55, 0x19, 0x11,
+	0x97, 0x1e, 0x23, 0x01, 0xd7, 0x48, 0xcb, 0x6d, 0xa8, 0x18, 0x6c, 0x69, 0x1a, 0xcc, 0xe8, 0x81,
+	0xf4, 0xa5, 0x38, 0x9b, 0x75, 0x62, 0xf3, 0x15, 0xec, 0x86, 0xe8, 0x1c, 0xcb, 0x5c, 0xde, 0x88,
+	0x30, 0x8e, 0x69, 0x04, 0xa3, 0x8d, 0x22, 0x24, 0x5f, 0x71, 0x99, 0x6d, 0x98, 0xf6, 0x85, 0xf0,
+	0xf6, 0xfd, 0x0d, 0xa4, 0xe2, 0xce, 0x4b, 0x13, 0xa5, 0x72, 0xd3, 0x2b, 0xbc, 0x76, 0xa7, 0x36,
+	0x81, 0xd6, 0xc2, 0x5c, 0xad, 0x2d, 0x24, 0x19, 0x0d, 0xc1, 0x47, 0x0e, 0x97, 0xce, 0xda, 0x0e,
+	0x7a, 0x8a, 0x94, 0x5e, 0x28, 0xcf, 0xed, 0x64, 0xf6, 0x2b, 0x24, 0x52, 0x1d, 0x8a, 0xb6, 0xa6,
+	0x79, 0xd0, 0x96, 0xf7, 0x49, 0x1f, 0xd4, 0xa0, 0xa0, 0x1b, 0x46, 0x4f, 0x49, 0x06, 0xa8, 0x24,
+	0xd2, 0x16, 0x94, 0x3d, 0xb6, 0x72, 0x28, 0xe7, 0x16, 0x0e, 0x8b, 0xea, 0x2f, 0x25, 0xb4, 0x58,
+	0x48, 0x87, 0x5a, 0x8c, 0x58, 0x7a, 0x70, 0x29, 0x70, 0xfe, 0xa3, 0x02, 0x2d, 0x04, 0xcf, 0x3c,
+	0xe7, 0xc7, 0x6c, 0x19, 0x98, 0x8e, 0x9d, 0x30, 0x11, 0x49, 0x60, 0x18, 0xde, 0x7c, 0x89, 0x9b,
+	0x67, 0x29, 0x6e, 0x43, 0x88, 0x9c, 0xdb, 0x47, 0xd2, 0x86, 0x4a, 0x52, 0x0a, 0xba, 0xef, 0x9b,
+	0x17, 0x36, 0x33, 0xc8, 0x4c, 0x8a, 0x78, 0x8f, 0xcb, 0xf1, 0x31, 0xa3, 0x57, 0x91, 0xbe, 0xe2,
+	0x91, 0x07, 0x31, 0x83, 0xcc, 0xa1, 0x1a, 0x1a, 0x5a, 0x8d, 0x0c, 0x6d, 0x0f, 0x9a, 0xe1, 0x91,
+	0xc7, 0x08, 0x46, 0x8b, 0x50, 0xb4, 0xbf, 0x55, 0x22, 0x1d, 0xcc, 0x99, 0xbf, 0xb6, 0x02, 0xf5,
+	0x1e, 0x94, 0x5f, 0xb0, 0x73, 0x34, 0x6a, 0x9e, 0xe1, 0x3b, 0xa9, 0x0c, 0xef, 0xab, 0xdf, 0x83,
+	0x92, 0x7e, 0x1e, 0x88, 0xa0, 0x9d, 0xb7, 0x21, 0x5e, 0xeb, 0x50, 0x09, 0x81, 0x7a, 0x8b, 0x98,
+	0x2c, 0xaa, 0xbb, 0xd0, 0xa0, 0x9f, 0x33, 0xe6, 0x2d, 0x99, 0x1d, 0x08, 0x67, 0x78, 0x4f, 0x66,
+	0xde, 0x72, 0x42, 0x5d, 0x49, 0xf1, 0x6a, 0x7f, 0x04, 0xbb, 0x32, 0xb2, 0x9f, 0xae, 0xad, 0xc0,
+	0x94, 0xaa, 0x7e, 0x4f, 0x24, 0x53, 0x25, 0x11, 0x17, 0x72, 0x92, 0x80, 0xcf, 0xbc, 0x6b, 0x73,
+	0xc9, 0x64, 0xd4, 0x47, 0x62, 0x1d, 0xcb, 0x7a, 0xa1, 0x2f, 0xaf, 0x44, 0xe5, 0xf0, 0x97, 0x0a,
+	0xec, 0x2c, 0xf8, 0x26, 0x7e, 0xdc, 0xd3, 0x49, 0xb1, 0x6d, 0xa8, 0x88, 0xb3, 0xc2, 0x29, 0xde,
+	0x85, 0x32, 0x96, 0x31, 0xe6, 0x45, 0x6f, 0x3b, 0x51, 0x0c, 0x21, 0xc5, 0x03, 0x5a, 0x88, 0x7c,
+	0xb6, 0x10, 0x8b, 0xfa, 0xa1, 0xb2, 0xf1, 0xc6, 0x2b, 0xd3, 0x75, 0x19, 0x4f, 0x63, 0x55, 0x55,
+	0x05, 0x40, 0x62, 0x98, 0xf1, 0x08, 0xc9, 0xa1, 0x54, 0x86, 0xaa, 0x93, 0x04, 0x8e, 0x22, 0xf7,
+	0xd7, 0x26, 0xb0, 0x93, 0x92, 0x03, 0xa9, 0x0f, 0x60, 0xdb, 0xb9, 0x12, 0x05, 0xe9, 0x07, 0x14,
+	0xa0, 0xd6, 0x56, 0x20, 0x53, 0xf4, 0x81, 0xf4, 0x80, 0x2c, 0x77, 0xda, 0x8f, 0xa1, 0x2e, 0xc0,
+	0x94, 0xa4, 0xb1, 0x36, 0x89, 0x32, 0x25, 0x1a, 0xbd, 0xe3, 0x05, 0xc4, 0x67, 0x29, 0x5e, 0x0f,
+	0x14, 0x64, 0xf6, 0x5d, 0xe9, 0x48, 0x89, 0xe0, 0x2b, 0xac, 0xa1, 0xb8, 0x05, 0x53, 0x20, 0x3a,
+	0xd7, 0xd7, 0x56, 0x20, 0xb2, 0xf3, 0x27, 0x21, 0x2e, 0xaa, 0x2f, 0xde, 0x8b, 0x29, 0x85, 0xbb,
+	0xaa, 0x9a, 0x24, 0x94, 0x0a, 0x89, 0x3f, 0x80, 0x66, 0x48, 0x37, 0x46, 0xef, 0xac, 0x3e, 0xda,
+	0x50, 0xd1, 0x5d, 0xd7, 0x32, 0x99, 0x21, 0x74, 0x9b, 0x53, 0x59, 0xf2, 0xc0, 0xd1, 0x82, 0x32,
+	0xa9, 0x45, 0x94, 0xd1, 0xda, 0x04, 0x54, 0xae, 0x30, 0x7e, 0xb5, 0x90, 0xe5, 0xfb, 0x19, 0xba,
+	0x76, 0xd3, 0x02, 0x24, 0x42, 0xb0, 0x1c, 0x71, 0x2e, 0x4e, 0xd8, 0x35, 0xb3, 0xb8, 0xa7, 0x6b,
+	0x3f, 0x84, 0x22, 0x26, 0x53, 0x5e, 0x96, 0xc4, 0x28, 0x94, 0xc1, 0x63, 0x5b, 0xfe, 0xf2, 0xcd,
+	0x9f, 0x32, 0xe1, 0x23, 0x0d, 0x28, 0xae, 0x65, 0x9e, 0x28, 0x6a, 0x1f, 0x00, 0xc4, 0xcc, 0xa8,
+	0x0d, 0x15, 0xcb, 0x59, 0xea, 0x56, 0x3c, 0xb0, 0x78, 0x3c, 0x18, 0x63, 0x59, 0xff, 0x18, 0x8a,
+	0xe8, 0x7d, 0xd9, 0x42, 0x2d, 0xb1, 0x0d, 0x1d, 0x97, 0x8a, 0xa4, 0x5e, 0x21, 0xe3, 0xb8, 0x43,
+	0x84, 0x6b, 0x7f, 0x25, 0x8a, 0x5d, 0xfa, 0x85, 0xe9, 0xe2, 0x85, 0xee, 0xb3, 0x67, 0x89, 0x1b,
+	0x0f, 0xe2, 0xe5, 0x71, 0x2a, 0x34, 0xee, 0x42, 0x43, 0x04, 0xb3, 0x09, 0x6d, 0x29, 0x50, 0x3c,
+	0xfd, 0x10, 0xc0, 0xd5, 0xbd, 0xc0, 0x44, 0x2b, 0x93, 0x15, 0x87, 0x34, 0xc4, 0x99, 0x5c, 0xe8,
+	0x53, 0x84, 0x5b, 0x61, 0x8d, 0xbd, 0x03, 0x75, 0xf4, 0xaa, 0xc1, 0xa5, 0x6e, 0x5f, 0x84, 0x8e,
+	0x21, 0x3b, 0x86, 0x32, 0xf1, 0xfb, 0x19, 0xec, 0xe4, 0x9d, 0xc4, 0xb8, 0x28, 0xc1, 0x22, 0x85,
+	0xa4, 0xaa, 0xf9, 0x23, 0x68, 0x2c, 0x98, 0xee, 0x2d, 0x2f, 0x85, 0x86, 0x43, 0x6e, 0x94, 0x0c,
+	0x37, 0xda, 0x01, 0xd4, 0xf0, 0xef, 0xef, 0xae, 0x99, 0x77, 0x83, 0xf7, 0xfc, 0x04, 0xff, 0xe1,
+	0x7a, 0xd4, 0x74, 0x68, 0x87, 0x6b, 0xe2, 0xaa, 0x8c, 0xec, 0xdf, 0x4b, 0x4a, 0x2a, 0x1e, 0xce,
+	0xe8, 0xdc, 0xa9, 0x1e, 0x2c, 0x2f, 0xa9, 0x39, 0x90, 0x34, 0x0f, 0x30, 0xf7, 0xc9, 0xfe, 0xe5,
+	0x21, 0xb4, 0x52, 0x5b, 0xef, 0x26, 0x22, 0x5c, 0x42, 0xf2, 0x6a, 0x42, 0xc6, 0x54, 0xf2, 0x69,
+	0xbf, 0x0e, 0xed, 0x50, 0x48, 0x27, 0x8e, 0x73, 0xb5, 0x76, 0xf3, 0x04, 0x44, 0x15, 0x0a, 0x6f,
+	0x4e, 0x44, 0xd7, 0x75, 0x04, 0xb5, 0x27, 0xec, 0x46, 0x9c, 0x88, 0xf5, 0x88, 0x8d, 0x9c, 0xbd,
+	0xff, 0xac, 0xc0, 0x5e, 0x0a, 0xc9, 0x26, 0x71, 0x24, 0x70, 0xf3, 0x6c, 0xfe, 0x83, 0x44, 0x9e,
+	0x88, 0xd7, 0x0a, 0xe1, 0x9d, 0xa2, 0x37, 0xc9, 0xeb, 0xa1, 0x78, 0x2b, 0x70, 0x17, 0xba, 0x72,
+	0x21, 0x3c, 0x24, 0xc2, 0xce, 0xc7, 0xd0, 0x91, 0x4b, 0x61, 0x8b, 0x53, 0x7e, 0x2d, 0x1a, 0xed,
+	0x21, 0x74, 0x32, 0xa8, 0xe3, 0xf9, 0x3c, 0xd1, 0x6e, 0x6f, 0x27, 0x6a, 0x10, 0x0a, 0xf0, 0xda,
+	0xff, 0x0a, 0x0f, 0xe2, 0x89, 0x30, 0x23, 0x84, 0x1c, 0x3d, 0x65, 0xb2, 0x25, 0xb5, 0x65, 0x7c,
+	0x0a, 0xc0, 0xdd, 0x88, 0x17, 0x8e, 0x7b, 0xd0, 0x34, 0xed, 0x38, 0x98, 0x33, 0x79, 0x17, 0xba,
+	0x3f, 0x65, 0x9e, 0x23, 0x8b, 0xab, 0x89, 0x48, 0xa0, 0xe2, 0xc4, 0x4a, 0x7f, 0x35, 0xbd, 0x66,
+	0xde, 0xd7, 0xcc, 0xbc, 0xb8, 0x0c, 0x28, 0x71, 0x28, 0xea, 0x1d, 0x68, 0xad, 0xf4, 0x57, 0x4f,
+	0x6d, 0x23, 0x84, 0x57, 0x09, 0xfe, 0xae, 0xb4, 0xd7, 0x5a, 0x22, 0x04, 0xe3, 0x8d, 0x8f, 0x74,
+	0x4b, 0xb7, 0x97, 0x4c, 0x7d, 0x57, 0x96, 0x5f, 0x90, 0xd8, 0x72, 0x66, 0x32, 0x4f, 0x6c, 0xd1,
+	0xfe, 0x18, 0xea, 0xf1, 0x13, 0x9b, 0x8b, 0xa1, 0x68, 0xd4, 0x51, 0xc8, 0x14, 0x3e, 0xc5, 0x28,
+	0x6d, 0xf8, 0x14, 0xb5, 0x79, 0x81, 0x90, 0xad, 0x84, 0x5a, 0x50, 0x7e, 0x19, 0xe3, 0x4d, 0xfb,
+	0x13, 0xa8, 0xc7, 0x88, 0xa1, 0xb2, 0x9f, 0xa2, 0x32, 0x4d, 0x5c, 0x90, 0x00, 0x24, 0x3f, 0xea,
+	0xc0, 0x6c, 0x11, 0xa6, 0xd2, 0xc5, 0x66, 0x51, 0xd6, 0xf7, 0x6f, 0x8a, 0xff, 0x7d, 0xd8, 0xa1,
+	0x96, 0xc6, 0x39, 0x0f, 0xb0, 0x1b, 0x11, 0xa6, 0x9a, 0xb6, 0x83, 0x9a, 0x16, 0x40, 0x27, 0xb5,
+	0xc7, 0x57, 0xef, 0x43, 0x55, 0x6c, 0x92, 0xe1, 0xe8, 0x2d, 0x99, 0x6d, 0x92, 0x5b, 0x8f, 0x3d,
+	0x67, 0xed, 0xaa, 0x1a, 0x94, 0xcf, 0x75, 0xd3, 0x12, 0xb9, 0x2e, 0xa9, 0x2f, 0x89, 0x37, 0xc9,
+	0xa2, 0xf6, 0x15, 0xec, 0xe6, 0x5e, 0x95, 0x26, 0x2f, 0x32, 0x85, 0x8d, 0x57, 0x6b, 0x5f, 0x41,
+	0x3d, 0xf6, 0x33, 0xdd, 0x17, 0x09, 0x27, 0x11, 0x92, 0x4e, 0xd5, 0x0b, 0xb5, 0xa8, 0x2a, 0xe2,
+	0x45, 0xf4, 0x0d, 0xb4, 0x9e, 0xba, 0x17, 0x9e, 0x1e, 0xf5, 0xce, 0x19, 0x8a, 0xba, 0x50, 0x7b,
+	0x81, 0x41, 0x70, 0x81, 0xf9, 0x72, 0x5b, 0x76, 0x52, 0xa4, 0x4d, 0xee, 0x33, 0x7b, 0xd0, 0xbc,
+	0x64, 0xba, 0x15, 0x5c, 0x9e, 0x99, 0x2b, 0xe6, 0xac, 0x03, 0xba, 0xba, 0xc4, 0xb5, 0x6a, 0xeb,
+	0x9e, 0x29, 0xc6, 0x63, 0x64, 0x85, 0xbe, 0xa3, 0xf3, 0xe2, 0xaa, 0xa4, 0xfd, 0x97, 0x02, 0x4d,
+	0x81, 0x5b, 0xf4, 0x3d, 0x19, 0xd4, 0x9a, 0xec, 0xd1, 0xb6, 0xa9, 0x47, 0xdb, 0x11, 0xc2, 0x88,
+	0x9d, 0x62, 0x49, 0xf2, 0x0a, 0x09, 0xf2, 0x38, 0x1d, 0x4d, 0x28, 0xd1, 0x86, 0xa8, 0x22, 0xa2,
+	0x9f, 0xa1, 0xaf, 0x86, 0x12, 0xaf, 0x24, 0x24, 0x2e, 0x90, 0x50, 0x8c, 0x0f, 0x85, 0x16, 0xb6,
+	0x7b, 0x54, 0xd9, 0x84, 0xed, 0x5e, 0x07, 0xaa, 0xe7, 0xa6, 0x6d, 0xfa, 0x97, 0x61, 0xbf, 0x87,
+	0xfd, 0x86, 0xa3, 0x5f, 0x3d, 0xb5, 0x03, 0xd3, 0x12, 0xdd, 0xde, 0xdf, 0x2b, 0x50, 0x8f, 0x5f,
+	0xfa, 0x5a, 0xbd, 0x61, 0xf5, 0xe2, 0xac, 0x74, 0x33, 0xa6, 0x36, 0xce, 0x04, 0xf7, 0xcf, 0xf7,
+	0xa5, 0x60, 0x4a, 0x24, 0x98, 0xfd, 0x2c, 0xcd, 0x5c, 0x38, 0x39, 0xb1, 0xbb, 0x9c, 0xb6, 0x8b,
+	0x4a, 0xd2, 0x2e, 0xaa, 0x51, 0x87, 0x6b, 0xeb, 0xde, 0x0d, 0x71, 0x58, 0xd5, 0xfe, 0x54, 0x01,
+	0x55, 0x14, 0x45, 0x81, 0xe7, 0x58, 0xd2, 0x58, 0xea, 0x50, 0x30, 0x0d, 0xee, 0x33, 0xc5, 0x28,
+	0x51, 0x73, 0x16, 0x78, 0x31, 0xb1, 0x5c, 0x7b, 0x1e, 0xb3, 0x97, 0x37, 0xb9, 0xaa, 0xc9, 0x58,
+	0x4e, 0x89, 0xc0, 0xfb, 0xd0, 0x5e, 0x3a, 0x76, 0x60, 0xda, 0x6b, 0x36, 0xb5, 0x79, 0xe1, 0xcd,
+	0x8b, 0xd7, 0x1f, 0x41, 0x37, 0x41, 0x04, 0xa5, 0xbb, 0xef, 0x27, 0x0b, 0x89, 0x5e, 0xb2, 0x13,
+	0xc0, 0x8d, 0xd1, 0xd0, 0x38, 0x74, 0x5b, 0x61, 0x09, 0xb2, 0x05, 0xe0, 0x4e, 0xea, 0x42, 0x37,
+	0x7b, 0xea, 0x8d, 0xd4, 0x14, 0xcd, 0xa5, 0x37, 0x74, 0x19, 0xa1, 0x98, 0x49, 0x0d, 0xda, 0x5f,
+	0x2b, 0xa0, 0x9e, 0xea, 0xa6, 0x1d, 0x30, 0x1b, 0xa3, 0x66, 0xde, 0xfc, 0x8a, 0x7a, 0x64, 0xdd,
+	0x17, 0x99, 0x9b, 0x9a, 0x26, 0x63, 0xcd, 0x1b, 0x07, 0xd1, 0x02, 0x34, 0xa1, 0x64, 0x78, 0x48,
+	0x03, 0xc7, 0xb9, 0x03, 0x75, 0xfa, 0x79, 0xa6, 0x7b, 0x17, 0x2c, 0x10, 0x56, 0xdf, 0x85, 0x1a,
+	0x01, 0x17, 0x01, 0x73, 0xa3, 0x1c, 0x45, 0xa0, 0xb1, 0x1d, 0x30, 0xef, 0x5a, 0xb7, 0x7a, 0x15,
+	0x29, 0x7c, 0x31, 0x5a, 0x91, 0x89, 0x8d, 0x77, 0xb5, 0xda, 0xff, 0x28, 0xd0, 0x8d, 0xd1, 0x9a,
+	0x23, 0x9e, 0x34, 0xa9, 0xd1, 0x98, 0xa4, 0x90, 0xf6, 0x9b, 0xa2, 0x1c, 0x93, 0xb0, 0x57, 0xae,
+	0xe9, 0x89, 0x94, 0x4a, 0x8e, 0x44, 0x34, 0x61, 0xe1, 0x5c, 0xce, 0xe4, 0xa6, 0x0a, 0xd1, 0xdd,
+	0x83, 0x8e, 0xe3, 0x99, 0x17, 0xa6, 0xad, 0x5b, 0x09, 0x0a, 0x9b, 0x69, 0xce, 0x6b, 0x59, 0xce,
+	0x21, 0x9f, 0xf3, 0xfa, 0x26, 0xce, 0x1b, 0xc4, 0xf9, 0x6f, 0x41, 0x3b, 0xc6, 0x38, 0xf5, 0x4d,
+	0x1b, 0x8c, 0x2e, 0x23, 0x1f, 0xed, 0x06, 0xea, 0x73, 0x7d, 0xe5, 0x6e, 0xd0, 0x6c, 0xc0, 0x29,
+	0x0d, 0xa3, 0xaa, 0x8f, 0x44, 0x86, 0x49, 0xd1, 0x94, 0xf4, 0x85, 0xde, 0xf2, 0x52, 0x37, 0x83,
+	0x81, 0x63, 0x5f, 0x33, 0x2f, 0xaa, 0xcf, 0xb9, 0xb7, 0x10, 0x48, 0xba, 0x11, 0x0f, 0xb0, 0x7f,
+	0xb3, 0x0d, 0x80, 0xb8, 0x73, 0x34, 0xf5, 0xbd, 0x64, 0x60, 0x0d, 0xdb, 0x11, 0xb1, 0x9b, 0x22,
+	0x1e, 0xa9, 0x4a, 0x10, 0x13, 0x91, 0x5a, 0x4c, 0x90, 0x5a, 0xca, 0x90, 0x5a, 0xce, 0x27, 0xb5,
+	0x92, 0x51, 0x66, 0x55, 0x36, 0x09, 0x78, 0x91, 0x1f, 0x29, 0x8b, 0x7e, 0x0e, 0x1d, 0x9b, 0x09,
+	0x65, 0xe5, 0x4d, 0xce, 0xf0, 0x22, 0x9b, 0xbd, 0x0a, 0x48, 0xa7, 0x0d, 0xe9, 0x04, 0xdc, 0xb1,
+	0x9a, 0x29, 0xd3, 0x6b, 0xa5, 0x4d, 0xaf, 0x9d, 0x09, 0xd9, 0x1d, 0x8a, 0xcf, 0xbf, 0x02, 0x55,
+	0xe4, 0x9f, 0xd4, 0x7b, 0x0f, 0x4a, 0x9e, 0xbe, 0x72, 0xa5, 0x7a, 0xbb, 0x29, 0xf9, 0xac, 0x7d,
+	0xed, 0x67, 0x0a, 0xec, 0x0c, 0xd9, 0xd2, 0x59, 0xad, 0x4c, 0xdf, 0xa7, 0x92, 0x35, 0xab, 0x60,
+	0x6c, 0x01, 0xf5, 0x95, 0x1b, 0x8d, 0x28, 0x3d, 0x3a, 0x1f, 0xaa, 0x18, 0x9b, 0x36, 0x7d, 0xe5,
+	0x8e, 0x93, 0x6a, 0xce, 0xd1, 0x67, 0x49, 0xd6, 0xa6, 0x7e, 0xe0, 0xb8, 0x38, 0x9f, 0xc0, 0xe9,
+	0x3b, 0x0f, 0x89, 0x7f, 0xb7, 0x0d, 0x6a, 0x9c, 0x8e, 0xdb, 0x44, 0xad, 0xef, 0x4b, 0xed, 0x17,
+	0x48, 0xfb, 0xd2, 0x78, 0xd3, 0xd7, 0xb0, 0x58, 0xc4, 0x2c, 0x26, 0xa3, 0x57, 0x49, 0x36, 0xd1,
+	0xc4, 0x5f, 0x39, 0xc3, 0x5f, 0x25, 0x97, 0xbf, 0xea, 0x26, 0xfe, 0x6a, 0x79, 0xfc, 0x81, 0x8c,
+	0x66, 0x59, 0xf5, 0x47, 0xda, 0x6d, 0xa4, 0xb5, 0xdb, 0x94, 0x81, 0x45, 0x4e, 0xbc, 0x5b, 0x19,
+	0x75, 0x93, 0x01, 0x68, 0x5f, 0x40, 0x27, 0xce, 0x30, 0xa9, 0xfd, 0x30, 0xe9, 0xd5, 0x77, 0x37,
+	0x08, 0x66, 0xed, 0x6b, 0x47, 0xbc, 0x06, 0x13, 0x09, 0x37, 0x2d, 0xee, 0xf8, 0x47, 0xa8, 0x9a,
+	0xf6, 0x11, 0x74, 0x63, 0x7b, 0x37, 0x7c, 0xc1, 0xac, 0x43, 0x61, 0xe5, 0x5f, 0x88, 0x13, 0x3f,
+	0x82, 0x0a, 0x76, 0x32, 0xf8, 0xa5, 0xf0, 0x5b, 0xe6, 0x0a, 0x58, 0xcd, 0xac, 0x4d, 0xcb, 0x10,
+	0x31, 0x96, 0x1a, 0x45, 0x83, 0xe9, 0x86, 0x65, 0xda, 0x2c, 0xfa, 0x62, 0x13, 0x4e, 0xd9, 0x28,
+	0xca, 0x6a, 0xa7, 0x50, 0x5f, 0x60, 0x98, 0xdb, 0xd4, 0x2f, 0xc6, 0x51, 0x54, 0xd3, 0x28, 0xaa,
+	0x48, 0xfa, 0xc8, 0xf3, 0x4e, 0xfd, 0x0b, 0x51, 0x43, 0x7e, 0x08, 0x4d, 0xf9, 0x21, 0x81, 0xfb,
+	0x80, 0x3c, 0xaf, 0xa4, 0xcf, 0xf3, 0xce, 0xf5, 0x29, 0xd4, 0xf8, 0xfe, 0x5c, 0xfe, 0xba, 0x50,
+	0xc3, 0xc3, 0x28, 0x1c, 0x69, 0xaf, 0xbb, 0xd0, 0x10, 0x37, 0x70, 0x68, 0x98, 0x6b, 0x63, 0x93,
+	0xaf, 0xda, 0xd1, 0xbf, 0x2a, 0xd0, 0x4c, 0x7e, 0x0e, 0xec, 0x42, 0xf3, 0xe9, 0xe4, 0xc9, 0x64,
+	0xfa, 0xf5, 0xe4, 0xf9, 0xe8, 0xd9, 0x68, 0x72, 0xd6, 0xd9, 0x52, 0x5b, 0x00, 0x93, 0xe9, 0x70,
+	0xf4, 0xbc, 0x3f, 0x1c, 0x8e, 0x86, 0x1d, 0xec, 0x2b, 0x1a, 0xf4, 0x7b, 0x3e, 0x3a, 0x9d, 0x3e,
+	0x1b, 0x0d, 0x3b, 0xdb, 0xaa, 0x0a, 0x2d, 0xbe, 0x63, 0x70, 0x36, 0x7e, 0xd6, 0x3f, 0x1b, 0x0d,
+	0x3b, 0x05, 0x75, 0x17, 0x3a, 0x04, 0x1b, 0x8e, 0x22, 0x28, 0x8e, 0x52, 0x3b, 0x83, 0xfe, 0xac,
+	0x3f, 0x18, 0x9f, 0xfd, 0xfe, 0xf3, 0xc1, 0x97, 0xfd, 0xc9, 0xf1, 0x68, 0xd8, 0x29, 0x21, 0xd2,
+	0xb3, 0xf1, 0x68, 0xbe, 0x08, 0x41, 0x65, 0x75, 0x0f, 0xba, 0xfd, 0xe1, 0x70, 0x3e, 0x5a, 0x2c,
+	0x46, 0x11, 0xb8, 0x82, 0x98, 0x06, 0xd3, 0xc9, 0xe3, 0xf1, 0x71, 0x08, 0xab, 0xe2, 0x9d, 0xf3,
+	0xd1, 0xec, 0x64, 0x3c, 0xe8, 0x47, 0x3b, 0x6b, 0x47, 0x3f, 0x53, 0x60, 0x2f, 0xff, 0xc3, 0xc4,
+	0x2e, 0x74, 0x66, 0xf3, 0xe9, 0x6c, 0xba, 0xe8, 0x9f, 0x3c, 0x9f, 0x8d, 0x26, 0xc3, 0xf1, 0xe4,
+	0xb8, 0xb3, 0x95, 0x80, 0xf6, 0x67, 0xb3, 0x93, 0x31, 0xf1, 0xba, 0x07, 0xdd, 0x10, 0x3a, 0x1f,
+	0x7d, 0x35, 0x1a, 0x9c, 0x11, 0xc3, 0x3b, 0xd0, 0x0e, 0xc1, 0x8f, 0xfb, 0xe3, 0x13, 0xc9, 0x71,
+	0x08, 0x1c, 0xfd, 0xde, 0x6c, 0x3c, 0x47, 0x8e, 0x8f, 0xfe, 0x41, 0x81, 0x46, 0xa2, 0xf8, 0xee,
+	0x40, 0xe3, 0xe9, 0xec, 0x78, 0xde, 0x1f, 0x8e, 0x9e, 0x8f, 0x87, 0x27, 0xa3, 0xce, 0x16, 0xde,
+	0x26, 0x21, 0xf3, 0xa7, 0x93, 0x09, 0xd2, 0xa3, 0x20, 0xa7, 0x12, 0x38, 0xeb, 0x3f, 0x5d, 0x48,
+	0xb4, 0x12, 0xd6, 0x7f, 0x34, 0x9d, 0x73, 0x41, 0xc7, 0x36, 0x0a, 0x52, 0x48, 0xcc, 0x12, 0x36,
+	0x98, 0x9e, 0xce, 0x4e, 0x46, 0x67, 0xa3, 0x4e, 0x49, 0xed, 0xc1, 0x6e, 0x88, 0x67, 0x7a, 0x72,
+	0x32, 0x9e, 0x1c, 0x3f, 0x7f, 0xd4, 0x1f, 0x3c, 0xe9, 0x94, 0xd5, 0x7d, 0xd8, 0x89, 0xaf, 0x8c,
+	0x86, 0x7c, 0xa1, 0x72, 0xf4, 0x73, 0x05, 0x3a, 0x99, 0x0a, 0x59, 0x1a, 0x40, 0x24, 0x3c, 0x69,
+	0x00, 0xfc, 0x12, 0xce, 0x40, 0x17, 0x9a, 0x04, 0x1b, 0x7c, 0x39, 0x1a, 0x3c, 0x41, 0xd0, 0x76,
+	0x08, 0x12, 0xb8, 0x90, 0xfa, 0x36, 0xd4, 0x27, 0xd3, 0x38, 0xe9, 0xf2, 0xf2, 0xc5, 0x93, 0xf1,
+	0x6c, 0x46, 0xd6, 0xb1, 0x07, 0xdd, 0xc9, 0x34, 0x4b, 0xb3, 0x34, 0xb0, 0x04, 0xc1, 0xc8, 0x63,
+	0x08, 0x45, 0x90, 0xbc, 0xb8, 0x7a, 0x64, 0x40, 0x2d, 0xca, 0xd5, 0x1d, 0x68, 0xcc, 0xfb, 0xa7,
+	0xb3, 0x50, 0xde, 0x5b, 0x48, 0x08, 0x41, 0x84, 0xb0, 0x95, 0x70, 0x8b, 0x94, 0xf4, 0x76, 0xb8,
+	0x25, 0xd4, 0x78, 0x17, 0x9a, 0x04, 0x08, 0x65, 0x5c, 0x3c, 0xfa, 0x73, 0x05, 0xba, 0xd9, 0xa4,
+	0x70, 0x17, 0xf6, 0x86, 0xa3, 0xc1, 0xf4, 0xf4, 0x74, 0xbc, 0x58, 0x8c, 0xa7, 0x93, 0xe7, 0xc3,
+	0x79, 0x7f, 0x2c, 0xf0, 0xbe, 0x05, 0xfb, 0x89, 0xa5, 0xc1, 0x74, 0xf2, 0x6c, 0x34, 0x3f, 0xe6,
+	0x32, 0x4c, 0x9f, 0x5b, 0x9c, 0x4d, 0x67, 0x33, 0x2e, 0xcb, 0xf4, 0x12, 0x79, 0x23, 0x2e, 0x15,
+	0x32, 0x4b, 0x11, 0x79, 0x0f, 0xfe, 0xed, 0x2e, 0xd4, 0x16, 0xf2, 0xf5, 0x08, 0x4e, 0xdc, 0xfb,
+	0x06, 0x4d, 0x3f, 0xd5, 0xf8, 0x7c, 0xee, 0x20, 0xfb, 0x4c, 0x42, 0xdb, 0xc2, 0xc1, 0xe8, 0x9c,
+	0xc6, 0xa5, 0xb7, 0xdc, 0xff, 0x11, 0x54, 0x4e, 0x1d, 0x7e, 0xb9, 0xac, 0x95, 0xc2, 0xc7, 0x1c,
+	0xf9, 0x27, 0x3e, 0x80, 0xca, 0x82, 0x05, 0xf4, 0xe4, 0x22, 0xfe, 0x0e, 0x23, 0x7f, 0x33, 0x0d,
+	0xe5, 0x03, 0x39, 0x29, 0x53, 0xdb, 0xb1, 0x3d, 0xf8, 0x82, 0x25, 0xff, 0xd0, 0x7d, 0xa8, 0x2d,
+	0x58, 0xd0, 0xa7, 0x41, 0xce, 0x2d, 0x58, 0xf8, 0x55, 0xc2, 0x21, 0xa3, 0xc8, 0x2d, 0x0e, 0xfc,
+	0x1a, 0x74, 0xc4, 0xa7, 0xf5, 0xbe, 0x9c, 0xbb, 0xdd, 0x4a, 0x52, 0x0d, 0x71, 0x0a, 0xa7, 0x41,
+	0xb7, 0x39, 0xf1, 0x00, 0xe0, 0x98, 0x05, 0xe1, 0xbc, 0x46, 0x6c, 0x91, 0x0f, 0x7f, 0xf2, 0xcf,
+	0x7c, 0x0a, 0xed, 0x63, 0x16, 0x1c, 0x5b, 0xce, 0x0b, 0xdd, 0x92, 0x73, 0xfa, 0xf4, 0xc1, 0xb8,
+	0x14, 0x71, 0x0f, 0xc9, 0xa0, 0x79, 0xcc, 0x82, 0xd8, 0x70, 0x3f, 0x41, 0x5d, 0xce, 0x81, 0x01,
+	0xdc, 0x11, 0x07, 0xd2, 0x73, 0xa5, 0xc4, 0xc9, 0x83, 0xd8, 0x8f, 0xd4, 0x46, 0x6d, 0x4b, 0x3d,
+	0x81, 0x83, 0x78, 0xfe, 0x4f, 0x5d, 0x14, 0x9f, 0xf0, 0x88, 0x2d, 0x07, 0xbd, 0x2c, 0x2c, 0x64,
+	0x7d, 0x48, 0x24, 0xf5, 0x2d, 0x2b, 0x33, 0xc5, 0xca, 0x48, 0x60, 0x3f, 0x7f, 0x88, 0x85, 0xb7,
+	0x3c, 0x0c, 0xe7, 0x3e, 0x03, 0x6b, 0x8d, 0x49, 0x54, 0xdd, 0x4b, 0xce, 0x10, 0x44, 0x2e, 0x3f,
+	0xd8, 0xcd, 0xce, 0x5c, 0x88, 0x8c, 0xcf, 0xa1, 0x73, 0xcc, 0x82, 0xd4, 0xfc, 0x26, 0x4d, 0xc0,
+	0xa6, 0xc3, 0xbf, 0x09, 0x8d, 0xaf, 0x71, 0x9a, 0x21, 0xe0, 0xb7, 0x3e, 0xf8, 0x91, 0xa2, 0x7e,
+	0x06, 0x8d, 0x99, 0xbe, 0xf6, 0xd9, 0x9b, 0x1e, 0x55, 0x7f, 0x03, 0x9a, 0x58, 0xef, 0xac, 0xde,
+	0xfc, 0xe4, 0x67, 0xd0, 0xe8, 0xbf, 0x70, 0xbc, 0xe0, 0x8d, 0x0f, 0x0e, 0x00, 0x16, 0x58, 0x96,
+	0xf2, 0x8f, 0xdd, 0x77, 0xb3, 0x83, 0x09, 0x29, 0xe4, 0x5e, 0xde, 0x12, 0xd6, 0x66, 0xda, 0x96,
+	0xfa, 0x08, 0xab, 0x25, 0xc7, 0xfd, 0x85, 0xee, 0x18, 0xa1, 0x53, 0xfa, 0xbf, 0x30, 0x29, 0xc7,
+	0xd0, 0x5a, 0xb0, 0x20, 0xd6, 0xe3, 0x86, 0x17, 0x65, 0x67, 0x18, 0x07, 0x9b, 0x5b, 0xe2, 0x2d,
+	0x75, 0x0c, 0x9d, 0x81, 0xc5, 0x74, 0xef, 0x3b, 0xb8, 0xea, 0x0b, 0x68, 0x63, 0xe9, 0x1e, 0xbf,
+	0x29, 0xa3, 0x9f, 0x3b, 0xd9, 0xf3, 0x78, 0x86, 0xab, 0x16, 0xf3, 0x68, 0x18, 0x15, 0xd5, 0x58,
+	0xa3, 0x27, 0xb1, 0xe7, 0x34, 0x7f, 0x18, 0xb1, 0x31, 0x92, 0x44, 0xa0, 0xd7, 0x84, 0x1f, 0xd1,
+	0x53, 0xf2, 0x88, 0x4d, 0xb6, 0x8b, 0xa0, 0x0d, 0x81, 0x31, 0x8e, 0x83, 0x92, 0x14, 0x5a, 0xec,
+	0x2d, 0xf7, 0xdf, 0x87, 0x1a, 0xd9, 0xe9, 0x2d, 0xb7, 0x3f, 0x49, 0x36, 0x40, 0xb8, 0x51, 0x3d,
+	0xc8, 0xe9, 0x78, 0xa4, 0x1c, 0x5e, 0xd3, 0x0d, 0xa1, 0xa9, 0xef, 0x1d, 0xb3, 0x20, 0xbb, 0xb4,
+	0x39, 0x28, 0xa5, 0x9b, 0x2f, 0x6d, 0x4b, 0xfd, 0x18, 0xea, 0xfc, 0x13, 0x21, 0xb7, 0xd2, 0x04,
+	0x0b, 0x3b, 0xe1, 0xe7, 0xdf, 0xe8, 0x1b, 0x22, 0x79, 0x35, 0xd0, 0x67, 0x3a, 0x7e, 0xa2, 0x93,
+	0xfe, 0xd0, 0x77, 0x70, 0x27, 0x0d, 0x09, 0x4f, 0x8e, 0xa1, 0xcd, 0xbf, 0x9a, 0x85, 0x5f, 0x9d,
+	0xd4, 0x3b, 0xe9, 0x6f, 0x53, 0x7c, 0xc3, 0xc1, 0xdb, 0xf9, 0xf0, 0xf0, 0xaa, 0xdf, 0x86, 0x1a,
+	0x87, 0x3c, 0x61, 0x37, 0x21, 0x0d, 0xe1, 0x87, 0xbc, 0x6f, 0x3d, 0xfe, 0x09, 0x34, 0xd0, 0x96,
+	0xa2, 0x4f, 0x57, 0x69, 0x91, 0x65, 0x9e, 0x79, 0x68, 0x5b, 0xea, 0x0f, 0xa0, 0x22, 0x0e, 0x65,
+	0xf7, 0xd7, 0x63, 0xfb, 0x29, 0x59, 0x36, 0xc3, 0xfb, 0x3d, 0xa6, 0xaf, 0xd4, 0x9d, 0xec, 0x73,
+	0xc1, 0x61, 0xea, 0xd0, 0x47, 0x8a, 0xfa, 0x10, 0xda, 0x14, 0xa4, 0xc3, 0x9e, 0xca, 0x0f, 0x05,
+	0x94, 0x7a, 0xdd, 0x79, 0xd0, 0x49, 0xc3, 0xe9, 0x82, 0xcf, 0xb9, 0x6b, 0x46, 0x58, 0x72, 0x58,
+	0xcb, 0xbe, 0x5c, 0x14, 0xb6, 0xf0, 0x3b, 0xb0, 0x3b, 0x34, 0x7d, 0xf1, 0x24, 0x31, 0x5a, 0xcd,
+	0xa7, 0x3d, 0x7d, 0x2d, 0xe5, 0xa7, 0x86, 0x7c, 0xa3, 0x41, 0x96, 0xbd, 0xe1, 0x55, 0xca, 0x41,
+	0xf6, 0xe1, 0x08, 0x29, 0xb4, 0x1e, 0x7b, 0x55, 0x18, 0x86, 0xa6, 0xec, 0x4b, 0xc3, 0xfc, 0xe3,
+	0xbc, 0x3a, 0x49, 0x3c, 0xe5, 0xcb, 0xb5, 0xe5, 0xf8, 0x0e, 0x6d, 0x4b, 0xfd, 0x21, 0x15, 0x5c,
+	0x0e, 0x0f, 0xd2, 0xc2, 0x7d, 0xf6, 0x63, 0x5b, 0xe3, 0xaf, 0xf5, 0xf2, 0xcb, 0xa2, 0x01, 0x74,
+	0x8f, 0x59, 0x90, 0x7c, 0x53, 0x96, 0x95, 0xf9, 0x5b, 0xe9, 0x57, 0x61, 0xb1, 0xb7, 0x67, 0xda,
+	0x96, 0xda, 0x87, 0xfd, 0xbe, 0xeb, 0x7a, 0xce, 0x35, 0xcb, 0x3c, 0x30, 0x4b, 0x70, 0xb1, 0xf1,
+	0x71, 0x19, 0x72, 0x72, 0x67, 0xce, 0xf0, 0xd5, 0xd0, 0xff, 0xfb, 0x86, 0x87, 0xd0, 0x08, 0x1f,
+	0x47, 0xa1, 0x8d, 0xdf, 0x49, 0x3d, 0x20, 0x93, 0xf2, 0xdf, 0xcb, 0xc0, 0x85, 0x53, 0x7d, 0x0a,
+	0x0d, 0x32, 0x3e, 0xf1, 0x88, 0x24, 0x2b, 0x85, 0xd4, 0x3b, 0x17, 0x61, 0x76, 0x13, 0xe8, 0xc6,
+	0xcd, 0x86, 0x9e, 0xf7, 0xa8, 0x6f, 0xa5, 0x6c, 0x27, 0xfe, 0xf8, 0xe9, 0xe0, 0x20, 0x7f, 0x51,
+	0x90, 0xf1, 0x05, 0x34, 0xf8, 0x4b, 0x95, 0x4d, 0x55, 0xea, 0xdd, 0x58, 0x73, 0x90, 0x7c, 0x03,
+	0xa3, 0x6d, 0x3d, 0xf8, 0x27, 0x85, 0xbf, 0xff, 0x1e, 0xe2, 0x70, 0xea, 0x3e, 0x94, 0x68, 0x6a,
+	0xa3, 0xb6, 0x62, 0xaa, 0x4f, 0x70, 0x12, 0xcd, 0x74, 0x28, 0x7b, 0x94, 0xe7, 0xec, 0x9a, 0x79,
+	0xc1, 0x2d, 0xf7, 0x3f, 0x80, 0xb2, 0xb0, 0xb9, 0xf0, 0xa5, 0x4d, 0x7c, 0xa8, 0x73, 0xd0, 0x49,
+	0x40, 0xb9, 0x93, 0x21, 0x49, 0x2c, 0x58, 0xbb, 0xb7, 0x43, 0xf1, 0xa8, 0xf3, 0xf3, 0x6f, 0xde,
+	0x51, 0xfe, 0xe5, 0x9b, 0x77, 0x94, 0x7f, 0xff, 0xe6, 0x1d, 0xe5, 0xcf, 0xfe, 0xe3, 0x9d, 0xad,
+	0x17, 0x65, 0xda, 0xf6, 0xc9, 0xff, 0x0d, 0x00, 0xa9, 0x9f, 0xc8, 0x7f, 0x06, 0x30, 0x00, 0x00,
 }
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -45,12 +47,20 @@ type RingSyndicate struct {
 	name   string
 	config syndicate.Config
 	server *syndicate.Server
-	gs     *grpc.Server
+}
+
+//RingListener is a grpc listener serving every service, services configured
+//with its port are the ones it defaults to.
+type RingListener struct {
+	port    int
+	syndics []*RingSyndicate
+	gs      *grpc.Server
 }
 
 type RingSyndicates struct {
 	sync.RWMutex
 	Syndics          []*RingSyndicate
+	Listeners        []*RingListener
 	ch               chan bool //os signal chan,
 	ShutdownComplete chan bool
 	waitGroup        *sync.WaitGroup
@@ -65,41 +75,89 @@ type ClusterConfigs struct {
 func (rs *RingSyndicates) Stop() {
 	log.Println("Exiting...")
 	close(rs.ch)
-	for i, _ := range rs.Syndics {
-		rs.Syndics[i].gs.Stop()
+	for _, l := range rs.Listeners {
+		l.gs.Stop()
 	}
 	rs.waitGroup.Wait()
 	close(rs.ShutdownComplete)
 }
 
-func (rs *RingSyndicates) launchSyndicates(k int) {
-	rs.Syndics[k].Lock()
+//services returns every services server by name.
+func (rs *RingSyndicates) services() map[string]*syndicate.Server {
+	services := make(map[string]*syndicate.Server, len(rs.Syndics))
+	for _, syndic := range rs.Syndics {
+		services[syndic.name] = syndic.server
+	}
+	return services
+}
+
+//newListeners sets up a listener per distinct configured port. Services that
+//share a port share a listener, which then has no default service so requests
+//to it have to name theirs. The first service on a port provides its TLS cert.
+func (rs *RingSyndicates) newListeners(reloader *syndicate.ConfigReloader) {
+	byPort := make(map[int]*RingListener)
+	for _, syndic := range rs.Syndics {
+		if !syndic.config.Master {
+			//pb.RegisterRingDistServer(s, newRingDistServer())
+			//log.Printf("Starting ring slave up on %d...\n", cfg.Port)
+			//s.Serve(l)
+			log.Fatalln("Syndicate slaves not implemented yet")
+		}
+		l, ok := byPort[syndic.config.Port]
+		if !ok {
+			l = &RingListener{port: syndic.config.Port}
+			byPort[syndic.config.Port] = l
+			rs.Listeners = append(rs.Listeners, l)
+		}
+		l.syndics = append(l.syndics, syndic)
+	}
+	services := rs.services()
+	for _, l := range rs.Listeners {
+		creds, err := credentials.NewServerTLSFromFile(l.syndics[0].config.CertFile, l.syndics[0].config.KeyFile)
+		if err != nil {
+			log.Fatalln("Error load cert or key:", err)
+		}
+		l.gs = grpc.NewServer(grpc.Creds(creds))
+		var def string
+		if len(l.syndics) == 1 {
+			def = l.syndics[0].name
+		}
+		router := syndicate.NewServiceRouter(services, def)
+		router.SetConfigReloader(reloader)
+		pb.RegisterSyndicateServer(l.gs, router)
+	}
+}
+
+func (rs *RingSyndicates) launchListener(l *RingListener) {
 	defer rs.waitGroup.Done()
-	rs.waitGroup.Add(1)
-	l, err := net.Listen("tcp", fmt.Sprintf(":%d", rs.Syndics[k].config.Port))
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", l.port))
 	if err != nil {
 		log.Fatalln(err)
 		return
 	}
-	var opts []grpc.ServerOption
-	creds, err := credentials.NewServerTLSFromFile(rs.Syndics[k].config.CertFile, rs.Syndics[k].config.KeyFile)
+	for _, syndic := range l.syndics {
+		log.Println("Master", syndic.name, "starting up on", l.port)
+	}
+	l.gs.Serve(ln)
+}
+
+//reloadConfig re-reads the config on SIGHUP and logs what changed.
+func reloadConfig(reloader *syndicate.ConfigReloader) {
+	log.Println("Reloading config")
+	res, err := reloader.Reload()
 	if err != nil {
-		log.Fatalln("Error load cert or key:", err)
-	}
-	opts = []grpc.ServerOption{grpc.Creds(creds)}
-	rs.Syndics[k].gs = grpc.NewServer(opts...)
-
-	if rs.Syndics[k].config.Master {
-		pb.RegisterSyndicateServer(rs.Syndics[k].gs, rs.Syndics[k].server)
-		log.Println("Master starting up on", rs.Syndics[k].config.Port)
-		rs.Syndics[k].gs.Serve(l)
-	} else {
-		//pb.RegisterRingDistServer(s, newRingDistServer())
-		//log.Printf("Starting ring slave up on %d...\n", cfg.Port)
-		//s.Serve(l)
-		log.Fatalln("Syndicate slaves not implemented yet")
-	}
-	rs.Syndics[k].Unlock()
+		log.Println("Config reload failed:", err)
+		return
+	}
+	for _, svc := range res.Services {
+		log.WithFields(log.Fields{
+			"service":          svc.Service,
+			"applied":          strings.Join(svc.Applied, ","),
+			"restart-required": strings.Join(svc.RestartRequired, ","),
+			"errors":           strings.Join(svc.Errors, "; "),
+		}).Info("Config reloaded")
+	}
+	log.WithField("level", res.LogLevel).Info("Log level after reload")
 }
 
 func main() {
@@ -127,7 +185,13 @@ func main() {
 	if _, err := toml.DecodeFile(configFile, &tc); err != nil {
 		log.Fatalln(err)
 	}
-	for k, v := range tc {
+	names := make([]string, 0, len(tc))
+	for k := range tc {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		v := tc[k]
 		log.Println("Found config for", k)
 		log.Println("Config:", v)
 		syndic := &RingSyndicate{
@@ -143,8 +207,11 @@ func main() {
 	}
 	rs.Lock()
 	defer rs.Unlock()
-	for k, _ := range rs.Syndics {
-		go rs.launchSyndicates(k)
+	reloader := syndicate.NewConfigReloader(configFile, rs.services())
+	rs.newListeners(reloader)
+	for _, l := range rs.Listeners {
+		rs.waitGroup.Add(1)
+		go rs.launchListener(l)
 	}
 	//now that syndics are up and running launch global metrics endpoint
 	//setup node_collector for system level metrics first
@@ -158,8 +225,12 @@ func main() {
 	go http.ListenAndServe(":9100", nil)
 	ch := make(chan os.Signal)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
 	for {
 		select {
+		case <-hup:
+			reloadConfig(reloader)
 		case <-ch:
 			rs.Stop()
 			<-rs.ShutdownComplete
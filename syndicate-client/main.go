@@ -18,6 +18,7 @@ import (
 var (
 	syndicateAddr    = flag.String("addr", "127.0.0.1:8443", "syndicate host to connect too")
 	groupMode        = flag.Bool("group", false, "use default groupstore addr instead")
+	serviceName      = flag.String("service", "", "the service to manage, i.e. valuestore or groupstore (see services)")
 	printVersionInfo = flag.Bool("version", false, "print version/build info")
 )
 
@@ -52,17 +53,88 @@ ccupgrade <ccaddr> <ver>    #DEPRECATED asks the node to upgrade itself
 ccsoftwareversion <ccaddr>  #DEPRECATED gets the currently running version from the node
 
 # syndicate based cluster wide commands
-softwareversion             #gets all currently running versions from nodes
-upgradesoftware <version>   #asks all currently running nodes to upgrade too <version-string>
+softwareversion             #gets the running version of all nodes, grouped by version
+upgradesoftware <version>   #DEPRECATED asks all currently running nodes to upgrade too <version-string>
+upgrade <version> [batch=N] [tier=N] [timeout=S] [canaries=id,id] [soak=S] [--follow]
+                            #rolling upgrade of all nodes, batch nodes at a time (default 1), one tierN
+                            #failure domain at a time (default tier0), each batch must answer a ping
+                            #within timeout seconds (default 300) and report the new version
+                            #canaries are upgraded first and must stay healthy for soak seconds
+                            #(default 300), if they don't they're rolled back and the upgrade stops
+upgrade status|pause|resume|abort [--follow]
+                            #report on or control the current upgrade, --follow streams its progress
+nodes start|stop|restart ids=<id,id>|query=<query> [concurrency=N] [tier=N] [timeout=S] [--continue]
+                            #starts, stops or restarts the nodes backends via synd, one tierN failure
+                            #domain at a time (default tier0) with up to concurrency nodes at once
+                            #(default 1). started/restarted nodes must answer a ping within timeout
+                            #seconds (default 300). stops at the first domain with a failure unless
+                            #--continue is given. query uses the search syntax, i.e. query="tier1=rack2"
+maintenance <id> [reason=<text>] [duration=S] [drain] [target=N] [step=N] [interval=S] [restore]
+                            #puts the node in maintenance, optionally for duration seconds. upgrades and
+                            #nodes start/stop/restart skip nodes in maintenance. drain lowers capacity by
+                            #step (default 10%%) every interval seconds (default 300) down to target
+                            #(default 0). restore puts capacity back when maintenance ends
+maintenance clear <id> [restore]
+                            #takes the node out of maintenance
+maintenance list            #lists the nodes in maintenance
+ramp <id> <capacity> [step=N] [interval=S] [converge] [timeout=S]
+                            #moves the node to capacity in steps of step (default a tenth of the way),
+                            #each its own ring version, interval seconds apart (default 300). converge
+                            #also waits for every managed node to pick up each step, for up to timeout
+                            #seconds (default 600)
+ramp status                 #shows running and last capacity ramps
+ramp pause|resume|abort <id>
+                            #pauses, resumes or aborts a nodes capacity ramp
+decommission <id> [ramp] [step=N] [interval=S] [timeout=S] [stop]
+                            #takes the node out of the ring: deactivates it (or ramps it to 0 capacity),
+                            #waits up to timeout seconds (default 600) for every managed node to pick up
+                            #that ring, stops its backend if stop is given and then removes it. running
+                            #it again for a failed decommission resumes from the step that failed
+decommission status         #shows running and past decommissions
+replace <id> [hostname=<name>] [addrs=<cidr,cidr>]
+                            #moves the nodes ring entry onto a new host keeping its id and tiers, run
+                            #on the new host. hostname and addrs default to the local ones, capacity
+                            #is recomputed from the local hardware profile
+services                    #lists the services synd serves, -service <name> picks the one other commands
+                            #act on, otherwise it's the one configured for the port in -addr
+reload                      #has synd re-read syndicate.toml (like a SIGHUP) and apply NetFilter, TierFilter,
+                            #WeightAssignment, Debug and Slaves changes live. changes to anything else are
+                            #listed as needing a restart
 version                     #print version
 config                      #print ring config
+stats                       #print ring balance stats per node and tier
+hw <id>                     #print the hardware profile the node last reported
+policy                      #shows the capacity policy mode, pending proposals and recent decisions
+policy approve|reject <id>  #applies or drops the nodes pending capacity proposal
+simulate [add=<count>:<capacity>[:tier,tier]] [rm=<id,id>] [capacity=<id>:<capacity>] [path=/data] [builder=<file>]
+                            #shows how the ring would look with count more nodes of capacity (in the
+                            #given tiers, above a generated tier0), nodes removed or capacities changed:
+                            #partition replicas moved, per tier balance and each nodes projected fill of
+                            #path from its reported disk usage. add and capacity may be repeated. nothing
+                            #is changed. builder simulates offline against a local builder file instead,
+                            #without fill projections
 search                      #lists all nodes
+search <query>              #lists all nodes matching the query, i.e.:
 search id=<nodeid>
-search meta=<metastring>
+search meta~=<regex>
 search tier=<string> or search tierX=<string>
-search address=<string> or search addressX=<string>
-search any of the above K/V combos
+search address~=<regex> or search addressX=<string>
+search active=true and (capacity>=500 or tier1~=^rack1)
+search not active=true sort capacity desc limit 10
+search tier2=row3 with partitions
+    keys: id active capacity partitions meta tier tierX address addressX
+    ops: = != ~= !~ (regex) > >= < <=
+    combine with and/or/not (or &&/||/!) and parens, adjacent terms are and'd
+    options: sort <key> [asc|desc], limit <n>, with partitions
+where <key>                 #print the nodes responsible for a key, now and in the previous ring
+partition <partition>       #print the nodes responsible for a partition, now and in the previous ring
 watch ringVersion           #get a stream of ring changes
+events [types=A,B] [nodes=id1,id2]
+                            #get a stream of ring change events, optionally filtered, types are:
+                            #NODE_ADDED NODE_REMOVED NODE_ACTIVATED NODE_DEACTIVATED CAPACITY_CHANGED
+                            #TIERS_CHANGED ADDRESSES_CHANGED CONFIG_CHANGED REPLICAS_CHANGED
+subscribers                 #list the connected ring subscribers
+kick <subscriberid>         #disconnect a ring subscriber
 set replicas=<replicacount> #set the rings replica count
 set config=./path/to/config #set the rings config
 
@@ -179,8 +251,42 @@ func (s *SyndClient) mainEntry(args []string) error {
 		}
 	case "search":
 		return s.SearchNodes(args[1:])
+	case "stats":
+		if len(args) == 1 {
+			return s.ringStatsCmd()
+		}
+	case "hw":
+		if len(args) == 2 {
+			id, err := strconv.ParseUint(args[1], 0, 64)
+			if err != nil {
+				return err
+			}
+			return s.nodeHardwareCmd(id)
+		}
+	case "where":
+		if len(args) == 2 {
+			return s.whereKeyCmd(args[1])
+		}
+	case "partition":
+		if len(args) == 2 {
+			p, err := strconv.ParseUint(args[1], 0, 32)
+			if err != nil {
+				return err
+			}
+			return s.wherePartitionCmd(uint32(p))
+		}
 	case "watch":
 		return s.WatchRing()
+	case "events":
+		return s.WatchRingEvents(args[1:])
+	case "subscribers":
+		if len(args) == 1 {
+			return s.listSubscribersCmd()
+		}
+	case "kick":
+		if len(args) == 2 {
+			return s.disconnectSubscriberCmd(args[1])
+		}
 	case "rm":
 		if len(args) == 2 {
 			id, err := strconv.ParseUint(args[1], 0, 64)
@@ -279,6 +385,41 @@ func (s *SyndClient) mainEntry(args []string) error {
 			return helpCmd()
 		}
 		return s.UpgradeSoftwareVersions(args[1])
+	case "upgrade":
+		return s.upgradeCmd(args[1:])
+	case "nodes":
+		if len(args) < 3 {
+			return helpCmd()
+		}
+		return s.controlNodesCmd(args[1], args[2:])
+	case "maintenance":
+		if len(args) < 2 {
+			return helpCmd()
+		}
+		return s.maintenanceCmd(args[1:])
+	case "ramp":
+		if len(args) < 2 {
+			return helpCmd()
+		}
+		return s.rampCmd(args[1:])
+	case "decommission":
+		if len(args) < 2 {
+			return helpCmd()
+		}
+		return s.decommissionCmd(args[1:])
+	case "policy":
+		return s.capacityPolicyCmd(args[1:])
+	case "simulate":
+		return s.simulateCmd(args[1:])
+	case "services":
+		return s.listServicesCmd()
+	case "reload":
+		return s.reloadConfigCmd()
+	case "replace":
+		if len(args) < 2 {
+			return helpCmd()
+		}
+		return s.replaceNodeCmd(args[1:])
 	case "softwareversion":
 		if len(args) != 1 {
 			return helpCmd()
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/user"
 	"strconv"
@@ -12,16 +13,21 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/gholt/brimtext"
+	"github.com/gholt/ring"
 	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"github.com/pandemicsyn/syndicate/syndicate"
+	"github.com/pandemicsyn/syndicate/utils/srvconf"
 	"golang.org/x/net/context"
 )
 
 //SyndClient returns a client for interacting with a synd instance
 type SyndClient struct {
-	conn   *grpc.ClientConn
-	client pb.SyndicateClient
+	conn    *grpc.ClientConn
+	client  pb.SyndicateClient
+	service string
 }
 
 //NewSyndicateClient returns a client for interacting with the syndicate
@@ -46,11 +52,42 @@ func NewSyndicateClient() (*SyndClient, error) {
 		}
 	}
 	s.client = pb.NewSyndicateClient(s.conn)
+	s.service = *serviceName
 	return &s, nil
 }
 
+//baseContext returns the context requests are made with, which names the
+//service they're for when one was picked with -service.
+func (s *SyndClient) baseContext() context.Context {
+	if s.service == "" {
+		return context.Background()
+	}
+	return metadata.NewContext(context.Background(), metadata.Pairs(syndicate.ServiceMetadataKey, s.service))
+}
+
+func (s *SyndClient) listServicesCmd() error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	list, err := s.client.ListServices(ctx, &pb.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	report := [][]string{[]string{"Service", "Port", "Master", "Ring Version", "Nodes", "Default"}}
+	for _, svc := range list.Services {
+		report = append(report, []string{
+			svc.Name,
+			fmt.Sprintf("%d", svc.Port),
+			fmt.Sprintf("%v", svc.Master),
+			fmt.Sprintf("%d", svc.Version),
+			fmt.Sprintf("%d", svc.Nodes),
+			fmt.Sprintf("%v", svc.Default),
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	return nil
+}
+
 func (s *SyndClient) printVersionCmd() error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	status, err := s.client.GetVersion(ctx, &pb.EmptyMsg{})
 	if err != nil {
 		return err
@@ -60,7 +97,7 @@ func (s *SyndClient) printVersionCmd() error {
 }
 
 func (s *SyndClient) rmNodeCmd(id uint64) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.RemoveNode(ctx, &pb.Node{Id: id})
 	if err != nil {
 		return err
@@ -74,7 +111,7 @@ func (s *SyndClient) rmNodeCmd(id uint64) error {
 }
 
 func (s *SyndClient) setReplicasCmd(count int) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.SetReplicas(ctx, &pb.RingOpts{Replicas: int32(count)})
 	if err != nil {
 		return err
@@ -88,7 +125,7 @@ func (s *SyndClient) setReplicasCmd(count int) error {
 }
 
 func (s *SyndClient) setActiveCmd(id uint64, active bool) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.SetActive(ctx, &pb.Node{Id: id, Active: active})
 	if err != nil {
 		return err
@@ -102,7 +139,7 @@ func (s *SyndClient) setActiveCmd(id uint64, active bool) error {
 }
 
 func (s *SyndClient) setCapacityCmd(id uint64, capacity uint32) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.SetCapacity(ctx, &pb.Node{Id: id, Capacity: capacity})
 	if err != nil {
 		return err
@@ -116,7 +153,7 @@ func (s *SyndClient) setCapacityCmd(id uint64, capacity uint32) error {
 }
 
 func (s *SyndClient) setAddressCmd(id uint64, addrs []string) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.ReplaceAddresses(ctx, &pb.Node{Id: id, Addresses: addrs})
 	if err != nil {
 		return err
@@ -130,7 +167,7 @@ func (s *SyndClient) setAddressCmd(id uint64, addrs []string) error {
 }
 
 func (s *SyndClient) setTierCmd(id uint64, tiers []string) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.ReplaceTiers(ctx, &pb.Node{Id: id, Tiers: tiers})
 	if err != nil {
 		return err
@@ -144,7 +181,7 @@ func (s *SyndClient) setTierCmd(id uint64, tiers []string) error {
 }
 
 func (s *SyndClient) printNodeConfigCmd(id uint64) error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.GetNodeConfig(ctx, &pb.Node{Id: id})
 	if err != nil {
 		return err
@@ -159,7 +196,7 @@ func (s *SyndClient) printNodeConfigCmd(id uint64) error {
 }
 
 func (s *SyndClient) printConfigCmd() error {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	c, err := s.client.GetGlobalConfig(ctx, &pb.EmptyMsg{})
 	if err != nil {
 		return err
@@ -176,7 +213,7 @@ func (s *SyndClient) printConfigCmd() error {
 // SetConfig sets the global ring config to the provided bytes, and indicates
 // whether the config change should trigger a restart.
 func (s *SyndClient) SetConfig(config []byte, restart bool) (err error) {
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
 	confMsg := &pb.Conf{
 		Conf:            config,
 		RestartRequired: restart,
@@ -193,69 +230,12 @@ func (s *SyndClient) SetConfig(config []byte, restart bool) (err error) {
 	return nil
 }
 
-// SearchNodes uses a provide pb.Node to search for matching nodes in the active ring
+// SearchNodes asks synd for the nodes in the active ring matching the provided query.
+// The args are joined with spaces and evaluated server side (see QueryNodes).
 func (s *SyndClient) SearchNodes(args []string) (err error) {
-	filter := &pb.Node{}
-	for _, arg := range args {
-		sarg := strings.SplitN(arg, "=", 2)
-		if len(sarg) != 2 {
-			return fmt.Errorf(`invalid expression %#v; needs "="`, arg)
-		}
-		if sarg[0] == "" {
-			return fmt.Errorf(`invalid expression %#v; nothing was left of "="`, arg)
-		}
-		if sarg[1] == "" {
-			return fmt.Errorf(`invalid expression %#v; nothing was right of "="`, arg)
-		}
-		switch sarg[0] {
-		case "id":
-			filter.Id, err = strconv.ParseUint(sarg[1], 0, 64)
-			if err != nil {
-				return err
-			}
-		case "meta":
-			filter.Meta = sarg[1]
-		default:
-			if strings.HasPrefix(sarg[0], "tier") {
-				var tiers []string
-				level, err := strconv.Atoi(sarg[0][4:])
-				if err != nil {
-					return fmt.Errorf("invalid expression %#v; %#v doesn't specify a number", arg, sarg[0][4:])
-				}
-				if level < 0 {
-					return fmt.Errorf("invalid expression %#v; minimum level is 0", arg)
-				}
-				if len(tiers) <= level {
-					t := make([]string, level+1)
-					copy(t, tiers)
-					tiers = t
-				}
-				tiers[level] = sarg[1]
-				filter.Tiers = tiers
-			} else if strings.HasPrefix(sarg[0], "address") {
-				var addresses []string
-				index, err := strconv.Atoi(sarg[0][7:])
-				if err != nil {
-					return fmt.Errorf("invalid expression %#v; %#v doesn't specify a number", arg, sarg[0][4:])
-				}
-				if index < 0 {
-					return fmt.Errorf("invalid expression %#v; minimum index is 0", arg)
-				}
-				if len(addresses) <= index {
-					a := make([]string, index+1)
-					copy(a, addresses)
-					addresses = a
-				}
-				addresses[index] = sarg[1]
-				filter.Addresses = addresses
-			} else {
-				return fmt.Errorf("unknown k/v combo: %s=%s", sarg[0], sarg[1])
-			}
-		}
-	}
-	fmt.Printf("Searching for: %#v\n", filter)
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
-	res, err := s.client.SearchNodes(ctx, filter)
+	query := strings.Join(args, " ")
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	res, err := s.client.QueryNodes(ctx, &pb.NodeQuery{Query: query})
 	if err != nil {
 		return err
 	}
@@ -264,18 +244,123 @@ func (s *SyndClient) SearchNodes(args []string) (err error) {
 	}
 	for i, n := range res.Nodes {
 		fmt.Println("# result", i)
-		printNode(n)
+		printNode(n.Node)
+		if res.PartitionCounts {
+			fmt.Print(brimtext.Align([][]string{[]string{"Partitions:", fmt.Sprintf("%d", n.Partitions)}}, nil))
+		}
+	}
+	return nil
+}
+
+//whereKeyCmd prints the nodes responsible for a key in the current and previous ring versions
+func (s *SyndClient) whereKeyCmd(key string) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	res, err := s.client.LookupKey(ctx, &pb.KeyLookup{Key: []byte(key), Previous: true})
+	if err != nil {
+		return err
 	}
+	printPartitionLookup(res)
+	return nil
+}
 
+//wherePartitionCmd prints the nodes responsible for a partition in the current and previous ring versions
+func (s *SyndClient) wherePartitionCmd(partition uint32) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	res, err := s.client.LookupPartition(ctx, &pb.PartitionLookup{Partition: partition, Previous: true})
+	if err != nil {
+		return err
+	}
+	printPartitionLookup(res)
 	return nil
 }
 
+func printPartitionLookup(res *pb.PartitionLookupResult) {
+	report := [][]string{
+		[]string{"Version:", fmt.Sprintf("%d", res.Version)},
+		[]string{"Partition:", fmt.Sprintf("%d", res.Partition)},
+	}
+	report = append(report, replicaRows(res.Replicas)...)
+	if res.PreviousVersion == 0 {
+		report = append(report, []string{"Previous:", "previous ring version unknown"})
+	} else {
+		report = append(report, []string{"Previous Version:", fmt.Sprintf("%d", res.PreviousVersion)})
+		report = append(report, []string{"Previous Partition:", fmt.Sprintf("%d", res.PreviousPartition)})
+		report = append(report, replicaRows(res.PreviousReplicas)...)
+	}
+	fmt.Print(brimtext.Align(report, nil))
+}
+
+func replicaRows(replicas []*pb.PartitionReplica) [][]string {
+	var rows [][]string
+	for i, r := range replicas {
+		rows = append(rows, []string{fmt.Sprintf("Replica %d:", i), fmt.Sprintf("%d %s %s", r.Id, r.Meta, strings.Join(r.Addresses, ","))})
+	}
+	return rows
+}
+
+//ringStatsCmd prints how well balanced the ring is
+func (s *SyndClient) ringStatsCmd() error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	stats, err := s.client.GetRingStats(ctx, &pb.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	report := [][]string{
+		[]string{"Version:", fmt.Sprintf("%d", stats.Version)},
+		[]string{"Partitions:", fmt.Sprintf("%d", stats.Partitions)},
+		[]string{"Replicas:", fmt.Sprintf("%d", stats.Replicas)},
+		[]string{"Active Nodes:", fmt.Sprintf("%d", stats.ActiveNodes)},
+		[]string{"Inactive Nodes:", fmt.Sprintf("%d", stats.InactiveNodes)},
+		[]string{"Zero Capacity Nodes:", fmt.Sprintf("%d", stats.ZeroCapacityNodes)},
+		[]string{"Max Overweight:", fmt.Sprintf("%.2f%%", stats.MaxOverWeight)},
+		[]string{"Max Underweight:", fmt.Sprintf("%.2f%%", stats.MaxUnderWeight)},
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	fmt.Println()
+	nodes := [][]string{
+		[]string{"ID", "Meta", "Active", "Capacity", "Desired", "Assigned", "Weight"},
+	}
+	for _, n := range stats.Nodes {
+		nodes = append(nodes, []string{
+			fmt.Sprintf("%d", n.Id),
+			n.Meta,
+			fmt.Sprintf("%v", n.Active),
+			fmt.Sprintf("%d", n.Capacity),
+			fmt.Sprintf("%.2f", n.Desired),
+			fmt.Sprintf("%d", n.Assigned),
+			fmt.Sprintf("%+.2f%%", n.Weight),
+		})
+	}
+	fmt.Print(brimtext.Align(nodes, nil))
+	fmt.Println()
+	printTierBalance(stats.Tiers)
+	return nil
+}
+
+func printTierBalance(tiers []*pb.TierBalance) {
+	report := [][]string{
+		[]string{"Level", "Tier", "Nodes", "Capacity", "Desired", "Assigned", "Weight"},
+	}
+	for _, t := range tiers {
+		report = append(report, []string{
+			fmt.Sprintf("%d", t.Level),
+			t.Tier,
+			fmt.Sprintf("%d", t.Nodes),
+			fmt.Sprintf("%d", t.Capacity),
+			fmt.Sprintf("%.2f", t.Desired),
+			fmt.Sprintf("%d", t.Assigned),
+			fmt.Sprintf("%+.2f%%", t.Weight),
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+}
+
 //WatchRing prints out ring versions as ring changes occur
 func (s *SyndClient) WatchRing() error {
-	ctx := context.Background()
+	ctx := s.baseContext()
 	hname, _ := os.Hostname()
 	user, _ := user.Current()
-	sid := pb.SubscriberID{Id: fmt.Sprintf("%s:%s-sc", hname, user.Name)}
+	sid := pb.SubscriberID{Id: fmt.Sprintf("%s:%s-sc", hname, user.Name), Deltas: true}
 	stream, err := s.client.GetRingStream(ctx, &sid)
 	if err != nil {
 		return err
@@ -288,41 +373,159 @@ func (s *SyndClient) WatchRing() error {
 		if err != nil {
 			return err
 		}
-		fmt.Println(ring.Version)
+		if ring.Delta != nil {
+			fmt.Printf("%d (delta from %d: %d nodes changed, %d removed, %d partitions moved, config changed: %v)\n",
+				ring.Version, ring.Delta.BaseVersion, len(ring.Delta.Nodes), len(ring.Delta.RemovedNodes), len(ring.Delta.Partitions), ring.Delta.ConfChanged)
+		} else {
+			fmt.Printf("%d (full ring, %d bytes)\n", ring.Version, len(ring.Ring))
+		}
 	}
 	return nil
 }
 
-//GetSoftwareVersions asks synd to query each host for its running software version
-//TODO: These are brute force (we're just looping over each id and querying each seperately),
-//and synd's not locking for the entire "transaction". So versions could change before this
-//list is finished (or nodes be added or removed)!
-//Long term synd should have a "GetAllSoftwareVersions()" call.
-func (s *SyndClient) GetSoftwareVersions() error {
-	ctx := context.Background()
-	res, err := s.client.SearchNodes(ctx, &pb.Node{})
+//WatchRingEvents prints ring change events as they occur, optionally filtered
+//by event type and node id (i.e. types=NODE_ADDED,NODE_REMOVED nodes=1234).
+func (s *SyndClient) WatchRingEvents(args []string) error {
+	filter := &pb.RingEventFilter{}
+	for _, arg := range args {
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return fmt.Errorf(`invalid expression %#v; needs "types=" or "nodes="`, arg)
+		}
+		switch sarg[0] {
+		case "types":
+			for _, t := range strings.Split(sarg[1], ",") {
+				v, ok := pb.RingEventType_value[strings.ToUpper(t)]
+				if !ok {
+					return fmt.Errorf("invalid event type %#v", t)
+				}
+				filter.Types = append(filter.Types, pb.RingEventType(v))
+			}
+		case "nodes":
+			for _, n := range strings.Split(sarg[1], ",") {
+				id, err := strconv.ParseUint(n, 0, 64)
+				if err != nil {
+					return err
+				}
+				filter.Nodes = append(filter.Nodes, id)
+			}
+		default:
+			return fmt.Errorf(`invalid expression %#v; needs "types=" or "nodes="`, arg)
+		}
+	}
+	stream, err := s.client.WatchRingEvents(s.baseContext(), filter)
 	if err != nil {
 		return err
 	}
-	for _, node := range res.Nodes {
-		ver, err := s.client.GetNodeSoftwareVersion(ctx, node)
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
-			fmt.Printf("%d Error getting software version for: %s\n", node.Id, err.Error())
-			continue
+			return err
 		}
-		fmt.Printf("%d %s - %+v\n", node.Id, ver.Version, node.Addresses)
+		fmt.Printf("%d %s %s\n", e.Version, e.Type, describeRingEvent(e))
 	}
 	return nil
 }
 
+func describeRingEvent(e *pb.RingEvent) string {
+	switch e.Type {
+	case pb.RingEventType_REPLICAS_CHANGED:
+		return fmt.Sprintf("replicas %d -> %d", e.OldReplicas, e.NewReplicas)
+	case pb.RingEventType_CONFIG_CHANGED:
+		return fmt.Sprintf("config %q -> %q", e.OldConf, e.NewConf)
+	case pb.RingEventType_NODE_ADDED:
+		return fmt.Sprintf("node %d (%s)", e.Node, e.NewNode.Meta)
+	case pb.RingEventType_NODE_REMOVED:
+		return fmt.Sprintf("node %d (%s)", e.Node, e.OldNode.Meta)
+	case pb.RingEventType_NODE_ACTIVATED, pb.RingEventType_NODE_DEACTIVATED:
+		return fmt.Sprintf("node %d (%s) active %v -> %v", e.Node, e.NewNode.Meta, e.OldNode.Active, e.NewNode.Active)
+	case pb.RingEventType_CAPACITY_CHANGED:
+		return fmt.Sprintf("node %d (%s) capacity %d -> %d", e.Node, e.NewNode.Meta, e.OldNode.Capacity, e.NewNode.Capacity)
+	case pb.RingEventType_TIERS_CHANGED:
+		return fmt.Sprintf("node %d (%s) tiers %s -> %s", e.Node, e.NewNode.Meta, strings.Join(e.OldNode.Tiers, ","), strings.Join(e.NewNode.Tiers, ","))
+	case pb.RingEventType_ADDRESSES_CHANGED:
+		return fmt.Sprintf("node %d (%s) addresses %s -> %s", e.Node, e.NewNode.Meta, strings.Join(e.OldNode.Addresses, ","), strings.Join(e.NewNode.Addresses, ","))
+	}
+	return fmt.Sprintf("node %d", e.Node)
+}
+
+//listSubscribersCmd prints the connected ring subscribers and how far behind they are
+func (s *SyndClient) listSubscribersCmd() error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	res, err := s.client.ListSubscribers(ctx, &pb.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	fmt.Println("Ring Version:", res.Version)
+	report := [][]string{
+		[]string{"ID", "Peer", "Connected", "Last Version", "Last Send", "Deltas", "Current"},
+	}
+	for _, sub := range res.Subscribers {
+		lastSend := "never"
+		if sub.LastSend != 0 {
+			lastSend = time.Unix(sub.LastSend, 0).Format(time.RFC3339)
+		}
+		report = append(report, []string{
+			sub.Id,
+			sub.Peer,
+			time.Unix(sub.Connected, 0).Format(time.RFC3339),
+			fmt.Sprintf("%d", sub.LastVersion),
+			lastSend,
+			fmt.Sprintf("%v", sub.Deltas),
+			fmt.Sprintf("%v", sub.LastVersion == res.Version),
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	return nil
+}
+
+//disconnectSubscriberCmd asks synd to disconnect the given ring subscriber
+func (s *SyndClient) disconnectSubscriberCmd(id string) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	_, err := s.client.DisconnectSubscriber(ctx, &pb.SubscriberID{Id: id})
+	if err != nil {
+		return err
+	}
+	fmt.Println("Disconnected:", id)
+	return nil
+}
+
+//GetSoftwareVersions asks synd for the running software version of every managed node,
+//grouped by version.
+func (s *SyndClient) GetSoftwareVersions() error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 60*time.Second)
+	res, err := s.client.GetAllSoftwareVersions(ctx, &pb.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	if len(res.Versions) > 1 {
+		fmt.Printf("WARNING: %d different versions running across %d nodes\n", len(res.Versions), res.Nodes)
+	}
+	report := [][]string{[]string{"Version", "ID", "Address", "Error"}}
+	for _, g := range res.Versions {
+		report = append(report, []string{fmt.Sprintf("%s (%d nodes)", g.Version, len(g.Nodes)), "", "", ""})
+		for _, n := range g.Nodes {
+			report = append(report, []string{"", fmt.Sprintf("%d", n.Id), n.Address, ""})
+		}
+	}
+	if len(res.Failed) != 0 {
+		report = append(report, []string{fmt.Sprintf("failed (%d nodes)", len(res.Failed)), "", "", ""})
+		for _, n := range res.Failed {
+			report = append(report, []string{"", fmt.Sprintf("%d", n.Id), n.Address, n.Error})
+		}
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	return nil
+}
+
 //UpgradeSoftwareVersions asks synd to query each host for its running software version
 //NOTE: Upgrades roll on to other nodes regardless of whether any individual nodes encounters an error!
-//TODO: These are brute force (we're just looping over each id and asking for upgrades of
-//each found node seperately), and synd's not locking for the entire "transaction".
-//So versions (or nodes added/removed) could change before this list & roll out is finished!
-//Long term synd should have a "UpgradeAllSoftwareVersions()" call.
+//DEPRECATED: use upgradeCmd, which has synd run a batched and health checked UpgradeCluster.
 func (s *SyndClient) UpgradeSoftwareVersions(version string) error {
-	ctx := context.Background()
+	ctx := s.baseContext()
 	res, err := s.client.SearchNodes(ctx, &pb.Node{})
 	if err != nil {
 		return err
@@ -337,3 +540,812 @@ func (s *SyndClient) UpgradeSoftwareVersions(version string) error {
 	}
 	return nil
 }
+
+//upgradeCmd starts, controls or reports on a cluster upgrade:
+//upgrade <version> [batch=N] [tier=N] [timeout=S] [--follow]
+//upgrade status|pause|resume|abort [--follow]
+func (s *SyndClient) upgradeCmd(args []string) error {
+	follow := false
+	var rest []string
+	for _, arg := range args {
+		if arg == "--follow" {
+			follow = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	if len(rest) == 0 {
+		if follow {
+			return s.followUpgrade()
+		}
+		return fmt.Errorf("upgrade needs a version or one of status, pause, resume or abort")
+	}
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	var status *pb.UpgradeStatus
+	var err error
+	switch rest[0] {
+	case "status":
+		status, err = s.client.GetUpgradeStatus(ctx, &pb.EmptyMsg{})
+	case "pause":
+		status, err = s.client.PauseUpgrade(ctx, &pb.EmptyMsg{})
+	case "resume":
+		status, err = s.client.ResumeUpgrade(ctx, &pb.EmptyMsg{})
+	case "abort":
+		status, err = s.client.AbortUpgrade(ctx, &pb.EmptyMsg{})
+	default:
+		var r *pb.UpgradeRequest
+		r, err = parseUpgradeRequest(rest)
+		if err != nil {
+			return err
+		}
+		status, err = s.client.UpgradeCluster(ctx, r)
+	}
+	if err != nil {
+		return err
+	}
+	if follow {
+		return s.followUpgrade()
+	}
+	printUpgradeStatus(status)
+	return nil
+}
+
+func parseUpgradeRequest(args []string) (*pb.UpgradeRequest, error) {
+	r := &pb.UpgradeRequest{Version: args[0]}
+	for _, arg := range args[1:] {
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return nil, fmt.Errorf(`invalid expression %#v; needs "batch=", "tier=", "timeout=", "canaries=" or "soak="`, arg)
+		}
+		if sarg[0] == "canaries" {
+			for _, c := range strings.Split(sarg[1], ",") {
+				id, err := strconv.ParseUint(c, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid expression %#v; %s", arg, err)
+				}
+				r.Canaries = append(r.Canaries, id)
+			}
+			continue
+		}
+		v, err := strconv.ParseUint(sarg[1], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %#v; %s", arg, err)
+		}
+		switch sarg[0] {
+		case "batch":
+			r.BatchSize = uint32(v)
+		case "tier":
+			r.Tier = int32(v)
+		case "timeout":
+			r.HealthTimeout = int32(v)
+		case "soak":
+			r.Soak = int32(v)
+		default:
+			return nil, fmt.Errorf(`invalid expression %#v; needs "batch=", "tier=", "timeout=", "canaries=" or "soak="`, arg)
+		}
+	}
+	return r, nil
+}
+
+//followUpgrade prints the progress of the current upgrade until it finishes
+func (s *SyndClient) followUpgrade() error {
+	stream, err := s.client.WatchUpgrade(s.baseContext(), &pb.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	var last *pb.UpgradeStatus
+	var lastLine string
+	for {
+		status, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		last = status
+		if line := upgradeProgress(status); line != lastLine {
+			fmt.Println(line)
+			lastLine = line
+		}
+	}
+	if last != nil {
+		printUpgradeStatus(last)
+	}
+	return nil
+}
+
+func upgradeProgress(status *pb.UpgradeStatus) string {
+	if status.State == pb.UpgradeState_UPGRADE_IDLE {
+		return "no upgrade"
+	}
+	counts := make(map[pb.UpgradeNodeState]int)
+	for _, n := range status.Nodes {
+		counts[n.State]++
+	}
+	var parts []string
+	for state := pb.UpgradeNodeState_NODE_PENDING; ; state++ {
+		name, ok := pb.UpgradeNodeState_name[int32(state)]
+		if !ok {
+			break
+		}
+		if counts[state] != 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[state], strings.ToLower(strings.TrimPrefix(name, "NODE_"))))
+		}
+	}
+	return fmt.Sprintf("%s %s batch %d/%d: %s", time.Now().Format("15:04:05"), status.State, status.Batch, status.Batches, strings.Join(parts, ", "))
+}
+
+func printUpgradeStatus(status *pb.UpgradeStatus) {
+	if status.State == pb.UpgradeState_UPGRADE_IDLE {
+		fmt.Println("No upgrade has been run")
+		return
+	}
+	report := [][]string{
+		[]string{"Version:", status.Version},
+		[]string{"State:", status.State.String()},
+		[]string{"Batch:", fmt.Sprintf("%d/%d (size %d, tier%d domains)", status.Batch, status.Batches, status.BatchSize, status.Tier)},
+		[]string{"Started:", time.Unix(status.Started, 0).Format(time.RFC3339)},
+	}
+	if status.SoakUntil != 0 {
+		report = append(report, []string{"Soak until:", time.Unix(status.SoakUntil, 0).Format(time.RFC3339)})
+	}
+	if status.Finished != 0 {
+		report = append(report, []string{"Finished:", time.Unix(status.Finished, 0).Format(time.RFC3339)})
+	}
+	if status.Error != "" {
+		report = append(report, []string{"Error:", status.Error})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	nodes := [][]string{[]string{"Batch", "Domain", "ID", "Address", "State", "Previous", "Version", "Error"}}
+	for _, n := range status.Nodes {
+		batch := fmt.Sprintf("%d", n.Batch)
+		if n.Canary {
+			batch += " (canary)"
+		}
+		nodes = append(nodes, []string{
+			batch,
+			n.Domain,
+			fmt.Sprintf("%d", n.Id),
+			n.Address,
+			strings.TrimPrefix(n.State.String(), "NODE_"),
+			n.PreviousVersion,
+			n.Version,
+			n.Error,
+		})
+	}
+	fmt.Print(brimtext.Align(nodes, nil))
+}
+
+//controlNodesCmd starts, stops or restarts the backends of nodes via synd
+func (s *SyndClient) controlNodesCmd(action string, args []string) error {
+	r := &pb.NodeControlRequest{}
+	for _, arg := range args {
+		if arg == "--continue" {
+			r.ContinueOnError = true
+			continue
+		}
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return fmt.Errorf(`invalid expression %#v; needs "ids=", "query=", "concurrency=", "tier=" or "timeout="`, arg)
+		}
+		switch sarg[0] {
+		case "ids":
+			for _, v := range strings.Split(sarg[1], ",") {
+				id, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid expression %#v; %s", arg, err)
+				}
+				r.Ids = append(r.Ids, id)
+			}
+			continue
+		case "query":
+			r.Query = sarg[1]
+			continue
+		}
+		v, err := strconv.ParseUint(sarg[1], 10, 31)
+		if err != nil {
+			return fmt.Errorf("invalid expression %#v; %s", arg, err)
+		}
+		switch sarg[0] {
+		case "concurrency":
+			r.Concurrency = uint32(v)
+		case "tier":
+			r.Tier = int32(v)
+		case "timeout":
+			r.HealthTimeout = int32(v)
+		default:
+			return fmt.Errorf(`invalid expression %#v; needs "ids=", "query=", "concurrency=", "tier=" or "timeout="`, arg)
+		}
+	}
+	var res *pb.NodeControlResult
+	var err error
+	switch action {
+	case "start":
+		res, err = s.client.StartNodes(s.baseContext(), r)
+	case "stop":
+		res, err = s.client.StopNodes(s.baseContext(), r)
+	case "restart":
+		res, err = s.client.RestartNodes(s.baseContext(), r)
+	default:
+		return fmt.Errorf("unknown nodes action %q; needs start, stop or restart", action)
+	}
+	if err != nil {
+		return err
+	}
+	report := [][]string{[]string{"Domain", "ID", "Address", "Result", "Error"}}
+	for _, n := range res.Nodes {
+		result := "ok"
+		switch {
+		case n.Skipped:
+			result = "skipped"
+		case !n.Status:
+			result = "failed"
+		}
+		report = append(report, []string{n.Domain, fmt.Sprintf("%d", n.Id), n.Address, result, n.Error})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	fmt.Printf("%d nodes, %d failed, %d skipped\n", len(res.Nodes), res.Failed, res.Skipped)
+	if res.Failed != 0 {
+		return fmt.Errorf("%s failed on %d nodes", action, res.Failed)
+	}
+	return nil
+}
+
+func (s *SyndClient) maintenanceCmd(args []string) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 30*time.Second)
+	switch args[0] {
+	case "list":
+		list, err := s.client.ListMaintenance(ctx, &pb.EmptyMsg{})
+		if err != nil {
+			return err
+		}
+		report := [][]string{[]string{"ID", "Reason", "Since", "Expires", "Capacity", "Draining", "Caller"}}
+		for _, m := range list.Nodes {
+			expires := "never"
+			if m.Expires != 0 {
+				expires = time.Unix(m.Expires, 0).Format(time.RFC3339)
+			}
+			draining := "no"
+			if m.Draining {
+				draining = fmt.Sprintf("to %d, %d every %ds", m.DrainTarget, m.DrainStep, m.DrainInterval)
+			}
+			report = append(report, []string{
+				fmt.Sprintf("%d", m.Id),
+				m.Reason,
+				time.Unix(m.Started, 0).Format(time.RFC3339),
+				expires,
+				fmt.Sprintf("%d (was %d)", m.Capacity, m.OriginalCapacity),
+				draining,
+				m.Caller,
+			})
+		}
+		fmt.Print(brimtext.Align(report, nil))
+		return nil
+	case "clear":
+		if len(args) < 2 || len(args) > 3 || (len(args) == 3 && args[2] != "restore") {
+			return fmt.Errorf("maintenance clear needs a node id and optionally restore")
+		}
+		id, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		status, err := s.client.ClearMaintenance(ctx, &pb.MaintenanceRequest{Id: id, RestoreCapacity: len(args) == 3})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Node %d out of maintenance, capacity %d\n", status.Id, status.Capacity)
+		return nil
+	}
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("maintenance needs a node id, clear or list")
+	}
+	r := &pb.MaintenanceRequest{Id: id}
+	for _, arg := range args[1:] {
+		switch arg {
+		case "drain":
+			r.Drain = true
+			continue
+		case "restore":
+			r.RestoreCapacity = true
+			continue
+		}
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return fmt.Errorf(`invalid expression %#v; needs "reason=", "duration=", "target=", "step=" or "interval="`, arg)
+		}
+		if sarg[0] == "reason" {
+			r.Reason = sarg[1]
+			continue
+		}
+		v, err := strconv.ParseUint(sarg[1], 10, 31)
+		if err != nil {
+			return fmt.Errorf("invalid expression %#v; %s", arg, err)
+		}
+		switch sarg[0] {
+		case "duration":
+			r.Duration = int64(v)
+		case "target":
+			r.DrainTarget = uint32(v)
+		case "step":
+			r.DrainStep = uint32(v)
+		case "interval":
+			r.DrainInterval = int32(v)
+		default:
+			return fmt.Errorf(`invalid expression %#v; needs "reason=", "duration=", "target=", "step=" or "interval="`, arg)
+		}
+	}
+	status, err := s.client.SetMaintenance(ctx, r)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Node %d in maintenance", status.Id)
+	if status.Expires != 0 {
+		fmt.Printf(" until %s", time.Unix(status.Expires, 0).Format(time.RFC3339))
+	}
+	if status.Draining {
+		fmt.Printf(", draining to %d by %d every %ds", status.DrainTarget, status.DrainStep, status.DrainInterval)
+	}
+	fmt.Println()
+	return nil
+}
+
+func (s *SyndClient) rampCmd(args []string) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 30*time.Second)
+	var ramps []*pb.RampStatus
+	switch args[0] {
+	case "status":
+		list, err := s.client.GetRampStatus(ctx, &pb.EmptyMsg{})
+		if err != nil {
+			return err
+		}
+		ramps = list.Ramps
+	case "pause", "resume", "abort":
+		if len(args) != 2 {
+			return fmt.Errorf("ramp %s needs a node id", args[0])
+		}
+		id, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		var status *pb.RampStatus
+		switch args[0] {
+		case "pause":
+			status, err = s.client.PauseRamp(ctx, &pb.Node{Id: id})
+		case "resume":
+			status, err = s.client.ResumeRamp(ctx, &pb.Node{Id: id})
+		case "abort":
+			status, err = s.client.AbortRamp(ctx, &pb.Node{Id: id})
+		}
+		if err != nil {
+			return err
+		}
+		ramps = []*pb.RampStatus{status}
+	default:
+		if len(args) < 2 {
+			return fmt.Errorf("ramp needs a node id and target capacity")
+		}
+		r, err := parseRampRequest(args)
+		if err != nil {
+			return err
+		}
+		status, err := s.client.RampCapacity(ctx, r)
+		if err != nil {
+			return err
+		}
+		ramps = []*pb.RampStatus{status}
+	}
+	report := [][]string{[]string{"ID", "State", "Capacity", "Start", "Target", "Steps", "Next step", "Error"}}
+	for _, r := range ramps {
+		next := ""
+		if r.NextStep != 0 {
+			next = time.Unix(r.NextStep, 0).Format(time.RFC3339)
+		}
+		report = append(report, []string{
+			fmt.Sprintf("%d", r.Id),
+			strings.TrimPrefix(r.State.String(), "RAMP_"),
+			fmt.Sprintf("%d", r.Capacity),
+			fmt.Sprintf("%d", r.Start),
+			fmt.Sprintf("%d", r.Target),
+			fmt.Sprintf("%d/%d", r.StepsDone, r.Steps),
+			next,
+			r.Error,
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	return nil
+}
+
+func parseRampRequest(args []string) (*pb.RampRequest, error) {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node id %#v; %s", args[0], err)
+	}
+	target, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capacity %#v; %s", args[1], err)
+	}
+	r := &pb.RampRequest{Id: id, Target: uint32(target)}
+	for _, arg := range args[2:] {
+		if arg == "converge" {
+			r.WaitConverged = true
+			continue
+		}
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return nil, fmt.Errorf(`invalid expression %#v; needs "step=", "interval=" or "timeout="`, arg)
+		}
+		v, err := strconv.ParseUint(sarg[1], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %#v; %s", arg, err)
+		}
+		switch sarg[0] {
+		case "step":
+			r.Step = uint32(v)
+		case "interval":
+			r.Interval = int32(v)
+		case "timeout":
+			r.ConvergeTimeout = int32(v)
+		default:
+			return nil, fmt.Errorf(`invalid expression %#v; needs "step=", "interval=" or "timeout="`, arg)
+		}
+	}
+	return r, nil
+}
+
+func (s *SyndClient) decommissionCmd(args []string) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 30*time.Second)
+	var decoms []*pb.DecommissionStatus
+	if args[0] == "status" {
+		list, err := s.client.GetDecommissionStatus(ctx, &pb.EmptyMsg{})
+		if err != nil {
+			return err
+		}
+		decoms = list.Nodes
+	} else {
+		r, err := parseDecommissionRequest(args)
+		if err != nil {
+			return err
+		}
+		status, err := s.client.DecommissionNode(ctx, r)
+		if err != nil {
+			return err
+		}
+		decoms = []*pb.DecommissionStatus{status}
+	}
+	report := [][]string{[]string{"ID", "Address", "State", "Ring version", "Updated", "Error"}}
+	for _, d := range decoms {
+		state := strings.TrimPrefix(d.State.String(), "DECOMMISSION_")
+		if d.Failed {
+			state += " (failed)"
+		}
+		report = append(report, []string{
+			fmt.Sprintf("%d", d.Id),
+			d.Address,
+			state,
+			fmt.Sprintf("%d", d.RingVersion),
+			time.Unix(d.Updated, 0).Format(time.RFC3339),
+			d.Error,
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	return nil
+}
+
+func parseDecommissionRequest(args []string) (*pb.DecommissionRequest, error) {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node id %#v; %s", args[0], err)
+	}
+	r := &pb.DecommissionRequest{Id: id}
+	for _, arg := range args[1:] {
+		switch arg {
+		case "ramp":
+			r.Ramp = true
+			continue
+		case "stop":
+			r.StopBackend = true
+			continue
+		}
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return nil, fmt.Errorf(`invalid expression %#v; needs "step=", "interval=" or "timeout="`, arg)
+		}
+		v, err := strconv.ParseUint(sarg[1], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %#v; %s", arg, err)
+		}
+		switch sarg[0] {
+		case "step":
+			r.RampStep = uint32(v)
+		case "interval":
+			r.RampInterval = int32(v)
+		case "timeout":
+			r.ConvergeTimeout = int32(v)
+		default:
+			return nil, fmt.Errorf(`invalid expression %#v; needs "step=", "interval=" or "timeout="`, arg)
+		}
+	}
+	return r, nil
+}
+
+func (s *SyndClient) replaceNodeCmd(args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid node id %#v; %s", args[0], err)
+	}
+	r := &pb.ReplaceNodeRequest{Id: id}
+	for _, arg := range args[1:] {
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return fmt.Errorf(`invalid expression %#v; needs "hostname=" or "addrs="`, arg)
+		}
+		switch sarg[0] {
+		case "hostname":
+			r.Hostname = sarg[1]
+		case "addrs":
+			r.Addrs = strings.Split(sarg[1], ",")
+		default:
+			return fmt.Errorf(`invalid expression %#v; needs "hostname=" or "addrs="`, arg)
+		}
+	}
+	if r.Hostname == "" {
+		if r.Hostname, err = os.Hostname(); err != nil {
+			return err
+		}
+	}
+	if len(r.Addrs) == 0 {
+		addrs, err := net.InterfaceAddrs()
+		if err != nil {
+			return err
+		}
+		for _, a := range addrs {
+			r.Addrs = append(r.Addrs, a.String())
+		}
+	}
+	if r.Hardware, err = srvconf.GetHardwareProfile(); err != nil {
+		return err
+	}
+	ctx, _ := context.WithTimeout(s.baseContext(), 30*time.Second)
+	nc, err := s.client.ReplaceNode(ctx, r)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Node %d replaced by %s\n", nc.Localid, r.Hostname)
+	return nil
+}
+
+func (s *SyndClient) nodeHardwareCmd(id uint64) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 10*time.Second)
+	h, err := s.client.GetNodeHardware(ctx, &pb.Node{Id: id})
+	if err != nil {
+		return err
+	}
+	report := [][]string{
+		[]string{"ID:", fmt.Sprintf("%d", h.Id)},
+		[]string{"Hostname:", h.Hostname},
+		[]string{"Reported:", time.Unix(h.Updated, 0).Format(time.RFC3339)},
+		[]string{"CPUs:", fmt.Sprintf("%d", h.Hardware.Cpus)},
+		[]string{"Memory total:", fmt.Sprintf("%d", h.Hardware.Memtotal)},
+		[]string{"Memory free:", fmt.Sprintf("%d", h.Hardware.Memfree)},
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	disks := [][]string{[]string{"Device", "Path", "Size", "Used", "Used %"}}
+	for _, d := range h.Hardware.Disks {
+		var pct float64
+		if d.Size_ != 0 {
+			pct = float64(d.Used) / float64(d.Size_) * 100
+		}
+		disks = append(disks, []string{
+			d.Device,
+			d.Path,
+			fmt.Sprintf("%d", d.Size_),
+			fmt.Sprintf("%d", d.Used),
+			fmt.Sprintf("%.1f", pct),
+		})
+	}
+	fmt.Print(brimtext.Align(disks, nil))
+	return nil
+}
+
+func (s *SyndClient) capacityPolicyCmd(args []string) error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 30*time.Second)
+	var proposals []*pb.CapacityProposal
+	switch {
+	case len(args) == 0:
+		status, err := s.client.GetCapacityPolicy(ctx, &pb.EmptyMsg{})
+		if err != nil {
+			return err
+		}
+		mode := status.Mode
+		if mode == "" {
+			mode = "disabled"
+		}
+		fmt.Printf("Capacity policy: %s\n", mode)
+		proposals = append(status.Pending, status.History...)
+	case len(args) == 2 && (args[0] == "approve" || args[0] == "reject"):
+		id, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		var p *pb.CapacityProposal
+		if args[0] == "approve" {
+			p, err = s.client.ApproveCapacityProposal(ctx, &pb.Node{Id: id})
+		} else {
+			p, err = s.client.RejectCapacityProposal(ctx, &pb.Node{Id: id})
+		}
+		if err != nil {
+			return err
+		}
+		proposals = []*pb.CapacityProposal{p}
+	default:
+		return fmt.Errorf("policy needs no arguments or approve|reject <id>")
+	}
+	report := [][]string{[]string{"ID", "State", "Fill %", "Capacity", "Proposed", "Created", "Decided by", "Error"}}
+	for _, p := range proposals {
+		proposed := fmt.Sprintf("%d", p.Proposed)
+		if p.Restore {
+			proposed += " (restore)"
+		}
+		report = append(report, []string{
+			fmt.Sprintf("%d", p.Id),
+			strings.TrimPrefix(p.State.String(), "PROPOSAL_"),
+			fmt.Sprintf("%.1f", p.Fill),
+			fmt.Sprintf("%d", p.Capacity),
+			proposed,
+			time.Unix(p.Created, 0).Format(time.RFC3339),
+			p.Caller,
+			p.Error,
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	return nil
+}
+
+func (s *SyndClient) simulateCmd(args []string) error {
+	req, builder, err := parseSimulateRequest(args)
+	if err != nil {
+		return err
+	}
+	var res *pb.SimulateResult
+	if builder != "" {
+		_, b, err := ring.RingOrBuilder(builder)
+		if err != nil {
+			return err
+		}
+		if b == nil {
+			return fmt.Errorf("%s is not a builder file", builder)
+		}
+		res, err = syndicate.SimulateRing(b, nil, req, nil)
+		if err != nil {
+			return err
+		}
+	} else {
+		ctx, _ := context.WithTimeout(s.baseContext(), 30*time.Second)
+		res, err = s.client.SimulateRing(ctx, req)
+		if err != nil {
+			return err
+		}
+	}
+	report := [][]string{
+		[]string{"Moved Replicas:", fmt.Sprintf("%d of %d (%.2f%%)", res.Moved, res.Replicas, res.MovedPercent)},
+		[]string{"Active Nodes:", fmt.Sprintf("%d -> %d", res.Before.ActiveNodes, res.After.ActiveNodes)},
+		[]string{"Max Overweight:", fmt.Sprintf("%.2f%% -> %.2f%%", res.Before.MaxOverWeight, res.After.MaxOverWeight)},
+		[]string{"Max Underweight:", fmt.Sprintf("%.2f%% -> %.2f%%", res.Before.MaxUnderWeight, res.After.MaxUnderWeight)},
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	fmt.Println()
+	nodes := [][]string{
+		[]string{"ID", "Meta", "Capacity", "Assigned", "Projected", "Fill %", "Projected Fill %"},
+	}
+	for _, n := range res.Nodes {
+		meta := n.Meta
+		switch {
+		case n.Simulated:
+			meta += " (simulated)"
+		case n.Removed:
+			meta += " (removed)"
+		}
+		fill, projected := "-", "-"
+		if n.Reported {
+			fill = fmt.Sprintf("%.1f", n.Fill)
+			projected = fmt.Sprintf("%.1f", n.ProjectedFill)
+		}
+		nodes = append(nodes, []string{
+			fmt.Sprintf("%d", n.Id),
+			meta,
+			fmt.Sprintf("%d", n.Capacity),
+			fmt.Sprintf("%d", n.Assigned),
+			fmt.Sprintf("%d", n.Projected),
+			fill,
+			projected,
+		})
+	}
+	fmt.Print(brimtext.Align(nodes, nil))
+	fmt.Println()
+	printTierBalance(res.After.Tiers)
+	return nil
+}
+
+//parseSimulateRequest parses the simulate args, returning the builder file to
+//simulate against offline if one was given.
+func parseSimulateRequest(args []string) (*pb.SimulateRequest, string, error) {
+	r := &pb.SimulateRequest{}
+	var builder string
+	for _, arg := range args {
+		sarg := strings.SplitN(arg, "=", 2)
+		if len(sarg) != 2 || sarg[1] == "" {
+			return nil, "", fmt.Errorf(`invalid expression %#v; needs "add=", "rm=", "capacity=", "path=" or "builder="`, arg)
+		}
+		switch sarg[0] {
+		case "add":
+			parts := strings.SplitN(sarg[1], ":", 3)
+			if len(parts) < 2 {
+				return nil, "", fmt.Errorf("invalid expression %#v; needs add=<count>:<capacity>[:tier,tier]", arg)
+			}
+			count, err := strconv.ParseUint(parts[0], 10, 32)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid expression %#v; %s", arg, err)
+			}
+			capacity, err := strconv.ParseUint(parts[1], 10, 32)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid expression %#v; %s", arg, err)
+			}
+			a := &pb.SimulatedNodes{Count: uint32(count), Capacity: uint32(capacity)}
+			if len(parts) == 3 && parts[2] != "" {
+				a.Tiers = strings.Split(parts[2], ",")
+			}
+			r.Add = append(r.Add, a)
+		case "rm":
+			for _, v := range strings.Split(sarg[1], ",") {
+				id, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return nil, "", fmt.Errorf("invalid expression %#v; %s", arg, err)
+				}
+				r.Remove = append(r.Remove, id)
+			}
+		case "capacity":
+			parts := strings.SplitN(sarg[1], ":", 2)
+			if len(parts) != 2 {
+				return nil, "", fmt.Errorf("invalid expression %#v; needs capacity=<id>:<capacity>", arg)
+			}
+			id, err := strconv.ParseUint(parts[0], 10, 64)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid expression %#v; %s", arg, err)
+			}
+			capacity, err := strconv.ParseUint(parts[1], 10, 32)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid expression %#v; %s", arg, err)
+			}
+			r.Capacity = append(r.Capacity, &pb.Node{Id: id, Capacity: uint32(capacity)})
+		case "path":
+			r.Path = sarg[1]
+		case "builder":
+			builder = sarg[1]
+		default:
+			return nil, "", fmt.Errorf(`invalid expression %#v; needs "add=", "rm=", "capacity=", "path=" or "builder="`, arg)
+		}
+	}
+	return r, builder, nil
+}
+
+func (s *SyndClient) reloadConfigCmd() error {
+	ctx, _ := context.WithTimeout(s.baseContext(), 60*time.Second)
+	res, err := s.client.ReloadConfig(ctx, &pb.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	report := [][]string{[]string{"Service", "Applied", "Restart Required", "Errors"}}
+	for _, svc := range res.Services {
+		report = append(report, []string{
+			svc.Service,
+			strings.Join(svc.Applied, "\n"),
+			strings.Join(svc.RestartRequired, "\n"),
+			strings.Join(svc.Errors, "\n"),
+		})
+	}
+	fmt.Print(brimtext.Align(report, nil))
+	fmt.Println("Log level:", res.LogLevel)
+	return nil
+}
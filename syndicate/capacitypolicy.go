@@ -0,0 +1,435 @@
+package syndicate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	DefaultPolicyPath              = "/data" //The default disk path whose fill the capacity policy acts on
+	DefaultPolicyFillThreshold     = 90      //The default percent full at which a nodes capacity is reduced
+	DefaultPolicyRestoreThreshold  = 75      //The default percent full below which a reduced nodes capacity is restored
+	DefaultPolicyReduction         = 10      //The default percent of a nodes capacity removed per reduction
+	DefaultPolicyMinInterval       = 3600    //The default min seconds between policy changes to the same node
+	DefaultPolicyMaxChangesPerHour = 10      //The default max policy changes applied per hour across all nodes
+	maxPolicyHistory               = 100     //The number of decided proposals kept
+)
+
+var NoCapacityProposal = errors.New("No pending capacity proposal for the node")
+
+//CapacityPolicyConfig configures the disk fullness driven capacity policy. Nodes
+//whose reported fill of Path reaches FillThreshold have their capacity reduced
+//by Reduction percent so partitions move off before they run out of space, and
+//once they've drained below RestoreThreshold their original capacity is restored.
+type CapacityPolicyConfig struct {
+	//Mode is "propose" to queue changes for approval via ApproveCapacityProposal or
+	//"apply" to apply them automatically. Empty disables the policy.
+	Mode              string
+	Path              string
+	FillThreshold     float64
+	RestoreThreshold  float64
+	Reduction         float64
+	MinInterval       int
+	MaxChangesPerHour int
+}
+
+//parseCapacityPolicy fills in the policy defaults and validates it.
+func (s *Server) parseCapacityPolicy() error {
+	c := &s.cfg.CapacityPolicy
+	switch c.Mode {
+	case "":
+		return nil
+	case "propose", "apply":
+	default:
+		return fmt.Errorf("Invalid capacity policy mode %q, needs \"propose\" or \"apply\"", c.Mode)
+	}
+	if c.Path == "" {
+		c.Path = DefaultPolicyPath
+	}
+	if c.FillThreshold == 0 {
+		c.FillThreshold = DefaultPolicyFillThreshold
+	}
+	if c.RestoreThreshold == 0 {
+		c.RestoreThreshold = DefaultPolicyRestoreThreshold
+	}
+	if c.Reduction == 0 {
+		c.Reduction = DefaultPolicyReduction
+	}
+	if c.MinInterval == 0 {
+		c.MinInterval = DefaultPolicyMinInterval
+	}
+	if c.MaxChangesPerHour == 0 {
+		c.MaxChangesPerHour = DefaultPolicyMaxChangesPerHour
+	}
+	if c.RestoreThreshold >= c.FillThreshold {
+		return fmt.Errorf("Capacity policy restore threshold (%v) must be below its fill threshold (%v)", c.RestoreThreshold, c.FillThreshold)
+	}
+	if c.Reduction < 0 || c.Reduction > 100 {
+		return fmt.Errorf("Invalid capacity policy reduction %v", c.Reduction)
+	}
+	return nil
+}
+
+//capacityPolicy is the persisted state of the capacity policy.
+type capacityPolicy struct {
+	Nodes   []*policyNode          `json:"nodes"`
+	Pending []*pb.CapacityProposal `json:"pending"`
+	History []*pb.CapacityProposal `json:"history"`
+	Changes []time.Time            `json:"changes"` //when changes were applied, for the hourly limit
+}
+
+//policyNode is the policy state of a single node.
+type policyNode struct {
+	Id               uint64    `json:"id"`
+	OriginalCapacity uint32    `json:"original_capacity,omitempty"` //the capacity before the policy first reduced it
+	LastDecision     time.Time `json:"last_decision"`
+}
+
+func (s *Server) capacityPolicyPath() string {
+	return filepath.Join(s.cfg.RingDir, fmt.Sprintf("%s.capacitypolicy", s.servicename))
+}
+
+//loadCapacityPolicy loads the persisted policy state, a missing file just means
+//the policy hasn't done anything yet.
+func (s *Server) loadCapacityPolicy() error {
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	s.policy = &capacityPolicy{}
+	data, err := ioutil.ReadFile(s.capacityPolicyPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, s.policy); err != nil {
+		return fmt.Errorf("Invalid capacity policy file %s: %s", s.capacityPolicyPath(), err)
+	}
+	return nil
+}
+
+//saveCapacityPolicy persists the policy state, s.policyLock must be held.
+func (s *Server) saveCapacityPolicy() {
+	data, err := json.Marshal(s.policy)
+	if err == nil {
+		path := s.capacityPolicyPath()
+		if err = ioutil.WriteFile(path+".tmp", data, 0644); err == nil {
+			err = os.Rename(path+".tmp", path)
+		}
+	}
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.capacityPolicyPath(), "err": err}).Warning("Unable to persist capacity policy")
+	}
+}
+
+//policyNode returns the policy state for the node, creating it if needed.
+//s.policyLock must be held.
+func (s *Server) policyNode(id uint64) *policyNode {
+	for _, n := range s.policy.Nodes {
+		if n.Id == id {
+			return n
+		}
+	}
+	n := &policyNode{Id: id}
+	s.policy.Nodes = append(s.policy.Nodes, n)
+	return n
+}
+
+//pendingProposal returns the index of the nodes pending proposal, or -1.
+//s.policyLock must be held.
+func (s *Server) pendingProposal(id uint64) int {
+	for i, p := range s.policy.Pending {
+		if p.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+//decideProposal records the outcome of a proposal, removing it from the pending
+//proposals. s.policyLock must be held.
+func (s *Server) decideProposal(p *pb.CapacityProposal, state pb.CapacityProposalState, caller string, now time.Time) {
+	if i := s.pendingProposal(p.Id); i != -1 && s.policy.Pending[i] == p {
+		s.policy.Pending = append(s.policy.Pending[:i], s.policy.Pending[i+1:]...)
+	}
+	p.State = state
+	p.Caller = caller
+	p.Decided = now.Unix()
+	s.policy.History = append(s.policy.History, p)
+	if len(s.policy.History) > maxPolicyHistory {
+		s.policy.History = s.policy.History[len(s.policy.History)-maxPolicyHistory:]
+	}
+}
+
+//diskFill returns the percent full of the disk mounted at path.
+func diskFill(hw *pb.HardwareProfile, path string) (float64, bool) {
+	if hw == nil {
+		return 0, false
+	}
+	for _, d := range hw.Disks {
+		if d != nil && d.Path == path && d.Size_ != 0 {
+			return float64(d.Used) / float64(d.Size_) * 100, true
+		}
+	}
+	return 0, false
+}
+
+//policyBusy returns why the policy should leave a node alone, if it should.
+func (s *Server) policyBusy(id uint64) string {
+	if s.inMaintenance(id) {
+		return "in maintenance"
+	}
+	s.rampLock.Lock()
+	ramp, ok := s.ramps[id]
+	s.rampLock.Unlock()
+	if ok && !ramp.finished() {
+		return "capacity ramp in progress"
+	}
+	s.decomLock.Lock()
+	d, ok := s.decommissions[id]
+	s.decomLock.Unlock()
+	if ok && !d.finished() {
+		return "being decommissioned"
+	}
+	return ""
+}
+
+//capacityManaged returns why a nodes capacity is being managed by synd, if it
+//is, in which case registration shouldn't reset it. s.policyLock must be held.
+func (s *Server) capacityManaged(id uint64) string {
+	if reason := s.policyBusy(id); reason != "" {
+		return reason
+	}
+	if s.policy == nil {
+		return ""
+	}
+	for _, n := range s.policy.Nodes {
+		if n.Id == id && n.OriginalCapacity != 0 {
+			return "reduced by the capacity policy"
+		}
+	}
+	return ""
+}
+
+//checkCapacityPolicy evaluates the policy against the latest (non stale) node
+//reports, proposing or applying capacity changes for nodes that have filled up
+//or drained. The fullest nodes are handled first so they get any changes left
+//under the hourly limit.
+func (s *Server) checkCapacityPolicy(now time.Time) {
+	cfg := s.cfg.CapacityPolicy
+	if cfg.Mode == "" {
+		return
+	}
+	s.reportLock.Lock()
+	reports := make([]*pb.NodeStatusReport, 0, len(s.reports))
+	for _, nr := range s.reports {
+		if !nr.stale {
+			reports = append(reports, nr.report)
+		}
+	}
+	s.reportLock.Unlock()
+	sort.Sort(nodeReportsByFill{reports, s.cfg.CapacityPolicy.Path})
+
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	if s.policy == nil {
+		s.policy = &capacityPolicy{}
+	}
+	s.prunePolicy(now)
+	for _, r := range reports {
+		fill, ok := diskFill(r.Hardware, cfg.Path)
+		if !ok {
+			continue
+		}
+		capacity, err := s.nodeCapacity(r.Id)
+		if err != nil {
+			continue
+		}
+		pn := s.policyNode(r.Id)
+		p := &pb.CapacityProposal{Id: r.Id, Capacity: capacity, Fill: fill, Created: now.Unix()}
+		switch {
+		case fill >= cfg.FillThreshold && capacity > 0:
+			reduction := uint32(float64(capacity) * cfg.Reduction / 100)
+			if reduction == 0 {
+				reduction = 1
+			}
+			p.Proposed = capacity - reduction
+		case pn.OriginalCapacity > capacity && fill <= cfg.RestoreThreshold:
+			p.Restore = true
+			p.Proposed = pn.OriginalCapacity
+		default:
+			if i := s.pendingProposal(r.Id); i != -1 {
+				s.ctxlog.WithFields(log.Fields{"id": r.Id, "fill": fill}).Info("capacity proposal no longer needed")
+				s.decideProposal(s.policy.Pending[i], pb.CapacityProposalState_PROPOSAL_EXPIRED, "policy", now)
+				s.saveCapacityPolicy()
+			}
+			continue
+		}
+		if s.pendingProposal(r.Id) != -1 || now.Sub(pn.LastDecision) < time.Duration(cfg.MinInterval)*time.Second {
+			continue
+		}
+		if busy := s.policyBusy(r.Id); busy != "" {
+			s.ctxlog.WithFields(log.Fields{"id": r.Id, "fill": fill, "reason": busy}).Debug("capacity policy skipping node")
+			continue
+		}
+		if cfg.Mode == "propose" {
+			s.ctxlog.WithFields(log.Fields{"id": r.Id, "fill": fill, "capacity": capacity, "proposed": p.Proposed}).Info("capacity change proposed")
+			s.policy.Pending = append(s.policy.Pending, p)
+			s.saveCapacityPolicy()
+			continue
+		}
+		if len(s.policy.Changes) >= cfg.MaxChangesPerHour {
+			s.ctxlog.WithFields(log.Fields{"id": r.Id, "fill": fill}).Debug("capacity policy hourly change limit reached")
+			continue
+		}
+		s.applyProposal(p, "policy", now)
+	}
+}
+
+//prunePolicy drops the state of nodes no longer in the ring and applied changes
+//older than an hour. s.policyLock must be held.
+func (s *Server) prunePolicy(now time.Time) {
+	s.RLock()
+	nodes := s.policy.Nodes[:0]
+	for _, n := range s.policy.Nodes {
+		if s.r.Node(n.Id) != nil {
+			nodes = append(nodes, n)
+		}
+	}
+	s.policy.Nodes = nodes
+	pending := s.policy.Pending[:0]
+	for _, p := range s.policy.Pending {
+		if s.r.Node(p.Id) != nil {
+			pending = append(pending, p)
+		}
+	}
+	s.policy.Pending = pending
+	s.RUnlock()
+	changes := s.policy.Changes[:0]
+	for _, t := range s.policy.Changes {
+		if now.Sub(t) < time.Hour {
+			changes = append(changes, t)
+		}
+	}
+	s.policy.Changes = changes
+}
+
+//applyProposal applies the proposed capacity as its own ring change and records
+//the outcome. s.policyLock must be held.
+func (s *Server) applyProposal(p *pb.CapacityProposal, caller string, now time.Time) error {
+	pn := s.policyNode(p.Id)
+	pn.LastDecision = now
+	err := s.changeNodeCapacity(p.Id, p.Proposed, "CapacityPolicy", caller)
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{"id": p.Id, "proposed": p.Proposed, "err": err}).Warning("capacity policy change failed")
+		p.Error = err.Error()
+		s.decideProposal(p, pb.CapacityProposalState_PROPOSAL_FAILED, caller, now)
+		s.saveCapacityPolicy()
+		return err
+	}
+	if p.Restore {
+		pn.OriginalCapacity = 0
+	} else if pn.OriginalCapacity == 0 {
+		pn.OriginalCapacity = p.Capacity
+	}
+	p.RingVersion = s.ringVersion()
+	s.policy.Changes = append(s.policy.Changes, now)
+	s.ctxlog.WithFields(log.Fields{
+		"id":       p.Id,
+		"fill":     p.Fill,
+		"capacity": p.Proposed,
+		"restore":  p.Restore,
+		"ringver":  p.RingVersion,
+		"caller":   caller,
+	}).Info("capacity policy change applied")
+	s.decideProposal(p, pb.CapacityProposalState_PROPOSAL_APPLIED, caller, now)
+	s.saveCapacityPolicy()
+	return nil
+}
+
+//GetCapacityPolicy returns the policy mode, the pending proposals and the most
+//recently decided proposals.
+func (s *Server) GetCapacityPolicy(c context.Context, e *pb.EmptyMsg) (*pb.CapacityPolicyStatus, error) {
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	res := &pb.CapacityPolicyStatus{Mode: s.cfg.CapacityPolicy.Mode}
+	if s.policy == nil {
+		return res, nil
+	}
+	for _, p := range s.policy.Pending {
+		proposal := *p
+		res.Pending = append(res.Pending, &proposal)
+	}
+	for _, p := range s.policy.History {
+		proposal := *p
+		res.History = append(res.History, &proposal)
+	}
+	return res, nil
+}
+
+//ApproveCapacityProposal applies the nodes pending capacity proposal. Approved
+//proposals aren't subject to the hourly change limit.
+func (s *Server) ApproveCapacityProposal(c context.Context, n *pb.Node) (*pb.CapacityProposal, error) {
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	if s.policy == nil || s.pendingProposal(n.Id) == -1 {
+		return &pb.CapacityProposal{}, NoCapacityProposal
+	}
+	p := s.policy.Pending[s.pendingProposal(n.Id)]
+	now := time.Now()
+	if capacity, err := s.nodeCapacity(n.Id); err != nil || capacity != p.Capacity {
+		p.Error = fmt.Sprintf("Node capacity changed from %d since the proposal was made", p.Capacity)
+		s.decideProposal(p, pb.CapacityProposalState_PROPOSAL_EXPIRED, callerFromContext(c), now)
+		s.saveCapacityPolicy()
+		proposal := *p
+		return &proposal, errors.New(p.Error)
+	}
+	err := s.applyProposal(p, callerFromContext(c), now)
+	proposal := *p
+	return &proposal, err
+}
+
+//RejectCapacityProposal drops the nodes pending capacity proposal. The policy
+//won't propose another change for the node until MinInterval has passed.
+func (s *Server) RejectCapacityProposal(c context.Context, n *pb.Node) (*pb.CapacityProposal, error) {
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	if s.policy == nil || s.pendingProposal(n.Id) == -1 {
+		return &pb.CapacityProposal{}, NoCapacityProposal
+	}
+	p := s.policy.Pending[s.pendingProposal(n.Id)]
+	now := time.Now()
+	s.policyNode(n.Id).LastDecision = now
+	s.decideProposal(p, pb.CapacityProposalState_PROPOSAL_REJECTED, callerFromContext(c), now)
+	s.saveCapacityPolicy()
+	s.ctxlog.WithFields(log.Fields{"id": n.Id, "caller": p.Caller}).Info("capacity proposal rejected")
+	proposal := *p
+	return &proposal, nil
+}
+
+//nodeReportsByFill sorts reports fullest first, then by node id.
+type nodeReportsByFill struct {
+	reports []*pb.NodeStatusReport
+	path    string
+}
+
+func (r nodeReportsByFill) Len() int      { return len(r.reports) }
+func (r nodeReportsByFill) Swap(i, j int) { r.reports[i], r.reports[j] = r.reports[j], r.reports[i] }
+func (r nodeReportsByFill) Less(i, j int) bool {
+	fi, _ := diskFill(r.reports[i].Hardware, r.path)
+	fj, _ := diskFill(r.reports[j].Hardware, r.path)
+	if fi != fj {
+		return fi > fj
+	}
+	return r.reports[i].Id < r.reports[j].Id
+}
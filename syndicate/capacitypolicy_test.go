@@ -0,0 +1,212 @@
+package syndicate
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func newPolicyTestServer(t *testing.T, mode string) (*Server, func()) {
+	s, _ := newTestServerWithDefaults()
+	cleanup := useTempRingDir(t, s)
+	s.cfg.CapacityPolicy = CapacityPolicyConfig{Mode: mode, MinInterval: 60}
+	if err := s.parseCapacityPolicy(); err != nil {
+		t.Fatalf("parseCapacityPolicy returned unexpected error: %s", err)
+	}
+	if err := s.loadCapacityPolicy(); err != nil {
+		t.Fatalf("loadCapacityPolicy returned unexpected error: %s", err)
+	}
+	ctx := context.Background()
+	for _, n := range s.r.Nodes() {
+		if _, err := s.SetCapacity(ctx, &pb.Node{Id: n.ID(), Capacity: 100}); err != nil {
+			t.Fatalf("SetCapacity returned unexpected error: %s", err)
+		}
+	}
+	return s, cleanup
+}
+
+func reportFill(t *testing.T, s *Server, id uint64, used uint64) {
+	r := &pb.NodeStatusReport{
+		Id:       id,
+		Hardware: &pb.HardwareProfile{Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 100, Used: used}}},
+	}
+	if _, err := s.ReportNodeStatus(context.Background(), r); err != nil {
+		t.Fatalf("ReportNodeStatus returned unexpected error: %s", err)
+	}
+}
+
+func TestServer_CapacityPolicyApply(t *testing.T) {
+	s, cleanup := newPolicyTestServer(t, "apply")
+	defer cleanup()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+	now := time.Now()
+
+	reportFill(t, s, id, 95)
+	s.checkCapacityPolicy(now)
+	if capacity, _ := s.nodeCapacity(id); capacity != 90 {
+		t.Fatalf("capacity is %d, expected the policy to reduce it to 90", capacity)
+	}
+	//rate limited per node
+	s.checkCapacityPolicy(now.Add(30 * time.Second))
+	if capacity, _ := s.nodeCapacity(id); capacity != 90 {
+		t.Errorf("capacity is %d, the policy shouldn't change a node again within MinInterval", capacity)
+	}
+	s.checkCapacityPolicy(now.Add(61 * time.Second))
+	if capacity, _ := s.nodeCapacity(id); capacity != 81 {
+		t.Errorf("capacity is %d, expected a second reduction to 81", capacity)
+	}
+
+	//between the thresholds nothing happens, once drained the original is restored
+	reportFill(t, s, id, 80)
+	s.checkCapacityPolicy(now.Add(200 * time.Second))
+	if capacity, _ := s.nodeCapacity(id); capacity != 81 {
+		t.Errorf("capacity is %d, expected it to stay at 81", capacity)
+	}
+	reportFill(t, s, id, 50)
+	s.checkCapacityPolicy(now.Add(300 * time.Second))
+	if capacity, _ := s.nodeCapacity(id); capacity != 100 {
+		t.Errorf("capacity is %d, expected it to be restored to 100", capacity)
+	}
+
+	status, err := s.GetCapacityPolicy(ctx, &pb.EmptyMsg{})
+	if err != nil || status.Mode != "apply" || len(status.Pending) != 0 || len(status.History) != 3 {
+		t.Fatalf("GetCapacityPolicy returned unexpected result: %v, %v", status, err)
+	}
+	last := status.History[2]
+	if !last.Restore || last.State != pb.CapacityProposalState_PROPOSAL_APPLIED || last.Caller != "policy" || last.RingVersion != s.r.Version() {
+		t.Errorf("unexpected history entry: %v", last)
+	}
+
+	//state survives a restart
+	s.policy = nil
+	if err := s.loadCapacityPolicy(); err != nil {
+		t.Fatalf("loadCapacityPolicy returned unexpected error: %s", err)
+	}
+	if status, _ = s.GetCapacityPolicy(ctx, &pb.EmptyMsg{}); len(status.History) != 3 {
+		t.Errorf("history should have been reloaded: %v", status)
+	}
+}
+
+func TestServer_CapacityPolicyLimits(t *testing.T) {
+	s, cleanup := newPolicyTestServer(t, "apply")
+	defer cleanup()
+	s.cfg.CapacityPolicy.MaxChangesPerHour = 1
+	now := time.Now()
+	nodes := s.r.Nodes()
+	for _, n := range nodes {
+		reportFill(t, s, n.ID(), 99)
+	}
+	s.checkCapacityPolicy(now)
+	var reduced int
+	for _, n := range nodes {
+		if capacity, _ := s.nodeCapacity(n.ID()); capacity != 100 {
+			reduced++
+		}
+	}
+	if reduced != 1 {
+		t.Errorf("%d nodes were reduced, expected the hourly limit to allow 1", reduced)
+	}
+	//the fullest node gets the next change
+	for _, n := range nodes {
+		if capacity, _ := s.nodeCapacity(n.ID()); capacity != 100 {
+			reportFill(t, s, n.ID(), 92)
+		}
+	}
+	s.checkCapacityPolicy(now.Add(61 * time.Minute))
+	for _, n := range nodes {
+		if capacity, _ := s.nodeCapacity(n.ID()); capacity == 100 {
+			t.Errorf("node %d should have been reduced once the hour passed", n.ID())
+		}
+	}
+
+	//nodes in maintenance are left alone
+	id := nodes[0].ID()
+	if _, err := s.SetMaintenance(context.Background(), &pb.MaintenanceRequest{Id: id}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	before, _ := s.nodeCapacity(id)
+	s.checkCapacityPolicy(now.Add(3 * time.Hour))
+	if capacity, _ := s.nodeCapacity(id); capacity != before {
+		t.Errorf("node in maintenance had its capacity changed from %d to %d", before, capacity)
+	}
+}
+
+func TestServer_CapacityPolicyPropose(t *testing.T) {
+	s, cleanup := newPolicyTestServer(t, "propose")
+	defer cleanup()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+	other := s.r.Nodes()[1].ID()
+	now := time.Now()
+
+	reportFill(t, s, id, 95)
+	reportFill(t, s, other, 95)
+	s.checkCapacityPolicy(now)
+	status, _ := s.GetCapacityPolicy(ctx, &pb.EmptyMsg{})
+	if len(status.Pending) != 2 || status.Pending[0].Proposed != 90 {
+		t.Fatalf("expected two pending proposals: %v", status)
+	}
+	if capacity, _ := s.nodeCapacity(id); capacity != 100 {
+		t.Errorf("capacity is %d, proposals shouldn't be applied until approved", capacity)
+	}
+
+	p, err := s.ApproveCapacityProposal(ctx, &pb.Node{Id: id})
+	if err != nil || p.State != pb.CapacityProposalState_PROPOSAL_APPLIED {
+		t.Fatalf("ApproveCapacityProposal returned unexpected result: %v, %v", p, err)
+	}
+	if capacity, _ := s.nodeCapacity(id); capacity != 90 {
+		t.Errorf("capacity is %d after approval, expected 90", capacity)
+	}
+	if p, err = s.RejectCapacityProposal(ctx, &pb.Node{Id: other}); err != nil || p.State != pb.CapacityProposalState_PROPOSAL_REJECTED {
+		t.Fatalf("RejectCapacityProposal returned unexpected result: %v, %v", p, err)
+	}
+	if _, err = s.ApproveCapacityProposal(ctx, &pb.Node{Id: other}); err != NoCapacityProposal {
+		t.Errorf("approving a rejected proposal returned: %v", err)
+	}
+	//a rejected node isn't proposed again until MinInterval has passed
+	s.checkCapacityPolicy(time.Now().Add(30 * time.Second))
+	if status, _ = s.GetCapacityPolicy(ctx, &pb.EmptyMsg{}); len(status.Pending) != 0 {
+		t.Errorf("rejected node was proposed again too soon: %v", status.Pending)
+	}
+
+	//a proposal is expired if the node drains before it's approved
+	s.checkCapacityPolicy(time.Now().Add(2 * time.Minute))
+	if status, _ = s.GetCapacityPolicy(ctx, &pb.EmptyMsg{}); len(status.Pending) != 2 {
+		t.Fatalf("expected two pending proposals: %v", status)
+	}
+	reportFill(t, s, other, 80)
+	s.checkCapacityPolicy(time.Now().Add(3 * time.Minute))
+	if status, _ = s.GetCapacityPolicy(ctx, &pb.EmptyMsg{}); len(status.Pending) != 1 || status.History[len(status.History)-1].State != pb.CapacityProposalState_PROPOSAL_EXPIRED {
+		t.Errorf("proposal for drained node should have expired: %v", status)
+	}
+
+	//and refused if the capacity changed since it was made
+	if _, err := s.SetCapacity(ctx, &pb.Node{Id: id, Capacity: 50}); err != nil {
+		t.Fatalf("SetCapacity returned unexpected error: %s", err)
+	}
+	if _, err = s.ApproveCapacityProposal(ctx, &pb.Node{Id: id}); err == nil {
+		t.Errorf("approving an outdated proposal should have failed")
+	}
+}
+
+func TestServer_ParseCapacityPolicy(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	bad := []CapacityPolicyConfig{
+		{Mode: "yolo"},
+		{Mode: "apply", FillThreshold: 70, RestoreThreshold: 80},
+		{Mode: "apply", Reduction: 150},
+	}
+	for _, c := range bad {
+		s.cfg.CapacityPolicy = c
+		if err := s.parseCapacityPolicy(); err == nil {
+			t.Errorf("parseCapacityPolicy(%#v) should have returned an error", c)
+		}
+	}
+	s.cfg.CapacityPolicy = CapacityPolicyConfig{Mode: "propose"}
+	if err := s.parseCapacityPolicy(); err != nil || s.cfg.CapacityPolicy.FillThreshold != DefaultPolicyFillThreshold || s.cfg.CapacityPolicy.Path != DefaultPolicyPath {
+		t.Errorf("parseCapacityPolicy should have filled in defaults: %#v, %v", s.cfg.CapacityPolicy, err)
+	}
+}
@@ -0,0 +1,372 @@
+package syndicate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+var DecommissionInProgress = errors.New("Node is already being decommissioned")
+
+//decommission is the workflow for taking a node out of the ring. The node is first
+//deactivated (or ramped down to 0 capacity), then every managed node has to pick
+//up the resulting ring, then the nodes backend is optionally stopped and finally
+//the node is removed from the ring. The state is persisted after every step so a
+//restarted synd picks up where it left off, and a failed decommission resumes
+//from the step it failed on when it's requested again.
+type decommission struct {
+	sync.Mutex
+	s      *Server
+	status *pb.DecommissionStatus
+	done   chan struct{} //closed once the workflow has stopped running
+	ctxlog *log.Entry
+}
+
+func (s *Server) decommissionsPath() string {
+	return filepath.Join(s.cfg.RingDir, fmt.Sprintf("%s.decommissions", s.servicename))
+}
+
+func newDecommission(s *Server, status *pb.DecommissionStatus) *decommission {
+	d := &decommission{
+		s:      s,
+		status: status,
+		done:   make(chan struct{}),
+		ctxlog: s.ctxlog.WithField("decommission", status.Id),
+	}
+	if status.Failed || status.State == pb.DecommissionState_DECOMMISSION_COMPLETE {
+		close(d.done)
+	}
+	return d
+}
+
+//loadDecommissions loads the persisted decommissions, resumeDecommissions picks
+//back up the ones that were still running when synd stopped.
+func (s *Server) loadDecommissions() error {
+	data, err := ioutil.ReadFile(s.decommissionsPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var statuses []*pb.DecommissionStatus
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		return fmt.Errorf("Invalid decommissions file %s: %s", s.decommissionsPath(), err)
+	}
+	s.decomLock.Lock()
+	defer s.decomLock.Unlock()
+	s.decommissions = make(map[uint64]*decommission)
+	for _, status := range statuses {
+		s.decommissions[status.Id] = newDecommission(s, status)
+	}
+	return nil
+}
+
+//resumeDecommissions restarts the loaded decommissions that hadn't finished.
+//They make ring changes, so this mustn't happen before the slaves are registered.
+func (s *Server) resumeDecommissions() {
+	s.decomLock.Lock()
+	defer s.decomLock.Unlock()
+	for _, d := range s.decommissions {
+		if !d.finished() {
+			d.ctxlog.WithField("state", d.snapshot().State).Info("resuming decommission")
+			go d.run()
+		}
+	}
+}
+
+//saveDecommissions persists the state of every decommission.
+func (s *Server) saveDecommissions() error {
+	s.decomLock.Lock()
+	defer s.decomLock.Unlock()
+	statuses := make([]*pb.DecommissionStatus, 0, len(s.decommissions))
+	for _, d := range s.decommissions {
+		statuses = append(statuses, d.snapshot())
+	}
+	sort.Sort(decommissionStatusByID(statuses))
+	data, err := json.Marshal(statuses)
+	if err != nil {
+		return err
+	}
+	path := s.decommissionsPath()
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+//DecommissionNode starts decommissioning a node, or resumes a decommission that
+//previously failed. When resuming, any ramp or converge settings given replace
+//the ones the decommission was started with.
+func (s *Server) DecommissionNode(c context.Context, r *pb.DecommissionRequest) (*pb.DecommissionStatus, error) {
+	if r.RampInterval < 0 {
+		return &pb.DecommissionStatus{}, fmt.Errorf("Invalid ramp interval %d", r.RampInterval)
+	}
+	if r.ConvergeTimeout < 0 {
+		return &pb.DecommissionStatus{}, fmt.Errorf("Invalid converge timeout %d", r.ConvergeTimeout)
+	}
+	s.RLock()
+	n := s.r.Node(r.Id)
+	var address string
+	if n != nil {
+		address = n.Address(0)
+	}
+	s.RUnlock()
+
+	s.decomLock.Lock()
+	if s.decommissions == nil {
+		s.decommissions = make(map[uint64]*decommission)
+	}
+	d, ok := s.decommissions[r.Id]
+	if ok && !d.finished() {
+		s.decomLock.Unlock()
+		return d.snapshot(), DecommissionInProgress
+	}
+	now := time.Now().Unix()
+	if ok && d.snapshot().Failed {
+		status := d.snapshot()
+		status.Failed = false
+		status.Error = ""
+		status.Finished = 0
+		status.Updated = now
+		if r.RampStep != 0 {
+			status.RampStep = r.RampStep
+		}
+		if r.RampInterval != 0 {
+			status.RampInterval = r.RampInterval
+		}
+		if r.ConvergeTimeout != 0 {
+			status.ConvergeTimeout = r.ConvergeTimeout
+		}
+		d = newDecommission(s, status)
+		d.ctxlog.WithFields(log.Fields{"state": status.State, "caller": callerFromContext(c)}).Info("retrying decommission")
+	} else if n == nil {
+		s.decomLock.Unlock()
+		return &pb.DecommissionStatus{}, fmt.Errorf("Node %d not found", r.Id)
+	} else {
+		d = newDecommission(s, &pb.DecommissionStatus{
+			Id:              r.Id,
+			Address:         address,
+			State:           pb.DecommissionState_DECOMMISSION_DRAINING,
+			Ramp:            r.Ramp,
+			RampStep:        r.RampStep,
+			RampInterval:    r.RampInterval,
+			ConvergeTimeout: r.ConvergeTimeout,
+			StopBackend:     r.StopBackend,
+			Caller:          callerFromContext(c),
+			Started:         now,
+			Updated:         now,
+		})
+		d.ctxlog.WithFields(log.Fields{"ramp": r.Ramp, "stop": r.StopBackend, "caller": d.status.Caller}).Info("starting decommission")
+	}
+	s.decommissions[r.Id] = d
+	s.decomLock.Unlock()
+	if err := s.saveDecommissions(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.decommissionsPath(), "err": err}).Warning("Unable to persist decommissions")
+	}
+	go d.run()
+	return d.snapshot(), nil
+}
+
+//GetDecommissionStatus returns the running and past decommissions ordered by node id.
+func (s *Server) GetDecommissionStatus(c context.Context, e *pb.EmptyMsg) (*pb.DecommissionList, error) {
+	s.decomLock.Lock()
+	defer s.decomLock.Unlock()
+	res := &pb.DecommissionList{}
+	for _, d := range s.decommissions {
+		res.Nodes = append(res.Nodes, d.snapshot())
+	}
+	sort.Sort(decommissionStatusByID(res.Nodes))
+	return res, nil
+}
+
+//run works through the remaining steps of the decommission. Every step checks
+//what's already been done so it's safe to rerun after a restart or failure.
+func (d *decommission) run() {
+	for {
+		status := d.snapshot()
+		var err error
+		next := status.State + 1
+		switch status.State {
+		case pb.DecommissionState_DECOMMISSION_DRAINING:
+			err = d.drain(status)
+		case pb.DecommissionState_DECOMMISSION_CONVERGING:
+			timeout := time.Duration(status.ConvergeTimeout) * rampIntervalUnit
+			if timeout == 0 {
+				timeout = DefaultRampConvergeTimeout * rampIntervalUnit
+			}
+			err = d.s.waitConverged(status.RingVersion, timeout)
+		case pb.DecommissionState_DECOMMISSION_STOPPING:
+			err = d.stop(status)
+		case pb.DecommissionState_DECOMMISSION_REMOVING:
+			err = d.remove(status)
+		default:
+			return
+		}
+		if err != nil {
+			d.ctxlog.WithFields(log.Fields{"state": status.State, "err": err}).Warning("decommission failed")
+			d.update(func() {
+				d.status.Failed = true
+				d.status.Error = err.Error()
+				d.status.Finished = time.Now().Unix()
+			})
+			close(d.done)
+			return
+		}
+		d.ctxlog.WithField("state", status.State).Info("decommission step done")
+		d.update(func() {
+			d.status.State = next
+			if next == pb.DecommissionState_DECOMMISSION_COMPLETE {
+				d.status.Finished = time.Now().Unix()
+			}
+		})
+		if next == pb.DecommissionState_DECOMMISSION_COMPLETE {
+			close(d.done)
+			return
+		}
+	}
+}
+
+//drain takes the node out of service by ramping it down to 0 capacity or
+//deactivating it, and records the ring version the cluster has to converge on.
+func (d *decommission) drain(status *pb.DecommissionStatus) error {
+	s := d.s
+	if status.Ramp {
+		//an operator's ramp may already be running with a target other than 0, so
+		//keep ramping until the node actually ends up at 0 capacity
+		for {
+			capacity, err := s.nodeCapacity(status.Id)
+			if err != nil {
+				return err
+			}
+			if capacity == 0 {
+				break
+			}
+			r := &pb.RampRequest{
+				Id:              status.Id,
+				Step:            status.RampStep,
+				Interval:        status.RampInterval,
+				WaitConverged:   true,
+				ConvergeTimeout: status.ConvergeTimeout,
+			}
+			_, err = s.RampCapacity(context.Background(), r)
+			if err != nil && err != RampInProgress {
+				return fmt.Errorf("Unable to start capacity ramp: %s", err)
+			}
+			s.rampLock.Lock()
+			ramp := s.ramps[status.Id]
+			s.rampLock.Unlock()
+			if ramp == nil {
+				return fmt.Errorf("Node %d not found", status.Id)
+			}
+			<-ramp.done
+			rs := ramp.snapshot()
+			if err == nil && rs.State != pb.RampState_RAMP_COMPLETE {
+				return fmt.Errorf("Capacity ramp finished as %s at capacity %d: %s", rs.State, rs.Capacity, rs.Error)
+			}
+		}
+	} else {
+		s.RLock()
+		n := s.r.Node(status.Id)
+		active := n != nil && n.Active()
+		s.RUnlock()
+		if n == nil {
+			return fmt.Errorf("Node %d not found", status.Id)
+		}
+		if active {
+			if _, err := s.SetActive(context.Background(), &pb.Node{Id: status.Id, Active: false}); err != nil {
+				return fmt.Errorf("Unable to deactivate node: %s", err)
+			}
+		}
+	}
+	version := s.ringVersion()
+	d.update(func() { d.status.RingVersion = version })
+	return nil
+}
+
+//stop stops the nodes backend, if requested and the node is managed.
+func (d *decommission) stop(status *pb.DecommissionStatus) error {
+	if !status.StopBackend {
+		return nil
+	}
+	d.s.RLock()
+	mn, ok := d.s.managedNodes[status.Id]
+	d.s.RUnlock()
+	if !ok {
+		d.ctxlog.Info("node isn't managed, not stopping its backend")
+		return nil
+	}
+	if err := mn.Stop(); err != nil {
+		return fmt.Errorf("Unable to stop node backend: %s", err)
+	}
+	return nil
+}
+
+//remove removes the node from the ring and clears any maintenance it was in.
+func (d *decommission) remove(status *pb.DecommissionStatus) error {
+	s := d.s
+	s.RLock()
+	inRing := s.r.Node(status.Id) != nil
+	s.RUnlock()
+	if inRing {
+		if _, err := s.RemoveNode(context.Background(), &pb.Node{Id: status.Id}); err != nil {
+			return fmt.Errorf("Unable to remove node: %s", err)
+		}
+	}
+	if _, err := s.endMaintenance(status.Id, false, "decommission"); err != nil && err != NotInMaintenance {
+		d.ctxlog.WithField("err", err).Warning("Unable to clear maintenance for decommissioned node")
+	}
+	return nil
+}
+
+//backendDecommissioned returns true if the nodes decommission has got past
+//converging, so its backend may already have been stopped.
+func (s *Server) backendDecommissioned(id uint64) bool {
+	s.decomLock.Lock()
+	d, ok := s.decommissions[id]
+	s.decomLock.Unlock()
+	return ok && d.snapshot().State >= pb.DecommissionState_DECOMMISSION_STOPPING
+}
+
+//update applies fn to the status and persists the result.
+func (d *decommission) update(fn func()) {
+	d.Lock()
+	fn()
+	d.status.Updated = time.Now().Unix()
+	d.Unlock()
+	if err := d.s.saveDecommissions(); err != nil {
+		d.ctxlog.WithFields(log.Fields{"path": d.s.decommissionsPath(), "err": err}).Warning("Unable to persist decommissions")
+	}
+}
+
+func (d *decommission) finished() bool {
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *decommission) snapshot() *pb.DecommissionStatus {
+	d.Lock()
+	defer d.Unlock()
+	status := *d.status
+	return &status
+}
+
+type decommissionStatusByID []*pb.DecommissionStatus
+
+func (d decommissionStatusByID) Len() int           { return len(d) }
+func (d decommissionStatusByID) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d decommissionStatusByID) Less(i, j int) bool { return d[i].Id < d[j].Id }
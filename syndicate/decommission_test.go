@@ -0,0 +1,272 @@
+package syndicate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+//newDecommissionTestServer returns a test server with a temp ring dir, fake managed
+//nodes for both its nodes and ring changes being pushed out to them.
+func newDecommissionTestServer(t *testing.T) (*Server, map[uint64]*fakeManagedNode, func()) {
+	s, _ := newTestServerWithDefaults()
+	cleanup := useTempRingDir(t, s)
+	fakes := make(map[uint64]*fakeManagedNode)
+	for _, n := range s.r.Nodes() {
+		fakes[n.ID()] = &fakeManagedNode{address: n.Address(0)}
+		s.managedNodes[n.ID()] = fakes[n.ID()]
+	}
+	s.metrics.managedNodes.Set(float64(len(s.managedNodes)))
+	go s.RingChangeManager()
+	return s, fakes, cleanup
+}
+
+func waitForDecommission(t *testing.T, s *Server, id uint64) *pb.DecommissionStatus {
+	s.decomLock.Lock()
+	d := s.decommissions[id]
+	s.decomLock.Unlock()
+	select {
+	case <-d.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for decommission to finish: %v", d.snapshot())
+	}
+	return d.snapshot()
+}
+
+func persistedDecommissions(t *testing.T, s *Server) []*pb.DecommissionStatus {
+	data, err := ioutil.ReadFile(s.decommissionsPath())
+	if err != nil {
+		t.Fatalf("unable to read persisted decommissions: %s", err)
+	}
+	var statuses []*pb.DecommissionStatus
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		t.Fatalf("unable to parse persisted decommissions: %s", err)
+	}
+	return statuses
+}
+
+func TestServer_DecommissionNode(t *testing.T) {
+	s, fakes, cleanup := newDecommissionTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+
+	status, err := s.DecommissionNode(ctx, &pb.DecommissionRequest{Id: id, StopBackend: true, ConvergeTimeout: 1000})
+	if err != nil {
+		t.Fatalf("DecommissionNode returned unexpected error: %s", err)
+	}
+	if status.Address != "1.2.3.4:56789" || status.Failed {
+		t.Errorf("DecommissionNode returned unexpected status: %v", status)
+	}
+	status = waitForDecommission(t, s, id)
+	if status.State != pb.DecommissionState_DECOMMISSION_COMPLETE || status.Failed || status.RingVersion == 0 {
+		t.Errorf("decommission finished with unexpected status: %v", status)
+	}
+	if s.r.Node(id) != nil {
+		t.Errorf("node %d should have been removed from the ring", id)
+	}
+	if _, ok := s.managedNodes[id]; ok {
+		t.Errorf("node %d should no longer be managed", id)
+	}
+	if c := fakes[id].controlRequests(); len(c) != 1 || c[0] != "stop" {
+		t.Errorf("node backend got control requests %v, expected [stop]", c)
+	}
+	//the rest of the cluster saw the node go inactive before it was removed
+	for other, f := range fakes {
+		if other != id && f.RingVersion() < status.RingVersion {
+			t.Errorf("node %d is on ring version %d, expected at least %d", other, f.RingVersion(), status.RingVersion)
+		}
+	}
+	if p := persistedDecommissions(t, s); len(p) != 1 || p[0].State != pb.DecommissionState_DECOMMISSION_COMPLETE {
+		t.Errorf("unexpected persisted decommissions: %v", p)
+	}
+
+	list, err := s.GetDecommissionStatus(ctx, &pb.EmptyMsg{})
+	if err != nil || len(list.Nodes) != 1 || list.Nodes[0].Id != id {
+		t.Errorf("GetDecommissionStatus returned unexpected result: %v, %v", list, err)
+	}
+
+	bad := []*pb.DecommissionRequest{
+		{Id: id},
+		{Id: 42},
+		{Id: s.r.Nodes()[0].ID(), RampInterval: -1},
+		{Id: s.r.Nodes()[0].ID(), ConvergeTimeout: -1},
+	}
+	for _, r := range bad {
+		if _, err := s.DecommissionNode(ctx, r); err == nil {
+			t.Errorf("DecommissionNode(%v) should have returned an error", r)
+		}
+	}
+}
+
+func TestServer_DecommissionNodeRamp(t *testing.T) {
+	s, _, cleanup := newDecommissionTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	id := s.r.Nodes()[1].ID()
+	if _, err := s.SetCapacity(ctx, &pb.Node{Id: id, Capacity: 3}); err != nil {
+		t.Fatalf("SetCapacity returned unexpected error: %s", err)
+	}
+
+	//stays in the ramp long enough to see it's in progress
+	if _, err := s.DecommissionNode(ctx, &pb.DecommissionRequest{Id: id, Ramp: true, RampStep: 1, RampInterval: 10000}); err != nil {
+		t.Fatalf("DecommissionNode returned unexpected error: %s", err)
+	}
+	waitForRampSteps(t, s, id, 1)
+	if _, err := s.DecommissionNode(ctx, &pb.DecommissionRequest{Id: id}); err != DecommissionInProgress {
+		t.Errorf("second DecommissionNode returned: %v", err)
+	}
+	//aborting the ramp fails the decommission where it was
+	if _, err := s.AbortRamp(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("AbortRamp returned unexpected error: %s", err)
+	}
+	status := waitForDecommission(t, s, id)
+	if !status.Failed || status.State != pb.DecommissionState_DECOMMISSION_DRAINING || !strings.Contains(status.Error, "RAMP_ABORTED") {
+		t.Fatalf("decommission finished with unexpected status: %v", status)
+	}
+	if s.r.Node(id) == nil || !s.r.Node(id).Active() {
+		t.Errorf("node %d should still be in the ring and active", id)
+	}
+
+	//retrying picks the ramp back up from where it stopped
+	if _, err := s.DecommissionNode(ctx, &pb.DecommissionRequest{Id: id, RampInterval: 1}); err != nil {
+		t.Fatalf("DecommissionNode retry returned unexpected error: %s", err)
+	}
+	status = waitForDecommission(t, s, id)
+	if status.Failed || status.State != pb.DecommissionState_DECOMMISSION_COMPLETE || status.Error != "" {
+		t.Errorf("decommission retry finished with unexpected status: %v", status)
+	}
+	if s.r.Node(id) != nil {
+		t.Errorf("node %d should have been removed from the ring", id)
+	}
+}
+
+func TestServer_DecommissionNodeRampInProgress(t *testing.T) {
+	s, _, cleanup := newDecommissionTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	id := s.r.Nodes()[1].ID()
+	if _, err := s.SetCapacity(ctx, &pb.Node{Id: id, Capacity: 4}); err != nil {
+		t.Fatalf("SetCapacity returned unexpected error: %s", err)
+	}
+	//an operator's ramp that stops short of 0 is already running
+	if _, err := s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 2, Step: 1, Interval: 20}); err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	waitForRampSteps(t, s, id, 1)
+	if _, err := s.DecommissionNode(ctx, &pb.DecommissionRequest{Id: id, Ramp: true, RampStep: 1, RampInterval: 30, ConvergeTimeout: 1000}); err != nil {
+		t.Fatalf("DecommissionNode returned unexpected error: %s", err)
+	}
+	//the decommission starts its own ramp to 0 once the operator's one is done
+	var own *capacityRamp
+	deadline := time.Now().Add(5 * time.Second)
+	for own == nil && time.Now().Before(deadline) {
+		s.rampLock.Lock()
+		if r := s.ramps[id]; r != nil && r.snapshot().Target == 0 {
+			own = r
+		}
+		s.rampLock.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+	if own == nil {
+		t.Fatal("decommission never started a ramp to 0")
+	}
+	status := waitForDecommission(t, s, id)
+	if status.Failed || status.State != pb.DecommissionState_DECOMMISSION_COMPLETE {
+		t.Fatalf("decommission finished with unexpected status: %v", status)
+	}
+	if rs := own.snapshot(); rs.State != pb.RampState_RAMP_COMPLETE || rs.Capacity != 0 {
+		t.Errorf("node should have been ramped down to 0 before removal, ramp: %v", rs)
+	}
+	if s.r.Node(id) != nil {
+		t.Errorf("node %d should have been removed from the ring", id)
+	}
+}
+
+func TestServer_DecommissionNodeResume(t *testing.T) {
+	s, fakes, cleanup := newDecommissionTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+
+	//fails stopping the backend
+	fakes[id].ctlErr = fmt.Errorf("cmdctrl unreachable")
+	if _, err := s.DecommissionNode(ctx, &pb.DecommissionRequest{Id: id, StopBackend: true}); err != nil {
+		t.Fatalf("DecommissionNode returned unexpected error: %s", err)
+	}
+	status := waitForDecommission(t, s, id)
+	if !status.Failed || status.State != pb.DecommissionState_DECOMMISSION_STOPPING || !strings.Contains(status.Error, "cmdctrl unreachable") {
+		t.Fatalf("decommission finished with unexpected status: %v", status)
+	}
+	if s.r.Node(id) == nil || s.r.Node(id).Active() {
+		t.Errorf("node %d should still be in the ring and inactive", id)
+	}
+
+	//a restarted synd resumes whatever was still running when it stopped
+	p := persistedDecommissions(t, s)
+	if len(p) != 1 || !p[0].Failed {
+		t.Fatalf("unexpected persisted decommissions: %v", p)
+	}
+	p[0].Failed = false
+	data, _ := json.Marshal(p)
+	if err := ioutil.WriteFile(s.decommissionsPath(), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	fakes[id].ctlErr = nil
+	if err := s.loadDecommissions(); err != nil {
+		t.Fatalf("loadDecommissions returned unexpected error: %s", err)
+	}
+	s.resumeDecommissions()
+	status = waitForDecommission(t, s, id)
+	if status.Failed || status.State != pb.DecommissionState_DECOMMISSION_COMPLETE {
+		t.Errorf("resumed decommission finished with unexpected status: %v", status)
+	}
+	if s.r.Node(id) != nil {
+		t.Errorf("node %d should have been removed from the ring", id)
+	}
+	if c := fakes[id].controlRequests(); len(c) != 2 {
+		t.Errorf("node backend got control requests %v, expected two stops", c)
+	}
+}
+
+func TestServer_DecommissionNodeResumeConverging(t *testing.T) {
+	s, fakes, cleanup := newDecommissionTestServer(t)
+	defer cleanup()
+	id := s.r.Nodes()[0].ID()
+
+	//a restarted synd's managed nodes start out at ring version 0 and nothing
+	//pushes them a ring until the next change
+	p := []*pb.DecommissionStatus{{
+		Id:              id,
+		State:           pb.DecommissionState_DECOMMISSION_CONVERGING,
+		RingVersion:     s.r.Version(),
+		ConvergeTimeout: 1000,
+		Started:         time.Now().Unix(),
+	}}
+	data, _ := json.Marshal(p)
+	if err := ioutil.WriteFile(s.decommissionsPath(), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range fakes {
+		if f.RingVersion() != 0 {
+			t.Fatalf("fake node should start at ring version 0, is at %d", f.RingVersion())
+		}
+	}
+	if err := s.loadDecommissions(); err != nil {
+		t.Fatalf("loadDecommissions returned unexpected error: %s", err)
+	}
+	s.resumeDecommissions()
+	status := waitForDecommission(t, s, id)
+	if status.Failed || status.State != pb.DecommissionState_DECOMMISSION_COMPLETE {
+		t.Errorf("resumed decommission finished with unexpected status: %v", status)
+	}
+	if s.r.Node(id) != nil {
+		t.Errorf("node %d should have been removed from the ring", id)
+	}
+}
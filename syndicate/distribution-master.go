@@ -67,6 +67,11 @@ func (s *Server) RegisterSlave(slave *RingSlave) error {
 func (s *Server) replicateRing(r ring.Ring, rb, bb *[]byte) error {
 	failcount := 0
 	for _, slave := range s.slaves {
+		if slave.client == nil {
+			log.Printf("Slave %s was never connected, skipping", slave.addr)
+			failcount++
+			continue
+		}
 		ctx, _ := context.WithTimeout(context.Background(), time.Duration(_SYN_REGISTER_TIMEOUT)*time.Second)
 		i := &pb.RingMsg{
 			Version:  r.Version(),
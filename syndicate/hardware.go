@@ -0,0 +1,180 @@
+package syndicate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+//The latest hardware profile each node reported is kept (and persisted alongside
+//the builder) so disk sizes and usage can be audited across the fleet, instead of
+//only being used to compute the nodes weight at registration.
+
+func (s *Server) hardwarePath() string {
+	return filepath.Join(s.cfg.RingDir, fmt.Sprintf("%s.hardware", s.servicename))
+}
+
+//loadHardware loads the persisted hardware profiles, a missing file just means
+//no profiles have been reported yet.
+func (s *Server) loadHardware() error {
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	s.hardware = make(map[uint64]*pb.NodeHardware)
+	data, err := ioutil.ReadFile(s.hardwarePath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var entries []*pb.NodeHardware
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("Invalid hardware file %s: %s", s.hardwarePath(), err)
+	}
+	for _, h := range entries {
+		s.hardware[h.Id] = h
+	}
+	return nil
+}
+
+//saveHardware persists the hardware profiles, s.hwLock must be held.
+func (s *Server) saveHardware() error {
+	s.hwDirty = false
+	entries := make([]*pb.NodeHardware, 0, len(s.hardware))
+	for _, h := range s.hardware {
+		entries = append(entries, h)
+	}
+	sort.Sort(nodeHardwareByID(entries))
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	path := s.hardwarePath()
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+//setNodeHardware records the latest hardware profile reported by a node.
+func (s *Server) setNodeHardware(id uint64, hostname string, hw *pb.HardwareProfile) {
+	if hw == nil {
+		return
+	}
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	if s.hardware == nil {
+		s.hardware = make(map[uint64]*pb.NodeHardware)
+	}
+	s.hardware[id] = &pb.NodeHardware{Id: id, Hostname: hostname, Hardware: hw, Updated: time.Now().Unix()}
+	if err := s.saveHardware(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.hardwarePath(), "err": err}).Warning("Unable to persist hardware profiles")
+	}
+}
+
+//restoreNodeHardware puts back a previously stored hardware profile, dropping the
+//nodes profile if it didn't have one.
+func (s *Server) restoreNodeHardware(id uint64, hw *pb.NodeHardware) {
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	if hw == nil {
+		if _, ok := s.hardware[id]; !ok {
+			return
+		}
+		delete(s.hardware, id)
+	} else {
+		if s.hardware == nil {
+			s.hardware = make(map[uint64]*pb.NodeHardware)
+		}
+		s.hardware[id] = hw
+	}
+	if err := s.saveHardware(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.hardwarePath(), "err": err}).Warning("Unable to persist hardware profiles")
+	}
+}
+
+//hardwareWeightChanged reports whether weight differs from the weight of the
+//nodes last stored hardware profile. Nodes without a stored profile count as
+//changed.
+func (s *Server) hardwareWeightChanged(id uint64, weight uint32) bool {
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	prev, ok := s.hardware[id]
+	if !ok || prev.Hardware == nil {
+		return true
+	}
+	w, _, err := s.nodeWeight(prev.Hardware)
+	return err != nil || w != weight
+}
+
+//refreshNodeHardware records the hardware profile sent with a node status report.
+//Reports are frequent so the profile is only persisted by the next flushHardware.
+func (s *Server) refreshNodeHardware(id uint64, hostname string, hw *pb.HardwareProfile) {
+	if hw == nil {
+		return
+	}
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	if s.hardware == nil {
+		s.hardware = make(map[uint64]*pb.NodeHardware)
+	}
+	s.hardware[id] = &pb.NodeHardware{Id: id, Hostname: hostname, Hardware: hw, Updated: time.Now().Unix()}
+	s.hwDirty = true
+}
+
+//flushHardware persists the hardware profiles if any have been refreshed since
+//they were last saved.
+func (s *Server) flushHardware() {
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	if !s.hwDirty {
+		return
+	}
+	if err := s.saveHardware(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.hardwarePath(), "err": err}).Warning("Unable to persist hardware profiles")
+	}
+}
+
+//forgetNodeHardware drops the hardware profiles of nodes removed from the ring.
+func (s *Server) forgetNodeHardware(nodes []uint64) {
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	var changed bool
+	for _, id := range nodes {
+		if _, ok := s.hardware[id]; ok {
+			delete(s.hardware, id)
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := s.saveHardware(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.hardwarePath(), "err": err}).Warning("Unable to persist hardware profiles")
+	}
+}
+
+//GetNodeHardware returns the latest hardware profile reported by the node.
+func (s *Server) GetNodeHardware(c context.Context, n *pb.Node) (*pb.NodeHardware, error) {
+	s.hwLock.Lock()
+	defer s.hwLock.Unlock()
+	h, ok := s.hardware[n.Id]
+	if !ok {
+		return &pb.NodeHardware{}, fmt.Errorf("No hardware profile reported for node %d", n.Id)
+	}
+	return h, nil
+}
+
+type nodeHardwareByID []*pb.NodeHardware
+
+func (h nodeHardwareByID) Len() int           { return len(h) }
+func (h nodeHardwareByID) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h nodeHardwareByID) Less(i, j int) bool { return h[i].Id < h[j].Id }
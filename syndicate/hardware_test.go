@@ -0,0 +1,64 @@
+package syndicate
+
+import (
+	"testing"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func TestServer_NodeHardware(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	s.cfg.WeightAssignment = "self"
+	ctx := context.Background()
+
+	rr := &pb.RegisterRequest{
+		Hostname: "server2",
+		Addrs:    []string{"10.0.0.2/32"},
+		Tiers:    []string{"server2", "zone2"},
+		Hardware: &pb.HardwareProfile{
+			Cpus:     8,
+			Memtotal: 1000,
+			Disks:    []*pb.Disk{&pb.Disk{Path: "/data", Device: "/dev/sdb1", Size_: 10 * 1024 * 1024 * 1024, Used: 1024}},
+		},
+	}
+	nc, err := s.RegisterNode(ctx, rr)
+	if err != nil {
+		t.Fatalf("RegisterNode(ctx, %#v) returned unexpected error: %s", rr, err)
+	}
+	id := nc.Localid
+	hw, err := s.GetNodeHardware(ctx, &pb.Node{Id: id})
+	if err != nil || hw.Id != id || hw.Hostname != "server2" || hw.Updated == 0 || hw.Hardware.Cpus != 8 || hw.Hardware.Disks[0].Used != 1024 {
+		t.Fatalf("GetNodeHardware returned unexpected result: %v, %v", hw, err)
+	}
+
+	//re-registering refreshes the profile, even when the ring is untouched
+	rr.Hardware.Disks = []*pb.Disk{&pb.Disk{Path: "/data", Device: "/dev/sdb1", Size_: 10 * 1024 * 1024 * 1024, Used: 4096}}
+	if _, err := s.RegisterNode(ctx, rr); err != nil {
+		t.Fatalf("RegisterNode(ctx, %#v) returned unexpected error: %s", rr, err)
+	}
+	if hw, _ = s.GetNodeHardware(ctx, &pb.Node{Id: id}); hw.Hardware.Disks[0].Used != 4096 {
+		t.Errorf("GetNodeHardware should have returned the refreshed profile: %v", hw)
+	}
+
+	//profiles survive a restart
+	s.hardware = nil
+	if err := s.loadHardware(); err != nil {
+		t.Fatalf("loadHardware returned unexpected error: %s", err)
+	}
+	if hw, err = s.GetNodeHardware(ctx, &pb.Node{Id: id}); err != nil || hw.Hardware.Disks[0].Used != 4096 {
+		t.Errorf("GetNodeHardware after reload returned unexpected result: %v, %v", hw, err)
+	}
+
+	//nodes that never reported a profile, or were removed, have none
+	if _, err := s.GetNodeHardware(ctx, &pb.Node{Id: s.r.Nodes()[0].ID()}); err == nil {
+		t.Errorf("GetNodeHardware should have returned an error for a node without a profile")
+	}
+	if _, err := s.RemoveNode(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("RemoveNode returned unexpected error: %s", err)
+	}
+	if _, err := s.GetNodeHardware(ctx, &pb.Node{Id: id}); err == nil {
+		t.Errorf("GetNodeHardware should have returned an error for a removed node")
+	}
+}
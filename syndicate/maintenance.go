@@ -0,0 +1,373 @@
+package syndicate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	DefaultDrainSteps    = 10  //By default a drain lowers capacity by 1/10th of the original capacity per step
+	DefaultDrainInterval = 300 //The default seconds between drain steps
+)
+
+var (
+	//maintenanceCheckInterval is how often maintenance expiry and drain steps are checked.
+	maintenanceCheckInterval = 10 * time.Second
+
+	NotInMaintenance = errors.New("Node is not in maintenance")
+	DrainInProgress  = errors.New("The node is being drained for maintenance")
+)
+
+//nodeMaintenance is the synd side maintenance state of a node. While a node is in
+//maintenance it's skipped by upgrades and node control requests, and it should
+//never be deactivated for failing health checks. Maintenance state is persisted
+//alongside the builder so it survives synd restarts.
+type nodeMaintenance struct {
+	Status   *pb.MaintenanceStatus `json:"status"`
+	NextStep time.Time             `json:"next_step,omitempty"` //when the next drain step is due
+}
+
+func (s *Server) maintenancePath() string {
+	return filepath.Join(s.cfg.RingDir, fmt.Sprintf("%s.maintenance", s.servicename))
+}
+
+//loadMaintenance loads the persisted maintenance state, a missing file just means
+//no nodes are in maintenance.
+func (s *Server) loadMaintenance() error {
+	s.maintLock.Lock()
+	defer s.maintLock.Unlock()
+	s.maintenance = make(map[uint64]*nodeMaintenance)
+	data, err := ioutil.ReadFile(s.maintenancePath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var entries []*nodeMaintenance
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("Invalid maintenance file %s: %s", s.maintenancePath(), err)
+	}
+	for _, m := range entries {
+		s.maintenance[m.Status.Id] = m
+	}
+	return nil
+}
+
+//saveMaintenance persists the maintenance state, s.maintLock must be held.
+func (s *Server) saveMaintenance() error {
+	entries := make([]*nodeMaintenance, 0, len(s.maintenance))
+	for _, m := range s.maintenance {
+		entries = append(entries, m)
+	}
+	sort.Sort(maintenanceByID(entries))
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	path := s.maintenancePath()
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+//forgetNodeMaintenance drops the maintenance state of nodes that have been
+//removed from the ring.
+func (s *Server) forgetNodeMaintenance(nodes []uint64) {
+	s.maintLock.Lock()
+	defer s.maintLock.Unlock()
+	var changed bool
+	for _, id := range nodes {
+		if _, ok := s.maintenance[id]; ok {
+			delete(s.maintenance, id)
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := s.saveMaintenance(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.maintenancePath(), "err": err}).Warning("Unable to persist maintenance state")
+	}
+}
+
+//inMaintenance returns true if the node is in maintenance that hasn't expired.
+func (s *Server) inMaintenance(id uint64) bool {
+	s.maintLock.Lock()
+	defer s.maintLock.Unlock()
+	m, ok := s.maintenance[id]
+	return ok && (m.Status.Expires == 0 || m.Status.Expires > time.Now().Unix())
+}
+
+//nodeCapacity returns the nodes capacity in the current ring.
+func (s *Server) nodeCapacity(id uint64) (uint32, error) {
+	s.RLock()
+	defer s.RUnlock()
+	n := s.r.Node(id)
+	if n == nil {
+		return 0, fmt.Errorf("Node %d not found", id)
+	}
+	return n.Capacity(), nil
+}
+
+//changeNodeCapacity sets the capacity of a node as its own ring change.
+func (s *Server) changeNodeCapacity(id uint64, capacity uint32, kind, caller string) error {
+	s.Lock()
+	defer s.Unlock()
+	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
+	if err != nil {
+		return fmt.Errorf("Unable to load builder for change: %s", err)
+	}
+	node := b.Node(id)
+	if node == nil {
+		return fmt.Errorf("Node %d not found", id)
+	}
+	if node.Capacity() == capacity {
+		return nil
+	}
+	node.SetCapacity(capacity)
+	newRing := b.Ring()
+	s.ctxlog.WithFields(log.Fields{"proposed-ringver": newRing.Version(), "id": id, "capacity": capacity}).Info("attempting to apply ring version")
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: kind, nodes: []uint64{id}, caller: caller})
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{
+			"proposed-ringver": newRing.Version(),
+			"ringver":          s.r.Version(),
+			"err":              err,
+		}).Warning("failed to apply ring change")
+		return err
+	}
+	s.ctxlog.WithField("ringver", s.r.Version()).Info("updated ring")
+	return nil
+}
+
+//SetMaintenance puts a node into maintenance, or updates the maintenance of a node
+//already in it. A duration (in seconds) makes the maintenance expire on its own.
+//If drain is set the nodes capacity is lowered by drainStep every drainInterval
+//seconds, each step as its own ring change, until it reaches drainTarget. Nodes
+//with a capacity ramp running can't be drained.
+func (s *Server) SetMaintenance(c context.Context, r *pb.MaintenanceRequest) (*pb.MaintenanceStatus, error) {
+	if r.Duration < 0 {
+		return &pb.MaintenanceStatus{}, fmt.Errorf("Invalid duration %d", r.Duration)
+	}
+	if r.DrainInterval < 0 {
+		return &pb.MaintenanceStatus{}, fmt.Errorf("Invalid drain interval %d", r.DrainInterval)
+	}
+	capacity, err := s.nodeCapacity(r.Id)
+	if err != nil {
+		return &pb.MaintenanceStatus{}, err
+	}
+	now := time.Now()
+
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+	s.maintLock.Lock()
+	defer s.maintLock.Unlock()
+	if s.maintenance == nil {
+		s.maintenance = make(map[uint64]*nodeMaintenance)
+	}
+	status := &pb.MaintenanceStatus{
+		Id:               r.Id,
+		Reason:           r.Reason,
+		Caller:           callerFromContext(c),
+		Started:          now.Unix(),
+		Capacity:         capacity,
+		OriginalCapacity: capacity,
+		RestoreCapacity:  r.RestoreCapacity,
+	}
+	if prev, ok := s.maintenance[r.Id]; ok {
+		status.Started = prev.Status.Started
+		status.OriginalCapacity = prev.Status.OriginalCapacity
+	}
+	if r.Duration > 0 {
+		status.Expires = now.Unix() + r.Duration
+	}
+	m := &nodeMaintenance{Status: status}
+	if r.Drain {
+		s.rampLock.Lock()
+		ramp, ok := s.ramps[r.Id]
+		s.rampLock.Unlock()
+		if ok && !ramp.finished() {
+			return &pb.MaintenanceStatus{}, RampInProgress
+		}
+		if r.DrainTarget >= capacity {
+			return &pb.MaintenanceStatus{}, fmt.Errorf("Drain target %d must be below the current capacity %d", r.DrainTarget, capacity)
+		}
+		status.Draining = true
+		status.DrainTarget = r.DrainTarget
+		status.DrainStep = r.DrainStep
+		if status.DrainStep == 0 {
+			status.DrainStep = status.OriginalCapacity / DefaultDrainSteps
+			if status.DrainStep == 0 {
+				status.DrainStep = 1
+			}
+		}
+		status.DrainInterval = r.DrainInterval
+		if status.DrainInterval == 0 {
+			status.DrainInterval = DefaultDrainInterval
+		}
+		m.NextStep = now
+	}
+	s.maintenance[r.Id] = m
+	if err := s.saveMaintenance(); err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.maintenancePath(), "err": err}).Warning("Unable to persist maintenance state")
+		return &pb.MaintenanceStatus{}, err
+	}
+	s.ctxlog.WithFields(log.Fields{"id": r.Id, "reason": r.Reason, "expires": status.Expires, "drain": r.Drain, "caller": status.Caller}).Info("node in maintenance")
+	return copyMaintenanceStatus(status), nil
+}
+
+//ClearMaintenance takes a node out of maintenance. If restoreCapacity was set on
+//either request the node is put back to the capacity it had going into maintenance.
+func (s *Server) ClearMaintenance(c context.Context, r *pb.MaintenanceRequest) (*pb.MaintenanceStatus, error) {
+	status, err := s.endMaintenance(r.Id, r.RestoreCapacity, callerFromContext(c))
+	if err != nil {
+		return &pb.MaintenanceStatus{}, err
+	}
+	return status, nil
+}
+
+//endMaintenance removes the nodes maintenance state and restores its capacity if
+//requested. drainLock is held throughout so a drain step can't land after the
+//capacity has been restored.
+func (s *Server) endMaintenance(id uint64, restore bool, caller string) (*pb.MaintenanceStatus, error) {
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+	s.maintLock.Lock()
+	m, ok := s.maintenance[id]
+	if !ok {
+		s.maintLock.Unlock()
+		return nil, NotInMaintenance
+	}
+	delete(s.maintenance, id)
+	err := s.saveMaintenance()
+	s.maintLock.Unlock()
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": s.maintenancePath(), "err": err}).Warning("Unable to persist maintenance state")
+		return nil, err
+	}
+	status := copyMaintenanceStatus(m.Status)
+	status.Draining = false
+	s.ctxlog.WithFields(log.Fields{"id": id, "caller": caller}).Info("node out of maintenance")
+	if restore || status.RestoreCapacity {
+		if err := s.changeNodeCapacity(id, status.OriginalCapacity, "ClearMaintenance", caller); err != nil {
+			return nil, fmt.Errorf("Node out of maintenance but restoring capacity failed: %s", err)
+		}
+	}
+	status.Capacity, _ = s.nodeCapacity(id)
+	return status, nil
+}
+
+//ListMaintenance returns the nodes in maintenance ordered by id.
+func (s *Server) ListMaintenance(c context.Context, e *pb.EmptyMsg) (*pb.MaintenanceList, error) {
+	s.maintLock.Lock()
+	res := &pb.MaintenanceList{}
+	for _, m := range s.maintenance {
+		res.Nodes = append(res.Nodes, copyMaintenanceStatus(m.Status))
+	}
+	s.maintLock.Unlock()
+	sort.Sort(maintenanceStatusByID(res.Nodes))
+	for _, n := range res.Nodes {
+		if capacity, err := s.nodeCapacity(n.Id); err == nil {
+			n.Capacity = capacity
+		}
+	}
+	return res, nil
+}
+
+//maintenanceManager periodically expires maintenance and steps drains.
+func (s *Server) maintenanceManager() {
+	for now := range time.Tick(maintenanceCheckInterval) {
+		s.checkMaintenance(now)
+	}
+}
+
+//checkMaintenance ends any maintenance that has expired and lowers the capacity
+//of any draining node whose next drain step is due.
+func (s *Server) checkMaintenance(now time.Time) {
+	var expired, due []uint64
+	s.maintLock.Lock()
+	for id, m := range s.maintenance {
+		if m.Status.Expires != 0 && m.Status.Expires <= now.Unix() {
+			expired = append(expired, id)
+			continue
+		}
+		if m.Status.Draining && !now.Before(m.NextStep) {
+			due = append(due, id)
+		}
+	}
+	s.maintLock.Unlock()
+
+	for _, id := range expired {
+		if _, err := s.endMaintenance(id, false, "maintenance expiry"); err != nil {
+			s.ctxlog.WithFields(log.Fields{"id": id, "err": err}).Warning("Unable to expire maintenance")
+		}
+	}
+	for _, id := range due {
+		s.drainStep(id, now)
+	}
+}
+
+//drainStep lowers the capacity of a draining node by one drain step. The nodes
+//maintenance is checked again under drainLock, so a step is never applied after
+//the maintenance was ended or the drain stopped.
+func (s *Server) drainStep(id uint64, now time.Time) {
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+	s.maintLock.Lock()
+	m, ok := s.maintenance[id]
+	if !ok || !m.Status.Draining || now.Before(m.NextStep) {
+		s.maintLock.Unlock()
+		return
+	}
+	capacity := m.Status.DrainTarget
+	if m.Status.Capacity > m.Status.DrainTarget+m.Status.DrainStep {
+		capacity = m.Status.Capacity - m.Status.DrainStep
+	}
+	s.maintLock.Unlock()
+
+	err := s.changeNodeCapacity(id, capacity, "MaintenanceDrain", "maintenance drain")
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{"id": id, "capacity": capacity, "err": err}).Warning("drain step failed")
+	}
+	s.maintLock.Lock()
+	defer s.maintLock.Unlock()
+	if m, ok := s.maintenance[id]; ok && m.Status.Draining {
+		m.NextStep = now.Add(time.Duration(m.Status.DrainInterval) * time.Second)
+		if err == nil {
+			m.Status.Capacity = capacity
+			m.Status.Draining = capacity > m.Status.DrainTarget
+		}
+		if err := s.saveMaintenance(); err != nil {
+			s.ctxlog.WithFields(log.Fields{"path": s.maintenancePath(), "err": err}).Warning("Unable to persist maintenance state")
+		}
+	}
+}
+
+func copyMaintenanceStatus(m *pb.MaintenanceStatus) *pb.MaintenanceStatus {
+	c := *m
+	return &c
+}
+
+type maintenanceByID []*nodeMaintenance
+
+func (m maintenanceByID) Len() int           { return len(m) }
+func (m maintenanceByID) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+func (m maintenanceByID) Less(i, j int) bool { return m[i].Status.Id < m[j].Status.Id }
+
+type maintenanceStatusByID []*pb.MaintenanceStatus
+
+func (m maintenanceStatusByID) Len() int           { return len(m) }
+func (m maintenanceStatusByID) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+func (m maintenanceStatusByID) Less(i, j int) bool { return m[i].Id < m[j].Id }
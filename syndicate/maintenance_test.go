@@ -0,0 +1,214 @@
+package syndicate
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func TestServer_Maintenance(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+
+	status, err := s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Reason: "swapping disks", Duration: 3600})
+	if err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	if status.Id != id || status.Reason != "swapping disks" || status.Expires-status.Started != 3600 || status.Draining {
+		t.Errorf("SetMaintenance returned unexpected status: %v", status)
+	}
+	if !s.inMaintenance(id) || s.inMaintenance(s.r.Nodes()[1].ID()) {
+		t.Errorf("only node %d should be in maintenance", id)
+	}
+
+	//maintenance survives a restart
+	s.maintenance = nil
+	if err := s.loadMaintenance(); err != nil {
+		t.Fatalf("loadMaintenance returned unexpected error: %s", err)
+	}
+	list, err := s.ListMaintenance(ctx, &pb.EmptyMsg{})
+	if err != nil || len(list.Nodes) != 1 || list.Nodes[0].Id != id || list.Nodes[0].Reason != "swapping disks" {
+		t.Fatalf("ListMaintenance after reload returned unexpected result: %v, %v", list, err)
+	}
+
+	if _, err = s.ClearMaintenance(ctx, &pb.MaintenanceRequest{Id: id}); err != nil {
+		t.Fatalf("ClearMaintenance returned unexpected error: %s", err)
+	}
+	if s.inMaintenance(id) {
+		t.Errorf("node %d should be out of maintenance", id)
+	}
+	if _, err = s.ClearMaintenance(ctx, &pb.MaintenanceRequest{Id: id}); err != NotInMaintenance {
+		t.Errorf("ClearMaintenance of node not in maintenance returned: %v", err)
+	}
+
+	//maintenance expires
+	if _, err = s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Duration: 1}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	s.checkMaintenance(time.Now())
+	if !s.inMaintenance(id) {
+		t.Errorf("node %d maintenance expired early", id)
+	}
+	s.checkMaintenance(time.Now().Add(2 * time.Second))
+	if list, _ = s.ListMaintenance(ctx, &pb.EmptyMsg{}); len(list.Nodes) != 0 {
+		t.Errorf("maintenance should have expired: %v", list)
+	}
+
+	bad := []*pb.MaintenanceRequest{
+		{Id: 42},
+		{Id: id, Duration: -1},
+		{Id: id, Drain: true, DrainInterval: -1},
+		{Id: id, Drain: true, DrainTarget: 1},
+	}
+	for _, r := range bad {
+		if _, err := s.SetMaintenance(ctx, r); err == nil {
+			t.Errorf("SetMaintenance(%v) should have returned an error", r)
+		}
+	}
+}
+
+func TestServer_MaintenanceDrain(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+	if _, err := s.SetCapacity(ctx, &pb.Node{Id: id, Capacity: 100}); err != nil {
+		t.Fatalf("SetCapacity returned unexpected error: %s", err)
+	}
+
+	_, err := s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Drain: true, DrainStep: 30, DrainInterval: 60, RestoreCapacity: true})
+	if err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	if _, err = s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 200}); err != DrainInProgress {
+		t.Errorf("RampCapacity for a draining node returned: %v", err)
+	}
+	now := time.Now()
+	expected := []struct {
+		at       time.Duration
+		capacity uint32
+	}{
+		{0, 70},
+		{30 * time.Second, 70}, //not due yet
+		{61 * time.Second, 40},
+		{122 * time.Second, 10},
+		{183 * time.Second, 0},
+		{244 * time.Second, 0},
+	}
+	for _, e := range expected {
+		version := s.r.Version()
+		s.checkMaintenance(now.Add(e.at))
+		if capacity, _ := s.nodeCapacity(id); capacity != e.capacity {
+			t.Fatalf("capacity at %s is %d, expected %d", e.at, capacity, e.capacity)
+		}
+		if e.at == 30*time.Second && s.r.Version() != version {
+			t.Errorf("ring changed before the drain step was due")
+		}
+	}
+	list, _ := s.ListMaintenance(ctx, &pb.EmptyMsg{})
+	if len(list.Nodes) != 1 || list.Nodes[0].Draining || list.Nodes[0].Capacity != 0 || list.Nodes[0].OriginalCapacity != 100 {
+		t.Errorf("ListMaintenance returned unexpected result after drain: %v", list)
+	}
+
+	status, err := s.ClearMaintenance(ctx, &pb.MaintenanceRequest{Id: id})
+	if err != nil {
+		t.Fatalf("ClearMaintenance returned unexpected error: %s", err)
+	}
+	if capacity, _ := s.nodeCapacity(id); capacity != 100 || status.Capacity != 100 {
+		t.Errorf("ClearMaintenance should have restored capacity to 100, got %d", capacity)
+	}
+
+	//a drain step that was due when the maintenance ended isn't applied
+	if _, err = s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Drain: true, DrainStep: 30}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	if _, err = s.ClearMaintenance(ctx, &pb.MaintenanceRequest{Id: id, RestoreCapacity: true}); err != nil {
+		t.Fatalf("ClearMaintenance returned unexpected error: %s", err)
+	}
+	s.drainStep(id, time.Now())
+	if capacity, _ := s.nodeCapacity(id); capacity != 100 {
+		t.Errorf("drain step after ClearMaintenance should have been skipped, capacity is %d", capacity)
+	}
+
+	//removing a draining node from the ring drops its maintenance
+	if _, err = s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Drain: true, DrainStep: 30}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	if _, err = s.RemoveNode(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("RemoveNode returned unexpected error: %s", err)
+	}
+	if list, _ = s.ListMaintenance(ctx, &pb.EmptyMsg{}); len(list.Nodes) != 0 {
+		t.Errorf("removed node should no longer be in maintenance: %v", list)
+	}
+	if err = s.loadMaintenance(); err != nil {
+		t.Fatalf("loadMaintenance returned unexpected error: %s", err)
+	}
+	if s.inMaintenance(id) {
+		t.Errorf("removed node's maintenance should not have been persisted")
+	}
+}
+
+func TestServer_MaintenanceSkipsOrchestration(t *testing.T) {
+	s, fakes, ids := newUpgradeTestServer()
+	defer useTempRingDir(t, s)()
+	ctx := context.Background()
+	if _, err := s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: ids["server3"], Reason: "psu"}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+
+	u, err := s.planUpgrade(&pb.UpgradeRequest{Version: "2.0", Tier: 1, BatchSize: 3})
+	if err != nil {
+		t.Fatalf("planUpgrade returned unexpected error: %s", err)
+	}
+	if len(u.batches) != 2 || len(u.batches[0])+len(u.batches[1]) != 5 || len(u.status.Nodes) != 6 {
+		t.Fatalf("planUpgrade returned unexpected batches: %v", u.status.Nodes)
+	}
+	for _, n := range u.status.Nodes {
+		skipped := n.State == pb.UpgradeNodeState_NODE_SKIPPED && n.Error == "In maintenance" && n.Batch == 0
+		if (n.Id == ids["server3"]) != skipped {
+			t.Errorf("planUpgrade returned unexpected node: %#v", n)
+		}
+	}
+	if _, err := s.planUpgrade(&pb.UpgradeRequest{Version: "2.0", Canaries: []uint64{ids["server3"]}}); err == nil {
+		t.Errorf("planUpgrade should refuse a canary in maintenance")
+	}
+
+	res, err := s.RestartNodes(ctx, &pb.NodeControlRequest{Query: "active=true", Concurrency: 6, HealthTimeout: 1})
+	if err != nil {
+		t.Fatalf("RestartNodes returned unexpected error: %s", err)
+	}
+	if res.Skipped != 1 || res.Failed != 0 || len(fakes["server3"].controlRequests()) != 0 {
+		t.Errorf("RestartNodes should have skipped server3: %v", res)
+	}
+
+	//a node put into maintenance while an upgrade is running is skipped too
+	r := &pb.UpgradeRequest{Version: "2.0", Tier: 1, BatchSize: 1}
+	batches := plannedBatches(t, s, r, ids)
+	first, last := batches[0][0], batches[len(batches)-1][0]
+	gate := make(chan struct{})
+	fakes[first].gate = gate
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	waitForUpgradeState(t, s, func(status *pb.UpgradeStatus) bool { return status.Batch == 1 })
+	if _, err := s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: ids[last], Reason: "disk"}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	close(gate)
+	status := waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_COMPLETE {
+		t.Errorf("upgrade finished with unexpected status: %v", status)
+	}
+	for _, n := range status.Nodes {
+		if n.Id == ids[last] && (n.State != pb.UpgradeNodeState_NODE_SKIPPED || n.Error != "In maintenance") {
+			t.Errorf("upgrade should have skipped %s once it went into maintenance: %#v", last, n)
+		}
+	}
+	if len(fakes[last].upgradeRequests()) != 0 {
+		t.Errorf("upgrade should not have touched %s while it's in maintenance", last)
+	}
+}
@@ -67,8 +67,11 @@ type ManagedNode interface {
 	Connect() error
 	Disconnect() error
 	Ping() (bool, string, error)
+	Start() error
 	Stop() error
+	Restart() error
 	RingUpdate(*[]byte, int64) (bool, error)
+	RingVersion() int64
 	Lock()
 	Unlock()
 	RLock()
@@ -177,6 +180,38 @@ func (n *managedNode) Stop() error {
 	return nil
 }
 
+// Start a remote nodes backend
+func (n *managedNode) Start() error {
+	n.Lock()
+	defer n.Unlock()
+	ctx, _ := context.WithTimeout(context.Background(), _FH_STOP_NODE_TIMEOUT*time.Second)
+	status, err := n.client.Start(ctx, &cc.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	if !status.Status {
+		return fmt.Errorf("Start failed: %s", status.Msg)
+	}
+	n.active = true
+	return nil
+}
+
+// Restart a remote nodes backend
+func (n *managedNode) Restart() error {
+	n.Lock()
+	defer n.Unlock()
+	ctx, _ := context.WithTimeout(context.Background(), _FH_STOP_NODE_TIMEOUT*time.Second)
+	status, err := n.client.Restart(ctx, &cc.EmptyMsg{})
+	if err != nil {
+		return err
+	}
+	if !status.Status {
+		return fmt.Errorf("Restart failed: %s", status.Msg)
+	}
+	n.active = true
+	return nil
+}
+
 // RingUpdate lets you push a ring update to a remote node
 // If the underlying grpc conn is not ready it will wait for it to become
 // available. If the underlying conn is shutdown (like we caught an update
@@ -208,13 +243,23 @@ func (n *managedNode) RingUpdate(r *[]byte, version int64) (bool, error) {
 	return true, nil
 }
 
+// RingVersion returns the last ring version the node acknowledged
+func (n *managedNode) RingVersion() int64 {
+	n.RLock()
+	defer n.RUnlock()
+	return n.ringversion
+}
+
 // GetSoftwareVersion retrieves a managed nodes running version
 func (n *managedNode) GetSoftwareVersion() (string, error) {
 	n.RLock()
 	defer n.RUnlock()
 	ctx, _ := context.WithTimeout(context.Background(), DEFAULT_CTX_TIMEOUT)
 	version, err := n.client.SoftwareVersion(ctx, &cc.EmptyMsg{})
-	return version.Version, err
+	if err != nil {
+		return "", err
+	}
+	return version.Version, nil
 }
 
 // UpgradeSoftwareVersion asks a managed node to download and replace the running software
@@ -223,7 +268,27 @@ func (n *managedNode) UpgradeSoftwareVersion(version string) (bool, error) {
 	defer n.Unlock()
 	ctx, _ := context.WithTimeout(context.Background(), DEFAULT_CTX_TIMEOUT)
 	status, err := n.client.SelfUpgrade(ctx, &cc.SelfUpgradeMsg{Version: version})
-	return status.Status, err
+	if err != nil {
+		return false, err
+	}
+	return status.Status, nil
+}
+
+//replaceManagedNode disconnects the nodes current managed node, if any, and
+//connects a new one to address.
+func (s *Server) replaceManagedNode(id uint64, address string) {
+	s.removeManagedNodes([]uint64{id})
+	mn, err := NewManagedNode(&ManagedNodeOpts{Address: address})
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{
+			"id":      id,
+			"address": address,
+			"err":     err,
+		}).Warning("failed to replace managed node")
+		return
+	}
+	s.managedNodes[id] = mn
+	s.metrics.managedNodes.Inc()
 }
 
 // TODO: if disconnect encounters an error we just log it and remove the node anyway
@@ -0,0 +1,169 @@
+package syndicate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+//RegisterNodeMulti registers a host with several services at once, see
+//registerMulti. A single Server only knows its own service, a ServiceRouter
+//registers with any of the services it serves.
+func (s *Server) RegisterNodeMulti(c context.Context, r *pb.RegisterMultiRequest) (*pb.RegisterMultiResult, error) {
+	return registerMulti(c, map[string]*Server{s.servicename: s}, r)
+}
+
+//nodeState is what registration may change about an existing ring entry, kept
+//so a multi service registration can be rolled back.
+type nodeState struct {
+	id        uint64
+	capacity  uint32
+	addresses []string
+	meta      string
+	hardware  *pb.NodeHardware
+}
+
+//nodeState returns the current state of the builder node. s.Lock must be held.
+func (s *Server) nodeState(node *ring.BuilderNode) *nodeState {
+	s.hwLock.Lock()
+	hw := s.hardware[node.ID()]
+	s.hwLock.Unlock()
+	return &nodeState{
+		id:        node.ID(),
+		capacity:  node.Capacity(),
+		addresses: append([]string(nil), node.Addresses()...),
+		meta:      node.Meta(),
+		hardware:  hw,
+	}
+}
+
+//restoreNode puts an entry reconciled by registration back to its previous
+//capacity, addresses and meta as a single ring change, and restores its stored
+//hardware profile.
+func (s *Server) restoreNode(c context.Context, prev *nodeState, kind string) error {
+	s.Lock()
+	defer s.Unlock()
+	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
+	if err != nil {
+		return fmt.Errorf("Unable to load builder for change: %s", err)
+	}
+	node := b.Node(prev.id)
+	if node == nil {
+		return fmt.Errorf("Node %d not found", prev.id)
+	}
+	oldCmdCtrlAddr := node.Address(s.cfg.CmdCtrlIndex)
+	var changed bool
+	if node.Capacity() != prev.capacity {
+		node.SetCapacity(prev.capacity)
+		changed = true
+	}
+	if strings.Join(node.Addresses(), "|") != strings.Join(prev.addresses, "|") {
+		node.ReplaceAddresses(prev.addresses)
+		changed = true
+	}
+	if node.Meta() != prev.meta {
+		node.SetMeta(prev.meta)
+		changed = true
+	}
+	if changed {
+		newRing := b.Ring()
+		s.ctxlog.WithFields(log.Fields{"proposed-ringver": newRing.Version(), "id": prev.id}).Info("attempting to apply ring version")
+		err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: kind, nodes: []uint64{prev.id}, caller: callerFromContext(c)})
+		if err != nil {
+			s.ctxlog.WithFields(log.Fields{
+				"proposed-ringver": newRing.Version(),
+				"ringver":          s.r.Version(),
+				"err":              err,
+			}).Warning("failed to apply ring change")
+			return err
+		}
+		if newCmdCtrlAddr := node.Address(s.cfg.CmdCtrlIndex); newCmdCtrlAddr != oldCmdCtrlAddr {
+			s.replaceManagedNode(prev.id, newCmdCtrlAddr)
+		}
+	}
+	s.restoreNodeHardware(prev.id, prev.hardware)
+	return nil
+}
+
+//registerMulti registers r.Node with each of r.Services (all of services if
+//none are given) in order, returning each services NodeConfig or why it failed.
+//Without r.Rollback a failure doesn't stop the remaining services from being
+//tried. With it the first failure skips the rest and undoes the registrations
+//already made: the node is removed from the services it was newly added to and
+//existing entries that were reconciled are put back as they were. Failures are
+//reported per service in the result (with Ok false) rather than as an error.
+func registerMulti(c context.Context, services map[string]*Server, r *pb.RegisterMultiRequest) (*pb.RegisterMultiResult, error) {
+	if r.Node == nil {
+		return &pb.RegisterMultiResult{}, fmt.Errorf("No node provided")
+	}
+	names := r.Services
+	if len(names) == 0 {
+		for name := range services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return &pb.RegisterMultiResult{}, fmt.Errorf("Service %q listed more than once", name)
+		}
+		seen[name] = true
+	}
+
+	res := &pb.RegisterMultiResult{Ok: true}
+	prevs := make(map[string]*nodeState, len(names))
+	for _, name := range names {
+		reg := &pb.ServiceRegistration{Service: name}
+		res.Results = append(res.Results, reg)
+		if !res.Ok && r.Rollback {
+			reg.Skipped = true
+			continue
+		}
+		s, ok := services[name]
+		if !ok {
+			reg.Error = fmt.Sprintf("Unknown service %q", name)
+			res.Ok = false
+			continue
+		}
+		nc, prev, err := s.registerNode(c, r.Node)
+		if err != nil {
+			s.ctxlog.WithFields(log.Fields{"hostname": r.Node.Hostname, "err": err}).Warning("multi service registration failed")
+			reg.Error = err.Error()
+			res.Ok = false
+			continue
+		}
+		reg.Config = nc
+		reg.Added = prev == nil
+		prevs[name] = prev
+	}
+	if res.Ok || !r.Rollback {
+		return res, nil
+	}
+	for i := len(res.Results) - 1; i >= 0; i-- {
+		reg := res.Results[i]
+		if reg.Config == nil {
+			continue
+		}
+		s := services[reg.Service]
+		var err error
+		if reg.Added {
+			_, err = s.removeNode(c, &pb.Node{Id: reg.Config.Localid}, "RegisterRollback")
+		} else {
+			err = s.restoreNode(c, prevs[reg.Service], "RegisterRollback")
+		}
+		if err != nil {
+			s.ctxlog.WithFields(log.Fields{"id": reg.Config.Localid, "err": err}).Warning("failed to roll back registration")
+			reg.RollbackError = err.Error()
+			continue
+		}
+		s.ctxlog.WithFields(log.Fields{"id": reg.Config.Localid, "hostname": r.Node.Hostname}).Info("rolled back registration")
+		reg.RolledBack = true
+	}
+	return res, nil
+}
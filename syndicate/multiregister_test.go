@@ -0,0 +1,123 @@
+package syndicate
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func multiRegisterRequest(hostname, addr string, rollback bool, services ...string) *pb.RegisterMultiRequest {
+	return &pb.RegisterMultiRequest{
+		Node: &pb.RegisterRequest{
+			Hostname: hostname,
+			Addrs:    []string{addr},
+			Tiers:    []string{hostname, "zone2"},
+			Hardware: &pb.HardwareProfile{Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 10000000000}}},
+		},
+		Services: services,
+		Rollback: rollback,
+	}
+}
+
+func TestServiceRouter_RegisterNodeMulti(t *testing.T) {
+	s1, _ := newTestServerWithDefaults()
+	s2, _ := newTestServerWithDefaults()
+	s2.servicename = "other"
+	defer useTempRingDir(t, s1)()
+	defer useTempRingDir(t, s2)()
+	router := NewServiceRouter(map[string]*Server{"test": s1, "other": s2}, "test")
+	ctx := context.Background()
+
+	//registers with every service when none are listed
+	req := multiRegisterRequest("server2", "10.0.0.2/32", false)
+	res, err := router.RegisterNodeMulti(ctx, req)
+	if err != nil || !res.Ok || len(res.Results) != 2 {
+		t.Fatalf("RegisterNodeMulti(ctx, %#v) returned unexpected result: %v, %v", req, res, err)
+	}
+	for i, s := range []*Server{s2, s1} {
+		reg := res.Results[i]
+		if reg.Service != s.servicename || !reg.Added || reg.Error != "" || s.r.Node(reg.Config.Localid) == nil {
+			t.Errorf("Unexpected registration result for %s: %v", s.servicename, reg)
+		}
+	}
+	server2 := res.Results[1].Config.Localid
+	//registering again just reconciles the existing entries
+	if res, err = router.RegisterNodeMulti(ctx, req); err != nil || !res.Ok || res.Results[0].Added || res.Results[1].Added {
+		t.Errorf("Reregistering returned unexpected result: %v, %v", res, err)
+	}
+
+	//other refuses 10.0.0.0/24 from here on
+	_, netblock, _ := net.ParseCIDR("1.2.3.0/24")
+	s2.netlimits = []*net.IPNet{netblock}
+
+	//without rollback the services that accepted keep the node
+	req = multiRegisterRequest("server3", "10.0.0.3/32", false, "test", "other")
+	if res, err = router.RegisterNodeMulti(ctx, req); err != nil || res.Ok {
+		t.Fatalf("RegisterNodeMulti(ctx, %#v) should have partially failed: %v, %v", req, res, err)
+	}
+	if !res.Results[0].Added || res.Results[0].RolledBack || res.Results[1].Error == "" {
+		t.Errorf("Unexpected partial registration result: %v", res)
+	}
+	if s1.r.Node(res.Results[0].Config.Localid) == nil {
+		t.Errorf("server3 should have been left in the test ring")
+	}
+
+	//with rollback they don't, and the rest are skipped
+	req = multiRegisterRequest("server4", "10.0.0.4/32", true, "test", "other", "nope")
+	if res, err = router.RegisterNodeMulti(ctx, req); err != nil || res.Ok {
+		t.Fatalf("RegisterNodeMulti(ctx, %#v) should have failed: %v, %v", req, res, err)
+	}
+	if !res.Results[0].Added || !res.Results[0].RolledBack || res.Results[0].RollbackError != "" {
+		t.Errorf("test registration should have been rolled back: %v", res.Results[0])
+	}
+	if s1.r.Node(res.Results[0].Config.Localid) != nil {
+		t.Errorf("server4 should have been removed from the test ring")
+	}
+	if res.Results[1].Error == "" || res.Results[1].RolledBack {
+		t.Errorf("Unexpected other registration result: %v", res.Results[1])
+	}
+	if !res.Results[2].Skipped || res.Results[2].Error != "" {
+		t.Errorf("nope should have been skipped: %v", res.Results[2])
+	}
+
+	//reconciled entries are put back as they were
+	before := s1.r.Node(server2)
+	addrs, capacity := strings.Join(before.Addresses(), "|"), before.Capacity()
+	req = multiRegisterRequest("server2", "10.0.0.22/32", true, "test", "other")
+	req.Node.Hardware.Disks[0].Size_ = 40000000000
+	if res, err = router.RegisterNodeMulti(ctx, req); err != nil || res.Ok {
+		t.Fatalf("RegisterNodeMulti(ctx, %#v) should have failed: %v, %v", req, res, err)
+	}
+	if res.Results[0].Added || !res.Results[0].RolledBack || res.Results[0].RollbackError != "" {
+		t.Errorf("test reconcile should have been rolled back: %v", res.Results[0])
+	}
+	after := s1.r.Node(server2)
+	if strings.Join(after.Addresses(), "|") != addrs || after.Capacity() != capacity || after.Meta() != "server2" {
+		t.Errorf("server2 should have been restored to %s with capacity %d: %v", addrs, capacity, after)
+	}
+	if hw, _ := s1.GetNodeHardware(ctx, &pb.Node{Id: server2}); hw.Hardware.Disks[0].Size_ != 10000000000 {
+		t.Errorf("server2 hardware profile should have been restored: %v", hw)
+	}
+
+	//unknown services fail on their own, duplicates fail the request
+	if res, err = router.RegisterNodeMulti(ctx, multiRegisterRequest("server5", "10.0.0.5/32", false, "nope", "test")); err != nil || res.Ok || res.Results[0].Error == "" || !res.Results[1].Added {
+		t.Errorf("Unknown service returned unexpected result: %v, %v", res, err)
+	}
+	if _, err = router.RegisterNodeMulti(ctx, multiRegisterRequest("server6", "10.0.0.6/32", false, "test", "test")); err == nil {
+		t.Errorf("RegisterNodeMulti with a duplicate service should have failed")
+	}
+	if _, err = router.RegisterNodeMulti(ctx, &pb.RegisterMultiRequest{}); err == nil {
+		t.Errorf("RegisterNodeMulti without a node should have failed")
+	}
+
+	//a lone server only knows its own service
+	if res, err = s1.RegisterNodeMulti(ctx, multiRegisterRequest("server7", "10.0.0.7/32", false, "other")); err != nil || res.Ok {
+		t.Errorf("Server.RegisterNodeMulti for another service should have failed: %v, %v", res, err)
+	}
+	if res, err = s1.RegisterNodeMulti(ctx, multiRegisterRequest("server7", "10.0.0.7/32", false)); err != nil || !res.Ok || res.Results[0].Service != "test" {
+		t.Errorf("Server.RegisterNodeMulti returned unexpected result: %v, %v", res, err)
+	}
+}
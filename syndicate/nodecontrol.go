@@ -0,0 +1,240 @@
+package syndicate
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	DefaultNodeControlConcurrency   = 1   //The default number of nodes started/stopped/restarted at once
+	DefaultNodeControlHealthTimeout = 300 //The default seconds started or restarted nodes have to answer a Ping
+)
+
+//nodeControlPingInterval is how often started or restarted nodes are pinged while
+//waiting for them to come back.
+var nodeControlPingInterval = time.Second
+
+//StartNodes starts the backends of the requested nodes, see controlNodes.
+func (s *Server) StartNodes(c context.Context, r *pb.NodeControlRequest) (*pb.NodeControlResult, error) {
+	return s.controlNodes(c, "start", r, true, func(n ManagedNode) error { return n.Start() })
+}
+
+//StopNodes stops the backends of the requested nodes, see controlNodes.
+func (s *Server) StopNodes(c context.Context, r *pb.NodeControlRequest) (*pb.NodeControlResult, error) {
+	return s.controlNodes(c, "stop", r, false, func(n ManagedNode) error { return n.Stop() })
+}
+
+//RestartNodes restarts the backends of the requested nodes, see controlNodes.
+func (s *Server) RestartNodes(c context.Context, r *pb.NodeControlRequest) (*pb.NodeControlResult, error) {
+	return s.controlNodes(c, "restart", r, true, func(n ManagedNode) error { return n.Restart() })
+}
+
+//controlNodes runs action against the managed nodes picked by the request's ids
+//or, if no ids were given, its QueryNodes query. Nodes are grouped into failure
+//domains by the requested tier and the domains are worked through one at a time,
+//with at most concurrency nodes in a domain being acted on at once. If wait is set
+//each node has to answer a Ping as a restarted process within the health timeout
+//to count as a success, see waitRestarted. Unless ContinueOnError is set, once a domain has a failure the
+//remaining domains are skipped. No new domains are started once c is done.
+func (s *Server) controlNodes(c context.Context, name string, r *pb.NodeControlRequest, wait bool, action func(ManagedNode) error) (*pb.NodeControlResult, error) {
+	if r.Tier < 0 {
+		return &pb.NodeControlResult{}, fmt.Errorf("Invalid tier %d", r.Tier)
+	}
+	if r.HealthTimeout < 0 {
+		return &pb.NodeControlResult{}, fmt.Errorf("Invalid health timeout %d", r.HealthTimeout)
+	}
+	concurrency := int(r.Concurrency)
+	if concurrency == 0 {
+		concurrency = DefaultNodeControlConcurrency
+	}
+	timeout := time.Duration(r.HealthTimeout) * time.Second
+	if timeout == 0 {
+		timeout = DefaultNodeControlHealthTimeout * time.Second
+	}
+	domains, managed, err := s.nodeControlTargets(r)
+	if err != nil {
+		return &pb.NodeControlResult{}, err
+	}
+	ctxlog := s.ctxlog.WithFields(log.Fields{"action": name, "caller": callerFromContext(c)})
+	ctxlog.WithField("nodes", len(managed)).Info("controlling nodes")
+
+	res := &pb.NodeControlResult{}
+	var failed bool
+	for _, domain := range domains {
+		var cancelled bool
+		select {
+		case <-c.Done():
+			cancelled = true
+		default:
+		}
+		nodes := make(map[uint64]ManagedNode)
+		byID := make(map[uint64]*pb.NodeControlStatus)
+		for _, n := range domain {
+			if n.Skipped {
+				continue
+			}
+			if cancelled {
+				n.Skipped = true
+				n.Error = "Skipped after the request was cancelled"
+				continue
+			}
+			if failed && !r.ContinueOnError {
+				n.Skipped = true
+				n.Error = "Skipped after failures in an earlier domain"
+				continue
+			}
+			nodes[n.Id] = managed[n.Id]
+			byID[n.Id] = n
+		}
+		forEachNode(nodes, concurrency, func(id uint64, mn ManagedNode) {
+			n := byID[id]
+			var up bool
+			var report string
+			if wait {
+				ok, msg, err := mn.Ping()
+				up = err == nil && ok
+				report = msg
+			}
+			err := action(mn)
+			if err == nil && wait {
+				//a node that was already running isn't stopped by a start
+				err = waitRestarted(mn, timeout, !up || name == "start", report)
+			}
+			if err != nil {
+				ctxlog.WithFields(log.Fields{"id": id, "err": err}).Warning("node control failed")
+				n.Error = err.Error()
+				return
+			}
+			n.Status = true
+		})
+		for _, n := range domain {
+			res.Nodes = append(res.Nodes, n)
+			switch {
+			case n.Skipped:
+				res.Skipped++
+			case !n.Status:
+				res.Failed++
+				failed = true
+			}
+		}
+	}
+	ctxlog.WithFields(log.Fields{"failed": res.Failed, "skipped": res.Skipped}).Info("finished controlling nodes")
+	return res, nil
+}
+
+//waitRestarted pings the node until it answers as a restarted process, or the
+//timeout passes. down is whether the node is already known to have been down and
+//report is the health message the node answered with before it was restarted.
+//Until the node has been seen down, or answers with a different message (i.e. one
+//carrying the backends start time), its answers may still come from the old
+//process so they don't count.
+func waitRestarted(mn ManagedNode, timeout time.Duration, down bool, report string) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, msg, err := mn.Ping()
+		up := err == nil && ok
+		if up && (down || msg != report) {
+			return nil
+		}
+		if !up {
+			down = true
+		}
+		if time.Now().After(deadline) {
+			if !down {
+				return fmt.Errorf("Node kept answering for %s without going down or reporting a restart, restart not confirmed", timeout)
+			}
+			if err == nil {
+				err = fmt.Errorf("ping not ok")
+			}
+			return fmt.Errorf("Node not healthy after %s: %s", timeout, err)
+		}
+		time.Sleep(nodeControlPingInterval)
+	}
+}
+
+//nodeControlTargets resolves the request's nodes, grouped by failure domain with
+//domains sorted by name and nodes by id. Nodes that aren't managed or are in
+//maintenance are included but already marked skipped.
+func (s *Server) nodeControlTargets(r *pb.NodeControlRequest) ([][]*pb.NodeControlStatus, map[uint64]ManagedNode, error) {
+	s.RLock()
+	defer s.RUnlock()
+	var ids []uint64
+	switch {
+	case len(r.Ids) != 0:
+		for _, id := range r.Ids {
+			if s.r.Node(id) == nil {
+				return nil, nil, fmt.Errorf("Node %d not found", id)
+			}
+			ids = append(ids, id)
+		}
+	case r.Query != "":
+		query, err := parseNodeQuery(r.Query)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid query: %s", err)
+		}
+		var counts map[uint64]uint64
+		if query.needPartitions {
+			counts = partitionCounts(s.r)
+		}
+		for _, n := range query.run(s.r.Nodes(), counts) {
+			ids = append(ids, n.ID())
+		}
+	default:
+		return nil, nil, fmt.Errorf("No node ids or query provided")
+	}
+	if len(ids) == 0 {
+		return nil, nil, fmt.Errorf("No nodes matched")
+	}
+
+	byDomain := make(map[string][]*pb.NodeControlStatus)
+	managed := make(map[uint64]ManagedNode)
+	seen := make(map[uint64]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		n := s.r.Node(id)
+		st := &pb.NodeControlStatus{Id: id}
+		if tiers := n.Tiers(); int(r.Tier) < len(tiers) {
+			st.Domain = tiers[r.Tier]
+		}
+		mn, ok := s.managedNodes[id]
+		switch {
+		case !ok:
+			st.Address = n.Address(0)
+			st.Skipped = true
+			st.Error = "Not a managed node"
+		case s.inMaintenance(id):
+			st.Address = mn.Address()
+			st.Skipped = true
+			st.Error = "In maintenance"
+		default:
+			st.Address = mn.Address()
+			managed[id] = mn
+		}
+		byDomain[st.Domain] = append(byDomain[st.Domain], st)
+	}
+	names := make([]string, 0, len(byDomain))
+	for domain := range byDomain {
+		names = append(names, domain)
+	}
+	sort.Strings(names)
+	domains := make([][]*pb.NodeControlStatus, 0, len(names))
+	for _, domain := range names {
+		sort.Sort(nodeControlStatusByID(byDomain[domain]))
+		domains = append(domains, byDomain[domain])
+	}
+	return domains, managed, nil
+}
+
+type nodeControlStatusByID []*pb.NodeControlStatus
+
+func (n nodeControlStatusByID) Len() int           { return len(n) }
+func (n nodeControlStatusByID) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodeControlStatusByID) Less(i, j int) bool { return n[i].Id < n[j].Id }
@@ -0,0 +1,173 @@
+package syndicate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	nodeControlPingInterval = 10 * time.Millisecond
+}
+
+func TestServer_RestartNodes(t *testing.T) {
+	s, fakes, ids := newUpgradeTestServer()
+	tracker := &callTracker{delay: 10 * time.Millisecond}
+	for _, f := range fakes {
+		f.tracker = tracker
+	}
+	ctx := context.Background()
+
+	r := &pb.NodeControlRequest{Query: "tier1~=^zone", Tier: 1, Concurrency: 2, HealthTimeout: 1}
+	res, err := s.RestartNodes(ctx, r)
+	if err != nil {
+		t.Fatalf("RestartNodes returned unexpected error: %s", err)
+	}
+	if len(res.Nodes) != 6 || res.Failed != 0 || res.Skipped != 0 {
+		t.Fatalf("RestartNodes returned unexpected result: %v", res)
+	}
+	if tracker.maxRunning() > 2 {
+		t.Errorf("RestartNodes restarted %d nodes at once, expected at most 2", tracker.maxRunning())
+	}
+	//all of zoneA (the odd servers) has to be done before zoneB is touched
+	for i, addr := range tracker.callOrder() {
+		var n int
+		fmt.Sscanf(addr, "10.0.0.%d:4443", &n)
+		if (i < 3) != (n%2 == 1) {
+			t.Errorf("RestartNodes didn't restart one failure domain at a time: %v", tracker.callOrder())
+			break
+		}
+	}
+	for i, n := range res.Nodes {
+		domain := "zoneA"
+		if i >= 3 {
+			domain = "zoneB"
+		}
+		if n.Domain != domain || !n.Status || n.Skipped || n.Error != "" {
+			t.Errorf("RestartNodes returned unexpected node status: %#v", n)
+		}
+		if i > 0 && n.Domain == res.Nodes[i-1].Domain && n.Id < res.Nodes[i-1].Id {
+			t.Errorf("RestartNodes nodes aren't sorted by id within their domain: %v", res.Nodes)
+		}
+	}
+	for name, f := range fakes {
+		if c := f.controlRequests(); len(c) != 1 || c[0] != "restart" {
+			t.Errorf("%s got control requests %v, expected [restart]", name, c)
+		}
+	}
+
+	//nodes that don't come back healthy are failures
+	fakes["server3"].unhealthy = true
+	res, err = s.StartNodes(ctx, &pb.NodeControlRequest{Ids: []uint64{ids["server1"], ids["server3"]}, HealthTimeout: 1})
+	if err != nil {
+		t.Fatalf("StartNodes returned unexpected error: %s", err)
+	}
+	if res.Failed != 1 {
+		t.Fatalf("StartNodes returned unexpected result: %v", res)
+	}
+	for _, n := range res.Nodes {
+		if (n.Id == ids["server3"]) != strings.Contains(n.Error, "not healthy") {
+			t.Errorf("StartNodes returned unexpected node status: %#v", n)
+		}
+	}
+
+	//a restarted node that never went down may still be the old process
+	fakes["server5"].bounce = 0
+	res, err = s.RestartNodes(ctx, &pb.NodeControlRequest{Ids: []uint64{ids["server5"]}, HealthTimeout: 1})
+	if err != nil || res.Failed != 1 || !strings.Contains(res.Nodes[0].Error, "without going down") {
+		t.Errorf("RestartNodes of a node that never went down returned unexpected result: %v, %v", res, err)
+	}
+
+	//unless it reports it has been restarted, however quickly it came back
+	fakes["server5"].starts = true
+	res, err = s.RestartNodes(ctx, &pb.NodeControlRequest{Ids: []uint64{ids["server5"]}, HealthTimeout: 1})
+	if err != nil || res.Failed != 0 || !res.Nodes[0].Status {
+		t.Errorf("RestartNodes of a node reporting its restart returned unexpected result: %v, %v", res, err)
+	}
+	if c := fakes["server5"].controlRequests(); len(c) != 3 {
+		t.Errorf("server5 got control requests %v, expected 3 restarts", c)
+	}
+
+	//no new domains are started once the request is done
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	res, err = s.RestartNodes(cctx, r)
+	if err != nil || res.Skipped != 6 || res.Failed != 0 {
+		t.Errorf("RestartNodes with a cancelled context returned unexpected result: %v, %v", res, err)
+	}
+	if c := fakes["server1"].controlRequests(); len(c) != 2 {
+		t.Errorf("RestartNodes with a cancelled context shouldn't have restarted anything: %v", c)
+	}
+
+	//stopping doesn't wait on health
+	res, err = s.StopNodes(ctx, &pb.NodeControlRequest{Ids: []uint64{ids["server3"]}})
+	if err != nil || res.Failed != 0 || len(res.Nodes) != 1 || !res.Nodes[0].Status {
+		t.Errorf("StopNodes returned unexpected result: %v, %v", res, err)
+	}
+}
+
+func TestServer_ControlNodesFailures(t *testing.T) {
+	ctx := context.Background()
+
+	//a failure in zoneA skips zoneB
+	s, fakes, ids := newUpgradeTestServer()
+	fakes["server1"].ctlErr = fmt.Errorf("backend wedged")
+	r := &pb.NodeControlRequest{Query: "tier1~=^zone", Tier: 1, Concurrency: 3}
+	res, err := s.StopNodes(ctx, r)
+	if err != nil {
+		t.Fatalf("StopNodes returned unexpected error: %s", err)
+	}
+	if res.Failed != 1 || res.Skipped != 3 || len(res.Nodes) != 6 {
+		t.Fatalf("StopNodes returned unexpected result: %v", res)
+	}
+	for name, f := range fakes {
+		zoneB := name == "server2" || name == "server4" || name == "server6"
+		if zoneB != (len(f.controlRequests()) == 0) {
+			t.Errorf("%s got unexpected control requests %v", name, f.controlRequests())
+		}
+	}
+	for _, n := range res.Nodes {
+		if n.Id == ids["server1"] && (n.Status || n.Error != "backend wedged") {
+			t.Errorf("StopNodes returned unexpected status for server1: %#v", n)
+		}
+	}
+
+	//unless asked to carry on
+	s, fakes, ids = newUpgradeTestServer()
+	fakes["server1"].ctlErr = fmt.Errorf("backend wedged")
+	r.ContinueOnError = true
+	res, err = s.StopNodes(ctx, r)
+	if err != nil || res.Failed != 1 || res.Skipped != 0 {
+		t.Errorf("StopNodes with ContinueOnError returned unexpected result: %v, %v", res, err)
+	}
+
+	//nodes without a managed connection are skipped
+	delete(s.managedNodes, ids["server2"])
+	res, err = s.StopNodes(ctx, &pb.NodeControlRequest{Ids: []uint64{ids["server2"], ids["server4"], ids["server2"]}})
+	if err != nil || len(res.Nodes) != 2 || res.Skipped != 1 || res.Failed != 0 {
+		t.Fatalf("StopNodes returned unexpected result: %v, %v", res, err)
+	}
+	for _, n := range res.Nodes {
+		if (n.Id == ids["server2"]) != (n.Skipped && n.Error == "Not a managed node") {
+			t.Errorf("StopNodes returned unexpected node status: %#v", n)
+		}
+	}
+
+	bad := []*pb.NodeControlRequest{
+		{},
+		{Ids: []uint64{42}},
+		{Query: "tier1=("},
+		{Query: "tier1=nowhere"},
+		{Query: "active=true", Tier: -1},
+		{Query: "active=true", HealthTimeout: -1},
+	}
+	for _, r := range bad {
+		if _, err := s.RestartNodes(ctx, r); err == nil {
+			t.Errorf("RestartNodes(%v) should have returned an error", r)
+		}
+	}
+}
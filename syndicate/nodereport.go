@@ -0,0 +1,150 @@
+package syndicate
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+//nodeReportCheckInterval is how often node report staleness is checked and
+//refreshed hardware profiles are persisted.
+var nodeReportCheckInterval = 10 * time.Second
+
+//nodeReport is the last status report received from a node.
+type nodeReport struct {
+	report   *pb.NodeStatusReport
+	received time.Time
+	stale    bool
+}
+
+//ReportNodeStatus records a heartbeat from a node with its current disk, memory and
+//load usage and the ring version it's running. The reported values are exported
+//as per node metrics and the reports hardware profile replaces the one stored for
+//the node. The current ring version is returned so the node can tell if it's behind.
+func (s *Server) ReportNodeStatus(c context.Context, r *pb.NodeStatusReport) (*pb.RingStatus, error) {
+	s.RLock()
+	inRing := s.r.Node(r.Id) != nil
+	version := s.r.Version()
+	s.RUnlock()
+	if !inRing {
+		return &pb.RingStatus{Status: false, Version: version}, fmt.Errorf("Node %d not found", r.Id)
+	}
+	s.reportLock.Lock()
+	if s.reports == nil {
+		s.reports = make(map[uint64]*nodeReport)
+	}
+	prev, ok := s.reports[r.Id]
+	if ok && prev.stale {
+		s.ctxlog.WithField("id", r.Id).Info("node resumed sending status reports")
+	}
+	s.reports[r.Id] = &nodeReport{report: r, received: time.Now()}
+	if ok {
+		s.deleteDiskMetrics(prev.report, r)
+	}
+	s.setReportMetrics(r)
+	s.reportLock.Unlock()
+	s.refreshNodeHardware(r.Id, r.Hostname, r.Hardware)
+	s.ctxlog.WithFields(log.Fields{"id": r.Id, "ringver": r.RingVersion}).Debug("node status report")
+	return &pb.RingStatus{Status: true, Version: version}, nil
+}
+
+//setReportMetrics exports the values of a node status report, s.reportLock must be held.
+func (s *Server) setReportMetrics(r *pb.NodeStatusReport) {
+	node := fmt.Sprintf("%d", r.Id)
+	if r.Hardware != nil {
+		for _, d := range r.Hardware.Disks {
+			s.metrics.nodeDiskUsed.WithLabelValues(node, d.Path).Set(float64(d.Used))
+			var free uint64
+			if d.Size_ > d.Used {
+				free = d.Size_ - d.Used
+			}
+			s.metrics.nodeDiskFree.WithLabelValues(node, d.Path).Set(float64(free))
+		}
+		s.metrics.nodeMemTotal.WithLabelValues(node).Set(float64(r.Hardware.Memtotal))
+		s.metrics.nodeMemFree.WithLabelValues(node).Set(float64(r.Hardware.Memfree))
+	}
+	s.metrics.nodeLoad.WithLabelValues(node, "1").Set(r.Load1)
+	s.metrics.nodeLoad.WithLabelValues(node, "5").Set(r.Load5)
+	s.metrics.nodeLoad.WithLabelValues(node, "15").Set(r.Load15)
+	s.metrics.nodeRingVersion.WithLabelValues(node).Set(float64(r.RingVersion))
+	s.metrics.nodeReportAge.WithLabelValues(node).Set(0)
+	s.metrics.nodeReportStale.WithLabelValues(node).Set(0)
+}
+
+//deleteDiskMetrics drops the disk metrics of disks in prev that are no longer in
+//the report that replaced it. A nil replacement drops them all.
+func (s *Server) deleteDiskMetrics(prev, r *pb.NodeStatusReport) {
+	if prev.Hardware == nil {
+		return
+	}
+	current := make(map[string]bool)
+	if r != nil && r.Hardware != nil {
+		for _, d := range r.Hardware.Disks {
+			current[d.Path] = true
+		}
+	}
+	node := fmt.Sprintf("%d", prev.Id)
+	for _, d := range prev.Hardware.Disks {
+		if !current[d.Path] {
+			s.metrics.nodeDiskUsed.DeleteLabelValues(node, d.Path)
+			s.metrics.nodeDiskFree.DeleteLabelValues(node, d.Path)
+		}
+	}
+}
+
+//forgetNodeReports drops the reports and metrics of nodes removed from the ring.
+func (s *Server) forgetNodeReports(nodes []uint64) {
+	s.reportLock.Lock()
+	defer s.reportLock.Unlock()
+	for _, id := range nodes {
+		nr, ok := s.reports[id]
+		if !ok {
+			continue
+		}
+		s.deleteDiskMetrics(nr.report, nil)
+		node := fmt.Sprintf("%d", id)
+		s.metrics.nodeMemTotal.DeleteLabelValues(node)
+		s.metrics.nodeMemFree.DeleteLabelValues(node)
+		for _, period := range []string{"1", "5", "15"} {
+			s.metrics.nodeLoad.DeleteLabelValues(node, period)
+		}
+		s.metrics.nodeRingVersion.DeleteLabelValues(node)
+		s.metrics.nodeReportAge.DeleteLabelValues(node)
+		s.metrics.nodeReportStale.DeleteLabelValues(node)
+		delete(s.reports, id)
+	}
+}
+
+//nodeReportManager periodically checks for nodes that have stopped reporting and
+//runs the capacity policy against the latest reports.
+func (s *Server) nodeReportManager() {
+	for {
+		time.Sleep(nodeReportCheckInterval)
+		now := time.Now()
+		s.checkNodeReports(now)
+		s.checkCapacityPolicy(now)
+	}
+}
+
+//checkNodeReports updates the age of every nodes last report, marking nodes that
+//haven't reported within NodeReportStaleAfter seconds as stale, and persists any
+//hardware profiles refreshed by reports since the last check.
+func (s *Server) checkNodeReports(now time.Time) {
+	staleAfter := time.Duration(s.cfg.NodeReportStaleAfter) * time.Second
+	s.reportLock.Lock()
+	for id, nr := range s.reports {
+		age := now.Sub(nr.received)
+		node := fmt.Sprintf("%d", id)
+		s.metrics.nodeReportAge.WithLabelValues(node).Set(age.Seconds())
+		if age > staleAfter && !nr.stale {
+			nr.stale = true
+			s.metrics.nodeReportStale.WithLabelValues(node).Set(1)
+			s.ctxlog.WithFields(log.Fields{"id": id, "last": nr.received}).Warning("node stopped sending status reports")
+		}
+	}
+	s.reportLock.Unlock()
+	s.flushHardware()
+}
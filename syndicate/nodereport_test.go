@@ -0,0 +1,121 @@
+package syndicate
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"golang.org/x/net/context"
+)
+
+func gaugeValue(t *testing.T, vec *prometheus.GaugeVec, labels ...string) float64 {
+	var m dto.Metric
+	if err := vec.WithLabelValues(labels...).Write(&m); err != nil {
+		t.Fatalf("unable to read metric %v: %s", labels, err)
+	}
+	return m.GetGauge().GetValue()
+}
+
+func TestServer_ReportNodeStatus(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	s.cfg.NodeReportStaleAfter = 60
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+	node := fmt.Sprintf("%d", id)
+
+	if _, err := s.ReportNodeStatus(ctx, &pb.NodeStatusReport{Id: 42}); err == nil {
+		t.Errorf("ReportNodeStatus for an unknown node should have returned an error")
+	}
+
+	r := &pb.NodeStatusReport{
+		Id:       id,
+		Hostname: "server1",
+		Hardware: &pb.HardwareProfile{
+			Memtotal: 4000,
+			Memfree:  1000,
+			Disks: []*pb.Disk{
+				&pb.Disk{Path: "/data", Size_: 1000, Used: 250},
+				&pb.Disk{Path: "/data2", Size_: 1000, Used: 900},
+			},
+		},
+		Load1:       1.5,
+		Load15:      0.5,
+		RingVersion: 7,
+	}
+	status, err := s.ReportNodeStatus(ctx, r)
+	if err != nil || !status.Status || status.Version != s.r.Version() {
+		t.Fatalf("ReportNodeStatus returned unexpected result: %v, %v", status, err)
+	}
+	expected := []struct {
+		vec    *prometheus.GaugeVec
+		labels []string
+		value  float64
+	}{
+		{s.metrics.nodeDiskUsed, []string{node, "/data"}, 250},
+		{s.metrics.nodeDiskFree, []string{node, "/data"}, 750},
+		{s.metrics.nodeDiskFree, []string{node, "/data2"}, 100},
+		{s.metrics.nodeMemTotal, []string{node}, 4000},
+		{s.metrics.nodeMemFree, []string{node}, 1000},
+		{s.metrics.nodeLoad, []string{node, "1"}, 1.5},
+		{s.metrics.nodeLoad, []string{node, "15"}, 0.5},
+		{s.metrics.nodeRingVersion, []string{node}, 7},
+		{s.metrics.nodeReportStale, []string{node}, 0},
+	}
+	for _, e := range expected {
+		if v := gaugeValue(t, e.vec, e.labels...); v != e.value {
+			t.Errorf("metric %v is %v, expected %v", e.labels, v, e.value)
+		}
+	}
+	//the report refreshes the stored hardware profile, persisted on the next check
+	if hw, err := s.GetNodeHardware(ctx, &pb.Node{Id: id}); err != nil || len(hw.Hardware.Disks) != 2 {
+		t.Errorf("GetNodeHardware should have returned the reported profile: %v, %v", hw, err)
+	}
+	if _, err := os.Stat(s.hardwarePath()); !os.IsNotExist(err) {
+		t.Errorf("hardware profiles shouldn't be persisted on every report")
+	}
+
+	//a node that stops reporting goes stale
+	s.checkNodeReports(time.Now().Add(30 * time.Second))
+	if v := gaugeValue(t, s.metrics.nodeReportStale, node); v != 0 {
+		t.Errorf("node shouldn't be stale after 30s")
+	}
+	if _, err := os.Stat(s.hardwarePath()); err != nil {
+		t.Errorf("refreshed hardware profiles should have been persisted: %s", err)
+	}
+	s.checkNodeReports(time.Now().Add(90 * time.Second))
+	if v := gaugeValue(t, s.metrics.nodeReportStale, node); v != 1 {
+		t.Errorf("node should be stale after 90s")
+	}
+	if v := gaugeValue(t, s.metrics.nodeReportAge, node); v < 90 {
+		t.Errorf("report age is %v, expected at least 90", v)
+	}
+
+	//reporting again clears it, and disks that went away are dropped
+	r = &pb.NodeStatusReport{
+		Id:       id,
+		Hostname: "server1",
+		Hardware: &pb.HardwareProfile{Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 1000, Used: 300}}},
+	}
+	if _, err := s.ReportNodeStatus(ctx, r); err != nil {
+		t.Fatalf("ReportNodeStatus returned unexpected error: %s", err)
+	}
+	if v := gaugeValue(t, s.metrics.nodeReportStale, node); v != 0 {
+		t.Errorf("node should no longer be stale")
+	}
+	if s.metrics.nodeDiskUsed.DeleteLabelValues(node, "/data2") {
+		t.Errorf("metrics for a disk no longer reported should have been dropped")
+	}
+
+	//removed nodes are forgotten
+	if _, err := s.RemoveNode(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("RemoveNode returned unexpected error: %s", err)
+	}
+	if _, ok := s.reports[id]; ok || s.metrics.nodeRingVersion.DeleteLabelValues(node) {
+		t.Errorf("removed node should have no report or metrics left")
+	}
+}
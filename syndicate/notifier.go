@@ -0,0 +1,212 @@
+package syndicate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	DefaultNotifierRetries    = 3    //The default number of times a failed notification is retried
+	DefaultNotifierRetryDelay = 1000 //The default milliseconds before the first retry, doubled on each retry
+	DefaultNotifierTimeout    = 5    //The default seconds a single notification POST may take
+	notifierQueueSize         = 100
+)
+
+const (
+	RingChangeApplied = "applied"
+	RingChangeFailed  = "failed"
+)
+
+//NotifierConfig configures a webhook that's sent a RingChangeSummary for ring changes.
+type NotifierConfig struct {
+	URL string
+	//Events is the ring change statuses to notify on ("applied" and/or "failed"),
+	//empty means all of them.
+	Events []string
+	//Retries is the number of times a failed POST is retried, 0 means a single
+	//attempt. Left unset it defaults to DefaultNotifierRetries.
+	Retries *int
+	//RetryDelay is the number of milliseconds to wait before the first retry,
+	//the delay is doubled after each retry.
+	RetryDelay int
+	//Timeout is the number of seconds a single POST may take.
+	Timeout int
+}
+
+//RingChangeSummary is the JSON body POSTed to notifiers for a ring change.
+type RingChangeSummary struct {
+	Service         string    `json:"service"`
+	Status          string    `json:"status"`
+	Type            string    `json:"type"`
+	Version         int64     `json:"version"`
+	PreviousVersion int64     `json:"previous_version"`
+	Nodes           []uint64  `json:"nodes"`
+	Caller          string    `json:"caller"`
+	Error           string    `json:"error,omitempty"`
+	Time            time.Time `json:"time"`
+}
+
+//webhookNotifier POSTs ring change summaries to a single URL. Summaries are queued
+//and delivered in order by run so a slow or down endpoint never holds up a ring change.
+type webhookNotifier struct {
+	cfg     NotifierConfig
+	retries int
+	events  map[string]bool
+	client  *http.Client
+	queue   chan *RingChangeSummary
+	ctxlog  *log.Entry
+}
+
+func newWebhookNotifier(cfg NotifierConfig, ctxlog *log.Entry) (*webhookNotifier, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid notifier url %q: %s", cfg.URL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("Invalid notifier url %q: must be an http or https url", cfg.URL)
+	}
+	n := &webhookNotifier{
+		cfg:     cfg,
+		retries: DefaultNotifierRetries,
+		events:  make(map[string]bool),
+		queue:   make(chan *RingChangeSummary, notifierQueueSize),
+		ctxlog:  ctxlog.WithField("notifier", cfg.URL),
+	}
+	for _, e := range cfg.Events {
+		if e != RingChangeApplied && e != RingChangeFailed {
+			return nil, fmt.Errorf("Invalid notifier event %q for %s", e, cfg.URL)
+		}
+		n.events[e] = true
+	}
+	if cfg.Retries != nil {
+		if *cfg.Retries < 0 {
+			return nil, fmt.Errorf("Invalid notifier retries %d for %s", *cfg.Retries, cfg.URL)
+		}
+		n.retries = *cfg.Retries
+	}
+	if n.cfg.RetryDelay == 0 {
+		n.cfg.RetryDelay = DefaultNotifierRetryDelay
+	}
+	if n.cfg.Timeout == 0 {
+		n.cfg.Timeout = DefaultNotifierTimeout
+	}
+	n.client = &http.Client{Timeout: time.Duration(n.cfg.Timeout) * time.Second}
+	return n, nil
+}
+
+//wants returns true if the notifier is configured for changes with the given status.
+func (n *webhookNotifier) wants(status string) bool {
+	return len(n.events) == 0 || n.events[status]
+}
+
+//enqueue queues the summary for delivery, dropping it if the queue is full.
+func (n *webhookNotifier) enqueue(summary *RingChangeSummary) {
+	select {
+	case n.queue <- summary:
+	default:
+		n.ctxlog.WithField("ringver", summary.Version).Warning("notifier queue full, dropping ring change notification")
+	}
+}
+
+func (n *webhookNotifier) run() {
+	for summary := range n.queue {
+		if err := n.deliver(summary); err != nil {
+			n.ctxlog.WithFields(log.Fields{"ringver": summary.Version, "err": err}).Error("failed to deliver ring change notification")
+		}
+	}
+}
+
+//deliver POSTs the summary, retrying with backoff until it gets a 2xx response
+//or runs out of retries.
+func (n *webhookNotifier) deliver(summary *RingChangeSummary) error {
+	body, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	delay := time.Duration(n.cfg.RetryDelay) * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		err = n.post(body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= n.retries {
+			return fmt.Errorf("Gave up after %d attempts: %s", attempt+1, err)
+		}
+		n.ctxlog.WithFields(log.Fields{"attempt": attempt + 1, "err": err}).Debug("ring change notification failed, retrying")
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (n *webhookNotifier) post(body []byte) error {
+	resp, err := n.client.Post(n.cfg.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
+
+//startNotifiers sets up and starts the configured notifiers.
+func (s *Server) startNotifiers() error {
+	for _, cfg := range s.cfg.Notifiers {
+		n, err := newWebhookNotifier(cfg, s.ctxlog)
+		if err != nil {
+			return err
+		}
+		s.notifiers = append(s.notifiers, n)
+		go n.run()
+	}
+	return nil
+}
+
+//notifyRingChange queues a summary of the ring change on the notifiers that want
+//it. prev is the ring version active when the change was attempted and err is the
+//error the change failed with, if any.
+func (s *Server) notifyRingChange(c *RingChange, prev int64, err error) {
+	if len(s.notifiers) == 0 {
+		return
+	}
+	summary := &RingChangeSummary{
+		Service:         s.servicename,
+		Status:          RingChangeApplied,
+		Type:            c.kind,
+		Version:         c.v,
+		PreviousVersion: prev,
+		Nodes:           c.nodes,
+		Caller:          c.caller,
+		Time:            time.Now().UTC(),
+	}
+	if summary.Nodes == nil {
+		summary.Nodes = []uint64{}
+	}
+	if err != nil {
+		summary.Status = RingChangeFailed
+		summary.Error = err.Error()
+	}
+	for _, n := range s.notifiers {
+		if n.wants(summary.Status) {
+			n.enqueue(summary)
+		}
+	}
+}
+
+//callerFromContext returns the address of the peer that made the request, or
+//"unknown" if it isn't available.
+func callerFromContext(c context.Context) string {
+	if p, ok := peer.FromContext(c); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
@@ -0,0 +1,228 @@
+package syndicate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+)
+
+//notifierEndpoint is an httptest server that fails the first failures POSTs it
+//gets and hands the summaries from the rest to the test.
+type notifierEndpoint struct {
+	sync.Mutex
+	*httptest.Server
+	failures  int
+	attempts  int
+	summaries chan *RingChangeSummary
+}
+
+func newNotifierEndpoint(failures int) *notifierEndpoint {
+	e := &notifierEndpoint{failures: failures, summaries: make(chan *RingChangeSummary, 10)}
+	e.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e.Lock()
+		e.attempts++
+		fail := e.attempts <= e.failures
+		e.Unlock()
+		if fail {
+			http.Error(w, "nope", http.StatusServiceUnavailable)
+			return
+		}
+		summary := &RingChangeSummary{}
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(summary); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		e.summaries <- summary
+	}))
+	return e
+}
+
+func (e *notifierEndpoint) attemptCount() int {
+	e.Lock()
+	defer e.Unlock()
+	return e.attempts
+}
+
+func (e *notifierEndpoint) next(t *testing.T) *RingChangeSummary {
+	select {
+	case summary := <-e.summaries:
+		return summary
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for ring change notification")
+	}
+	return nil
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+
+	n, err := newWebhookNotifier(NotifierConfig{URL: "https://hooks.example.com/ring"}, s.ctxlog)
+	if err != nil {
+		t.Fatalf("newWebhookNotifier returned unexpected error: %s", err)
+	}
+	if n.retries != DefaultNotifierRetries || n.cfg.RetryDelay != DefaultNotifierRetryDelay || n.cfg.Timeout != DefaultNotifierTimeout {
+		t.Errorf("newWebhookNotifier didn't apply defaults: %#v", n.cfg)
+	}
+	if !n.wants(RingChangeApplied) || !n.wants(RingChangeFailed) {
+		t.Errorf("notifier without events should want all ring changes")
+	}
+
+	n, err = newWebhookNotifier(NotifierConfig{URL: "http://hooks.example.com/ring", Events: []string{RingChangeFailed}}, s.ctxlog)
+	if err != nil {
+		t.Fatalf("newWebhookNotifier returned unexpected error: %s", err)
+	}
+	if n.wants(RingChangeApplied) || !n.wants(RingChangeFailed) {
+		t.Errorf("notifier should only want failed ring changes")
+	}
+
+	//zero retries is a single attempt rather than the default
+	n, err = newWebhookNotifier(NotifierConfig{URL: "http://hooks.example.com/ring", Retries: intPtr(0)}, s.ctxlog)
+	if err != nil || n.retries != 0 {
+		t.Errorf("newWebhookNotifier with zero retries returned unexpected result: %#v, %v", n, err)
+	}
+
+	bad := []NotifierConfig{
+		{URL: ""},
+		{URL: "hooks.example.com/ring"},
+		{URL: "ftp://hooks.example.com/ring"},
+		{URL: "http://"},
+		{URL: "http://hooks.example.com/ring", Events: []string{"exploded"}},
+		{URL: "http://hooks.example.com/ring", Retries: intPtr(-1)},
+	}
+	for _, cfg := range bad {
+		if _, err := newWebhookNotifier(cfg, s.ctxlog); err == nil {
+			t.Errorf("newWebhookNotifier(%#v) should have returned an error", cfg)
+		}
+	}
+}
+
+func TestWebhookNotifierDeliver(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	summary := &RingChangeSummary{Service: "test", Status: RingChangeApplied, Type: "SetActive", Version: 2, PreviousVersion: 1, Nodes: []uint64{42}}
+
+	//succeeds after retrying
+	e := newNotifierEndpoint(2)
+	defer e.Close()
+	n, err := newWebhookNotifier(NotifierConfig{URL: e.URL, Retries: intPtr(3), RetryDelay: 1}, s.ctxlog)
+	if err != nil {
+		t.Fatalf("newWebhookNotifier returned unexpected error: %s", err)
+	}
+	if err := n.deliver(summary); err != nil {
+		t.Fatalf("deliver should have succeeded after retrying: %s", err)
+	}
+	if e.attemptCount() != 3 {
+		t.Errorf("deliver made %d attempts, expected 3", e.attemptCount())
+	}
+	got := e.next(t)
+	if got.Type != "SetActive" || got.Version != 2 || got.PreviousVersion != 1 || len(got.Nodes) != 1 || got.Nodes[0] != 42 {
+		t.Errorf("endpoint got unexpected summary: %#v", got)
+	}
+
+	//runs out of retries
+	e2 := newNotifierEndpoint(100)
+	defer e2.Close()
+	n, err = newWebhookNotifier(NotifierConfig{URL: e2.URL, Retries: intPtr(2), RetryDelay: 1}, s.ctxlog)
+	if err != nil {
+		t.Fatalf("newWebhookNotifier returned unexpected error: %s", err)
+	}
+	if err := n.deliver(summary); err == nil {
+		t.Errorf("deliver should have failed once out of retries")
+	}
+	if e2.attemptCount() != 3 {
+		t.Errorf("deliver made %d attempts, expected 3", e2.attemptCount())
+	}
+
+	//zero retries is a single attempt
+	e3 := newNotifierEndpoint(100)
+	defer e3.Close()
+	single, err := newWebhookNotifier(NotifierConfig{URL: e3.URL, Retries: intPtr(0), RetryDelay: 1}, s.ctxlog)
+	if err != nil {
+		t.Fatalf("newWebhookNotifier returned unexpected error: %s", err)
+	}
+	if err := single.deliver(summary); err == nil || e3.attemptCount() != 1 {
+		t.Errorf("deliver without retries made %d attempts, expected 1: %v", e3.attemptCount(), err)
+	}
+
+	//endpoint isn't there at all
+	e2.Close()
+	if err := n.deliver(summary); err == nil {
+		t.Errorf("deliver to a closed endpoint should have failed")
+	}
+}
+
+func TestServer_NotifyRingChange(t *testing.T) {
+	s, m := newTestServerWithDefaults()
+	all := newNotifierEndpoint(0)
+	defer all.Close()
+	failed := newNotifierEndpoint(0)
+	defer failed.Close()
+	s.cfg.Notifiers = []NotifierConfig{
+		{URL: all.URL, RetryDelay: 1},
+		{URL: failed.URL, RetryDelay: 1, Events: []string{RingChangeFailed}},
+	}
+	if err := s.startNotifiers(); err != nil {
+		t.Fatalf("startNotifiers returned unexpected error: %s", err)
+	}
+
+	caller := &net.TCPAddr{IP: net.ParseIP("10.0.0.9"), Port: 31337}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: caller})
+	id := s.r.Nodes()[0].ID()
+	origVersion := s.r.Version()
+
+	r, err := s.SetActive(ctx, &pb.Node{Id: id, Active: false})
+	if err != nil {
+		t.Fatalf("SetActive returned unexpected error: %s", err)
+	}
+	got := all.next(t)
+	if got.Service != "test" || got.Status != RingChangeApplied || got.Type != "SetActive" || got.Error != "" {
+		t.Errorf("unexpected applied summary: %#v", got)
+	}
+	if got.Version != r.Version || got.PreviousVersion != origVersion {
+		t.Errorf("applied summary has versions %d (previous %d), expected %d (previous %d)", got.Version, got.PreviousVersion, r.Version, origVersion)
+	}
+	if len(got.Nodes) != 1 || got.Nodes[0] != id || got.Caller != caller.String() {
+		t.Errorf("applied summary has nodes %v and caller %s, expected [%d] and %s", got.Nodes, got.Caller, id, caller)
+	}
+
+	m.persistBuilderErr = fmt.Errorf("persist builder oops")
+	if _, err = s.SetReplicas(ctx, &pb.RingOpts{Replicas: 2}); err == nil {
+		t.Fatalf("SetReplicas should have failed")
+	}
+	m.persistBuilderErr = nil
+	for _, e := range []*notifierEndpoint{all, failed} {
+		got = e.next(t)
+		if got.Status != RingChangeFailed || got.Type != "SetReplicas" || got.Error != "persist builder oops" {
+			t.Errorf("unexpected failed summary: %#v", got)
+		}
+		if got.PreviousVersion != s.r.Version() || got.Version == s.r.Version() || len(got.Nodes) != 0 {
+			t.Errorf("unexpected failed summary: %#v", got)
+		}
+	}
+
+	//the failed only notifier should never have seen the applied change
+	select {
+	case got = <-failed.summaries:
+		t.Errorf("failed only notifier got unexpected summary: %#v", got)
+	default:
+	}
+	if failed.attemptCount() != 1 {
+		t.Errorf("failed only notifier got %d POSTs, expected 1", failed.attemptCount())
+	}
+}
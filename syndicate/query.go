@@ -0,0 +1,463 @@
+package syndicate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gholt/ring"
+)
+
+//nodeQuery is a parsed QueryNodes query. The query syntax is:
+//
+//  [expression] [sort <field> [asc|desc]] [limit <n>] [with partitions]
+//
+//An expression is made up of terms combined with and, or, not (or &&, ||, !)
+//and grouped with parentheses. Adjacent terms without an operator are and'd.
+//A term is <key><op><value> where key is one of:
+//
+//  id, active, capacity, partitions, meta, tier, tierN, address, addressN
+//
+//tier and address match any of a nodes tiers or addresses, tierN and addressN
+//only match the given level or index. Valid ops are = and != for every key,
+//~= and !~ (regex) for meta, tier and address keys, and > >= < <= for id,
+//capacity and partitions. A limit must be at least 1. Values containing spaces,
+//parentheses or operator characters must be double quoted. Example:
+//
+//  active=true and (capacity>=500 or tier1~=^rack1) sort capacity desc limit 10
+type nodeQuery struct {
+	expr       queryExpr
+	sortField  string
+	sortDesc   bool
+	limit      int
+	partitions bool
+	// needPartitions is set if the expression or sort requires partition counts
+	needPartitions bool
+}
+
+type queryExpr interface {
+	match(n ring.Node, partitions map[uint64]uint64) bool
+}
+
+type queryAnd struct{ l, r queryExpr }
+type queryOr struct{ l, r queryExpr }
+type queryNot struct{ e queryExpr }
+
+func (q *queryAnd) match(n ring.Node, p map[uint64]uint64) bool {
+	return q.l.match(n, p) && q.r.match(n, p)
+}
+
+func (q *queryOr) match(n ring.Node, p map[uint64]uint64) bool {
+	return q.l.match(n, p) || q.r.match(n, p)
+}
+
+func (q *queryNot) match(n ring.Node, p map[uint64]uint64) bool {
+	return !q.e.match(n, p)
+}
+
+type queryTerm struct {
+	key   string
+	index int // tier level or address index, -1 means any
+	op    string
+	value string
+	num   uint64
+	b     bool
+	re    *regexp.Regexp
+}
+
+func (q *queryTerm) match(n ring.Node, p map[uint64]uint64) bool {
+	switch q.key {
+	case "id":
+		return q.matchNum(n.ID())
+	case "capacity":
+		return q.matchNum(uint64(n.Capacity()))
+	case "partitions":
+		return q.matchNum(p[n.ID()])
+	case "active":
+		if q.op == "!=" {
+			return n.Active() != q.b
+		}
+		return n.Active() == q.b
+	case "meta":
+		return q.matchStrings([]string{n.Meta()})
+	case "tier":
+		if q.index >= 0 {
+			return q.matchStrings([]string{n.Tier(q.index)})
+		}
+		return q.matchStrings(n.Tiers())
+	case "address":
+		if q.index >= 0 {
+			return q.matchStrings([]string{n.Address(q.index)})
+		}
+		return q.matchStrings(n.Addresses())
+	}
+	return false
+}
+
+func (q *queryTerm) matchNum(v uint64) bool {
+	switch q.op {
+	case "=":
+		return v == q.num
+	case "!=":
+		return v != q.num
+	case ">":
+		return v > q.num
+	case ">=":
+		return v >= q.num
+	case "<":
+		return v < q.num
+	case "<=":
+		return v <= q.num
+	}
+	return false
+}
+
+//matchStrings returns true if any of the values match. For the negated ops
+//(!= and !~) it returns true only if none of the values match.
+func (q *queryTerm) matchStrings(values []string) bool {
+	found := false
+	for _, v := range values {
+		if q.re != nil {
+			found = q.re.MatchString(v)
+		} else {
+			found = v == q.value
+		}
+		if found {
+			break
+		}
+	}
+	if q.op == "!=" || q.op == "!~" {
+		return !found
+	}
+	return found
+}
+
+var (
+	queryNumericKeys = map[string]bool{"id": true, "capacity": true, "partitions": true}
+	queryStringKeys  = map[string]bool{"meta": true, "tier": true, "address": true}
+	querySortFields  = map[string]bool{"id": true, "capacity": true, "partitions": true, "meta": true, "active": true}
+)
+
+const queryOpChars = "=!~<>"
+
+//tokenizeQuery splits a query into words, quoted strings, parentheses and operators.
+//Quoted strings are returned with their quotes so they're never mistaken for keywords.
+func tokenizeQuery(q string) ([]string, error) {
+	var tokens []string
+	r := []rune(q)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			var v []rune
+			for ; j < len(r) && r[j] != '"'; j++ {
+				if r[j] == '\\' && j+1 < len(r) {
+					j++
+				}
+				v = append(v, r[j])
+			}
+			if j >= len(r) {
+				return nil, fmt.Errorf("unterminated quote in query")
+			}
+			tokens = append(tokens, "\""+string(v)+"\"")
+			i = j + 1
+		case c == '&' || c == '|':
+			if i+1 >= len(r) || r[i+1] != c {
+				return nil, fmt.Errorf("unexpected %q in query, did you mean %q", string(c), string([]rune{c, c}))
+			}
+			tokens = append(tokens, string([]rune{c, c}))
+			i += 2
+		case strings.ContainsRune(queryOpChars, c):
+			j := i
+			for j < len(r) && strings.ContainsRune(queryOpChars, r[j]) {
+				j++
+			}
+			tokens = append(tokens, string(r[i:j]))
+			i = j
+		default:
+			j := i
+			for j < len(r) && !unicode.IsSpace(r[j]) && !strings.ContainsRune("()\""+queryOpChars, r[j]) {
+				j++
+			}
+			tokens = append(tokens, string(r[i:j]))
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []string
+	pos    int
+	q      *nodeQuery
+}
+
+func (p *queryParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *queryParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *queryParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func isQueryKeyword(t, kw string) bool {
+	return strings.ToLower(t) == kw
+}
+
+//atOption reports whether the parser is at one of the trailing sort/limit/with options.
+func (p *queryParser) atOption() bool {
+	t := p.peek()
+	return isQueryKeyword(t, "sort") || isQueryKeyword(t, "limit") || isQueryKeyword(t, "with")
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for isQueryKeyword(p.peek(), "or") || p.peek() == "||" {
+		p.next()
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = &queryOr{l, r}
+	}
+	return l, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	l, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		switch {
+		case isQueryKeyword(t, "and") || t == "&&":
+			p.next()
+		case p.done() || t == ")" || isQueryKeyword(t, "or") || t == "||" || p.atOption():
+			return l, nil
+		}
+		r, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l = &queryAnd{l, r}
+	}
+}
+
+func (p *queryParser) parseNot() (queryExpr, error) {
+	t := p.peek()
+	switch {
+	case isQueryKeyword(t, "not") || t == "!":
+		p.next()
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNot{e}, nil
+	case t == "(":
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return e, nil
+	}
+	return p.parseTerm()
+}
+
+func (p *queryParser) parseTerm() (queryExpr, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	key := strings.ToLower(p.next())
+	op := p.next()
+	if op == "" || !strings.ContainsRune(queryOpChars, rune(op[0])) {
+		return nil, fmt.Errorf("invalid expression %q; expected an operator after key", key)
+	}
+	value := p.next()
+	if value == "" || value == "(" || value == ")" {
+		return nil, fmt.Errorf("invalid expression %q; nothing was right of %q", key+op, op)
+	}
+	if strings.HasPrefix(value, "\"") {
+		value = value[1 : len(value)-1]
+	}
+	term := &queryTerm{key: key, index: -1, op: op, value: value}
+	for _, k := range []string{"tier", "address"} {
+		if strings.HasPrefix(key, k) && key != k {
+			i, err := strconv.Atoi(key[len(k):])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid expression %q; %q doesn't specify a valid number", key+op+value, key[len(k):])
+			}
+			term.key = k
+			term.index = i
+		}
+	}
+	switch {
+	case queryNumericKeys[term.key]:
+		switch op {
+		case "=", "!=", ">", ">=", "<", "<=":
+		default:
+			return nil, fmt.Errorf("invalid expression %q; %s only supports = != > >= < <=", key+op+value, term.key)
+		}
+		n, err := strconv.ParseUint(value, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %q; %s", key+op+value, err)
+		}
+		term.num = n
+		if term.key == "partitions" {
+			p.q.needPartitions = true
+		}
+	case term.key == "active":
+		if op != "=" && op != "!=" {
+			return nil, fmt.Errorf("invalid expression %q; active only supports = !=", key+op+value)
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %q; active must be true or false", key+op+value)
+		}
+		term.b = b
+	case queryStringKeys[term.key]:
+		switch op {
+		case "=", "!=":
+		case "~=", "!~":
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expression %q; %s", key+op+value, err)
+			}
+			term.re = re
+		default:
+			return nil, fmt.Errorf("invalid expression %q; %s only supports = != ~= !~", key+op+value, term.key)
+		}
+	default:
+		return nil, fmt.Errorf("unknown key %q", key)
+	}
+	return term, nil
+}
+
+func (p *queryParser) parseOptions() error {
+	for !p.done() {
+		switch t := strings.ToLower(p.next()); t {
+		case "sort":
+			field := strings.ToLower(p.next())
+			if !querySortFields[field] {
+				return fmt.Errorf("invalid sort field %q", field)
+			}
+			p.q.sortField = field
+			if field == "partitions" {
+				p.q.needPartitions = true
+			}
+			switch strings.ToLower(p.peek()) {
+			case "desc":
+				p.q.sortDesc = true
+				p.next()
+			case "asc":
+				p.next()
+			}
+		case "limit":
+			v := p.next()
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid limit %q", v)
+			}
+			p.q.limit = n
+		case "with":
+			if v := p.next(); !isQueryKeyword(v, "partitions") {
+				return fmt.Errorf("unknown option \"with %s\"", v)
+			}
+			p.q.partitions = true
+			p.q.needPartitions = true
+		default:
+			return fmt.Errorf("unexpected %q in query", t)
+		}
+	}
+	return nil
+}
+
+//parseNodeQuery parses a QueryNodes query string. An empty query matches all nodes.
+func parseNodeQuery(query string) (*nodeQuery, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens, q: &nodeQuery{}}
+	if !p.done() && !p.atOption() {
+		p.q.expr, err = p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := p.parseOptions(); err != nil {
+		return nil, err
+	}
+	return p.q, nil
+}
+
+type queryResultSorter struct {
+	nodes      []ring.Node
+	q          *nodeQuery
+	partitions map[uint64]uint64
+}
+
+func (s *queryResultSorter) Len() int      { return len(s.nodes) }
+func (s *queryResultSorter) Swap(i, j int) { s.nodes[i], s.nodes[j] = s.nodes[j], s.nodes[i] }
+func (s *queryResultSorter) Less(i, j int) bool {
+	if s.q.sortDesc {
+		i, j = j, i
+	}
+	a, b := s.nodes[i], s.nodes[j]
+	switch s.q.sortField {
+	case "capacity":
+		return a.Capacity() < b.Capacity()
+	case "partitions":
+		return s.partitions[a.ID()] < s.partitions[b.ID()]
+	case "meta":
+		return a.Meta() < b.Meta()
+	case "active":
+		return !a.Active() && b.Active()
+	}
+	return a.ID() < b.ID()
+}
+
+//run returns the nodes matching the query, sorted and limited as requested.
+func (q *nodeQuery) run(nodes ring.NodeSlice, partitions map[uint64]uint64) []ring.Node {
+	var matches []ring.Node
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		if q.expr == nil || q.expr.match(n, partitions) {
+			matches = append(matches, n)
+		}
+	}
+	if q.sortField != "" {
+		sort.Stable(&queryResultSorter{nodes: matches, q: q, partitions: partitions})
+	}
+	if q.limit > 0 && len(matches) > q.limit {
+		matches = matches[:q.limit]
+	}
+	return matches
+}
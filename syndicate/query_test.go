@@ -0,0 +1,158 @@
+package syndicate
+
+import (
+	"testing"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func getQueryTestRing() ring.Ring {
+	b := ring.NewBuilder(64)
+	b.SetReplicaCount(3)
+	b.AddNode(true, 100, []string{"server1", "rack1", "row1"}, []string{"10.0.0.1:4443", "10.0.0.1:8001"}, "server1", []byte(""))
+	b.AddNode(true, 500, []string{"server2", "rack1", "row1"}, []string{"10.0.0.2:4443", "10.0.0.2:8001"}, "server2", []byte(""))
+	b.AddNode(false, 1000, []string{"server3", "rack2", "row1"}, []string{"10.0.0.3:4443", "10.0.0.3:8001"}, "server3", []byte(""))
+	b.AddNode(true, 1000, []string{"server4", "rack3", "row2"}, []string{"10.0.0.4:4443", "10.0.0.4:8001"}, "other server", []byte(""))
+	return b.Ring()
+}
+
+func queryMetas(t *testing.T, r ring.Ring, query string) []string {
+	q, err := parseNodeQuery(query)
+	if err != nil {
+		t.Fatalf("parseNodeQuery(%q) returned unexpected error: %s", query, err)
+	}
+	var counts map[uint64]uint64
+	if q.needPartitions {
+		counts = partitionCounts(r)
+	}
+	var metas []string
+	for _, n := range q.run(r.Nodes(), counts) {
+		metas = append(metas, n.Meta())
+	}
+	return metas
+}
+
+func TestParseNodeQuery(t *testing.T) {
+	r := getQueryTestRing()
+
+	tests := map[string][]string{
+		"":                                []string{"server1", "server2", "server3", "other server"},
+		"active=true":                     []string{"server1", "server2", "other server"},
+		"active!=true":                    []string{"server3"},
+		"capacity>500":                    []string{"server3", "other server"},
+		"capacity>=100 and capacity<=500": []string{"server1", "server2"},
+		"capacity>=100 && capacity<1000":  []string{"server1", "server2"},
+		"tier1=rack1 or tier1=rack3":      []string{"server1", "server2", "other server"},
+		"tier1=rack1 || tier=rack3":       []string{"server1", "server2", "other server"},
+		"not tier2=row1":                  []string{"other server"},
+		"!(tier2=row1)":                   []string{"other server"},
+		"active=true not tier1=rack1":     []string{"other server"},
+		"active=true and (tier1=rack2 or tier=row2)": []string{"other server"},
+		"tier~=^rack[12]$ active=true":               []string{"server1", "server2"},
+		"tier!~rack1":                                []string{"server3", "other server"},
+		"meta=\"other server\"":                      []string{"other server"},
+		"address~=10\\.0\\.0\\.[34]:":                []string{"server3", "other server"},
+		"address1=10.0.0.2:8001":                     []string{"server2"},
+		"sort capacity desc limit 2":                 []string{"server3", "other server"},
+		"active=true sort meta":                      []string{"other server", "server1", "server2"},
+		"capacity=1000 sort active limit 1":          []string{"server3"},
+	}
+	for query, expected := range tests {
+		metas := queryMetas(t, r, query)
+		if len(metas) != len(expected) {
+			t.Errorf("query %q returned %v, expected %v", query, metas, expected)
+			continue
+		}
+		for i := range metas {
+			if metas[i] != expected[i] {
+				t.Errorf("query %q returned %v, expected %v", query, metas, expected)
+				break
+			}
+		}
+	}
+
+	bad := []string{
+		"nope=1",
+		"capacity~=1",
+		"capacity>lots",
+		"active=maybe",
+		"active>true",
+		"meta>foo",
+		"tierX=foo",
+		"meta~=[",
+		"meta=",
+		"(active=true",
+		"active=true and",
+		"meta=\"unterminated",
+		"active=true & capacity=1",
+		"sort nope",
+		"limit -1",
+		"limit 0",
+		"with stuff",
+		"active",
+	}
+	for _, query := range bad {
+		if _, err := parseNodeQuery(query); err == nil {
+			t.Errorf("parseNodeQuery(%q) should have returned an error", query)
+		}
+	}
+}
+
+func TestNodeQueryPartitions(t *testing.T) {
+	r := getQueryTestRing()
+	counts := partitionCounts(r)
+	var total uint64
+	for _, c := range counts {
+		total += c
+	}
+	if expected := uint64(r.ReplicaCount()) << r.PartitionBitCount(); total != expected {
+		t.Errorf("partitionCounts() assigned %d partition replicas, expected %d", total, expected)
+	}
+
+	q, err := parseNodeQuery("partitions>0 sort partitions desc with partitions")
+	if err != nil {
+		t.Fatalf("parseNodeQuery returned unexpected error: %s", err)
+	}
+	if !q.partitions || !q.needPartitions {
+		t.Errorf("parseNodeQuery should have requested partition counts: %#v", q)
+	}
+	nodes := q.run(r.Nodes(), counts)
+	for i, n := range nodes {
+		if counts[n.ID()] == 0 {
+			t.Errorf("node %d has no partitions but matched partitions>0", n.ID())
+		}
+		if i > 0 && counts[nodes[i-1].ID()] < counts[n.ID()] {
+			t.Errorf("nodes not sorted by partitions desc: %d < %d", counts[nodes[i-1].ID()], counts[n.ID()])
+		}
+	}
+}
+
+func TestServer_QueryNodes(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	ctx := context.Background()
+
+	res, err := s.QueryNodes(ctx, &pb.NodeQuery{Query: "meta~=^server1 with partitions"})
+	if err != nil {
+		t.Fatalf("QueryNodes returned unexpected error: %s", err)
+	}
+	if res.Version != s.r.Version() || !res.PartitionCounts {
+		t.Errorf("QueryNodes returned unexpected result: %#v", res)
+	}
+	if len(res.Nodes) != 1 || res.Nodes[0].Node.Meta != "server1|meta one" {
+		t.Fatalf("QueryNodes returned unexpected nodes: %v", res.Nodes)
+	}
+	if res.Nodes[0].Partitions != partitionCounts(s.r)[res.Nodes[0].Node.Id] {
+		t.Errorf("QueryNodes returned unexpected partition count: %d", res.Nodes[0].Partitions)
+	}
+
+	res, err = s.QueryNodes(ctx, &pb.NodeQuery{Query: ""})
+	if err != nil || len(res.Nodes) != len(s.r.Nodes()) || res.PartitionCounts {
+		t.Errorf("QueryNodes with an empty query should have returned all nodes: %#v, %v", res, err)
+	}
+
+	if _, err = s.QueryNodes(ctx, &pb.NodeQuery{Query: "capacity>"}); err == nil {
+		t.Errorf("QueryNodes with an invalid query should have returned an error")
+	}
+}
@@ -0,0 +1,371 @@
+package syndicate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	DefaultRampSteps           = 10  //By default a ramp gets to its target in 10 steps
+	DefaultRampInterval        = 300 //The default seconds between ramp steps
+	DefaultRampConvergeTimeout = 600 //The default seconds managed nodes have to pick up a ramp step
+)
+
+var (
+	//rampIntervalUnit is the unit ramp intervals and converge timeouts are given in.
+	rampIntervalUnit = time.Second
+	//rampPollInterval is how often managed nodes are checked while waiting for a
+	//ramp step to converge.
+	rampPollInterval = time.Second
+
+	RampInProgress = errors.New("A capacity ramp is already in progress for the node")
+	NoRamp         = errors.New("No capacity ramp in progress for the node")
+)
+
+//capacityRamp moves a nodes capacity to a target in steps, each step its own ring
+//version. Steps are separated by the interval and, if requested, by every managed
+//node picking up the new ring.
+type capacityRamp struct {
+	sync.Mutex
+	s               *Server
+	status          *pb.RampStatus
+	interval        time.Duration
+	convergeTimeout time.Duration
+	changed         chan struct{} //closed and replaced whenever the status changes
+	done            chan struct{} //closed once the ramp has stopped running
+	ctxlog          *log.Entry
+}
+
+//RampCapacity starts moving a nodes capacity from its current value to the target
+//in steps of step capacity. Only one ramp may run per node at a time, and nodes
+//being drained for maintenance can't be ramped.
+func (s *Server) RampCapacity(c context.Context, r *pb.RampRequest) (*pb.RampStatus, error) {
+	if r.Interval < 0 {
+		return &pb.RampStatus{}, fmt.Errorf("Invalid interval %d", r.Interval)
+	}
+	if r.ConvergeTimeout < 0 {
+		return &pb.RampStatus{}, fmt.Errorf("Invalid converge timeout %d", r.ConvergeTimeout)
+	}
+	capacity, err := s.nodeCapacity(r.Id)
+	if err != nil {
+		return &pb.RampStatus{}, err
+	}
+	if capacity == r.Target {
+		return &pb.RampStatus{}, fmt.Errorf("Node %d is already at capacity %d", r.Id, capacity)
+	}
+	//maintLock is taken before rampLock, as in SetMaintenance, so a ramp and a
+	//maintenance drain can't both be started for the node
+	s.maintLock.Lock()
+	defer s.maintLock.Unlock()
+	if m, ok := s.maintenance[r.Id]; ok && m.Status.Draining {
+		return &pb.RampStatus{}, DrainInProgress
+	}
+	s.rampLock.Lock()
+	defer s.rampLock.Unlock()
+	if ramp, ok := s.ramps[r.Id]; ok && !ramp.finished() {
+		return ramp.snapshot(), RampInProgress
+	}
+	diff := r.Target - capacity
+	if capacity > r.Target {
+		diff = capacity - r.Target
+	}
+	ramp := &capacityRamp{
+		s: s,
+		status: &pb.RampStatus{
+			Id:            r.Id,
+			State:         pb.RampState_RAMP_RUNNING,
+			Start:         capacity,
+			Target:        r.Target,
+			Step:          r.Step,
+			Interval:      r.Interval,
+			WaitConverged: r.WaitConverged,
+			Capacity:      capacity,
+			Caller:        callerFromContext(c),
+			Started:       time.Now().Unix(),
+		},
+		changed: make(chan struct{}),
+		done:    make(chan struct{}),
+		ctxlog:  s.ctxlog.WithField("ramp", r.Id),
+	}
+	if ramp.status.Step == 0 {
+		ramp.status.Step = (diff + DefaultRampSteps - 1) / DefaultRampSteps
+	}
+	if ramp.status.Interval == 0 {
+		ramp.status.Interval = DefaultRampInterval
+	}
+	ramp.status.Steps = (diff + ramp.status.Step - 1) / ramp.status.Step
+	ramp.interval = time.Duration(ramp.status.Interval) * rampIntervalUnit
+	ramp.convergeTimeout = time.Duration(r.ConvergeTimeout) * rampIntervalUnit
+	if ramp.convergeTimeout == 0 {
+		ramp.convergeTimeout = DefaultRampConvergeTimeout * rampIntervalUnit
+	}
+	if s.ramps == nil {
+		s.ramps = make(map[uint64]*capacityRamp)
+	}
+	s.ramps[r.Id] = ramp
+	ramp.ctxlog.WithFields(log.Fields{
+		"start":  capacity,
+		"target": r.Target,
+		"steps":  ramp.status.Steps,
+		"caller": ramp.status.Caller,
+	}).Info("starting capacity ramp")
+	go ramp.run()
+	return ramp.snapshot(), nil
+}
+
+//run applies the ramp steps until the target is reached, the ramp is aborted or
+//a step fails. Each step is taken from the nodes capacity in the current ring, so
+//changes made while the ramp was waiting are stepped from rather than undone.
+func (r *capacityRamp) run() {
+	for {
+		if !r.waitWhilePaused() {
+			r.ctxlog.Info("capacity ramp aborted")
+			r.finish(pb.RampState_RAMP_ABORTED, "")
+			return
+		}
+		r.Lock()
+		id, target, step := r.status.Id, r.status.Target, r.status.Step
+		r.Unlock()
+		capacity, err := r.s.nodeCapacity(id)
+		if err != nil {
+			r.ctxlog.WithField("err", err).Warning("capacity ramp step failed")
+			r.finish(pb.RampState_RAMP_FAILED, err.Error())
+			return
+		}
+		next := target
+		switch {
+		case capacity < target && target-capacity > step:
+			next = capacity + step
+		case capacity > target && capacity-target > step:
+			next = capacity - step
+		}
+		if err := r.s.changeNodeCapacity(id, next, "RampCapacity", r.status.Caller); err != nil {
+			r.ctxlog.WithFields(log.Fields{"capacity": next, "err": err}).Warning("capacity ramp step failed")
+			r.finish(pb.RampState_RAMP_FAILED, err.Error())
+			return
+		}
+		version := r.s.ringVersion()
+		r.update(func() {
+			r.status.Capacity = next
+			r.status.StepsDone++
+			r.status.RingVersion = version
+		})
+		r.ctxlog.WithFields(log.Fields{"capacity": next, "ringver": version}).Info("capacity ramp step applied")
+		if next == target {
+			r.ctxlog.Info("capacity ramp complete")
+			r.finish(pb.RampState_RAMP_COMPLETE, "")
+			return
+		}
+		if r.status.WaitConverged {
+			if err := r.s.waitConverged(version, r.convergeTimeout); err != nil {
+				r.ctxlog.WithField("err", err).Warning("capacity ramp step didn't converge")
+				r.finish(pb.RampState_RAMP_FAILED, err.Error())
+				return
+			}
+		}
+		r.sleep(r.interval)
+	}
+}
+
+//sleep waits until the next step is due, returning early if the ramp is aborted.
+func (r *capacityRamp) sleep(d time.Duration) {
+	deadline := time.Now().Add(d)
+	r.update(func() { r.status.NextStep = deadline.Unix() })
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	for {
+		r.Lock()
+		state, changed := r.status.State, r.changed
+		r.Unlock()
+		if state == pb.RampState_RAMP_ABORTED {
+			return
+		}
+		select {
+		case <-timer.C:
+			return
+		case <-changed:
+		}
+	}
+}
+
+//waitWhilePaused blocks while the ramp is paused. Returns false if the ramp has
+//been aborted.
+func (r *capacityRamp) waitWhilePaused() bool {
+	for {
+		r.Lock()
+		state, changed := r.status.State, r.changed
+		r.Unlock()
+		switch state {
+		case pb.RampState_RAMP_ABORTED:
+			return false
+		case pb.RampState_RAMP_PAUSED:
+			<-changed
+		default:
+			return true
+		}
+	}
+}
+
+//update applies fn to the status and wakes up anyone waiting on it.
+func (r *capacityRamp) update(fn func()) {
+	r.Lock()
+	defer r.Unlock()
+	fn()
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *capacityRamp) finish(state pb.RampState, err string) {
+	r.update(func() {
+		r.status.State = state
+		r.status.Error = err
+		r.status.NextStep = 0
+		r.status.Finished = time.Now().Unix()
+		close(r.done)
+	})
+}
+
+//setState moves a running or paused ramp to the given state.
+func (r *capacityRamp) setState(state pb.RampState) error {
+	var err error
+	r.update(func() {
+		switch r.status.State {
+		case pb.RampState_RAMP_RUNNING, pb.RampState_RAMP_PAUSED:
+			r.status.State = state
+		default:
+			err = NoRamp
+		}
+	})
+	return err
+}
+
+func (r *capacityRamp) finished() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *capacityRamp) snapshot() *pb.RampStatus {
+	r.Lock()
+	defer r.Unlock()
+	status := *r.status
+	return &status
+}
+
+func (s *Server) ringVersion() int64 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.r.Version()
+}
+
+//waitConverged waits for every managed node to have picked up at least the given
+//ring version. Nodes that are behind are sent the current ring on every poll, as
+//they may have missed the update (or, after a synd restart, never been sent one).
+//Nodes that don't answer a Ping, or whose backend is being stopped by a
+//decommission, are left out as they'd never catch up.
+func (s *Server) waitConverged(version int64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		s.RLock()
+		rb, current := s.rb, s.r.Version()
+		s.RUnlock()
+		var behind, unreachable, stopped int
+		for id, n := range s.managedNodesSnapshot() {
+			if n.RingVersion() >= version {
+				continue
+			}
+			if s.backendDecommissioned(id) {
+				stopped++
+				continue
+			}
+			if ok, _, err := n.Ping(); err != nil || !ok {
+				unreachable++
+				continue
+			}
+			if _, err := n.RingUpdate(rb, current); err != nil {
+				s.ctxlog.WithFields(log.Fields{"nodeid": id, "err": err}).Debug("ring update for lagging node failed")
+			}
+			if n.RingVersion() < version {
+				behind++
+			}
+		}
+		if behind == 0 {
+			if unreachable != 0 || stopped != 0 {
+				s.ctxlog.WithFields(log.Fields{"ringver": version, "unreachable": unreachable, "decommissioned": stopped}).Info("converged, skipping nodes that are down")
+			}
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%d managed nodes hadn't picked up ring version %d after %s (skipped %d unreachable and %d decommissioned nodes)", behind, version, timeout, unreachable, stopped)
+		}
+		time.Sleep(rampPollInterval)
+	}
+}
+
+//forgetNodeRamps drops the ramps of nodes that have been removed from the ring.
+//A ramp still running for one fails on its next step.
+func (s *Server) forgetNodeRamps(nodes []uint64) {
+	s.rampLock.Lock()
+	defer s.rampLock.Unlock()
+	for _, id := range nodes {
+		delete(s.ramps, id)
+	}
+}
+
+//GetRampStatus returns the status of the running ramps and the last ramp run on
+//each node, ordered by node id.
+func (s *Server) GetRampStatus(c context.Context, e *pb.EmptyMsg) (*pb.RampList, error) {
+	s.rampLock.Lock()
+	defer s.rampLock.Unlock()
+	res := &pb.RampList{}
+	for _, ramp := range s.ramps {
+		res.Ramps = append(res.Ramps, ramp.snapshot())
+	}
+	sort.Sort(rampStatusByID(res.Ramps))
+	return res, nil
+}
+
+//PauseRamp stops the nodes ramp from applying any more steps.
+func (s *Server) PauseRamp(c context.Context, n *pb.Node) (*pb.RampStatus, error) {
+	return s.controlRamp(c, n.Id, pb.RampState_RAMP_PAUSED)
+}
+
+//ResumeRamp resumes a paused ramp.
+func (s *Server) ResumeRamp(c context.Context, n *pb.Node) (*pb.RampStatus, error) {
+	return s.controlRamp(c, n.Id, pb.RampState_RAMP_RUNNING)
+}
+
+//AbortRamp stops the nodes ramp, leaving the node at whatever capacity it's reached.
+func (s *Server) AbortRamp(c context.Context, n *pb.Node) (*pb.RampStatus, error) {
+	return s.controlRamp(c, n.Id, pb.RampState_RAMP_ABORTED)
+}
+
+func (s *Server) controlRamp(c context.Context, id uint64, state pb.RampState) (*pb.RampStatus, error) {
+	s.rampLock.Lock()
+	ramp, ok := s.ramps[id]
+	s.rampLock.Unlock()
+	if !ok {
+		return &pb.RampStatus{}, NoRamp
+	}
+	if err := ramp.setState(state); err != nil {
+		return ramp.snapshot(), err
+	}
+	ramp.ctxlog.WithFields(log.Fields{"state": state, "caller": callerFromContext(c)}).Info("capacity ramp state changed")
+	return ramp.snapshot(), nil
+}
+
+type rampStatusByID []*pb.RampStatus
+
+func (r rampStatusByID) Len() int           { return len(r) }
+func (r rampStatusByID) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r rampStatusByID) Less(i, j int) bool { return r[i].Id < r[j].Id }
@@ -0,0 +1,205 @@
+package syndicate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	rampIntervalUnit = time.Millisecond
+	rampPollInterval = time.Millisecond
+}
+
+func waitForRamp(t *testing.T, s *Server, id uint64) *pb.RampStatus {
+	s.rampLock.Lock()
+	ramp := s.ramps[id]
+	s.rampLock.Unlock()
+	select {
+	case <-ramp.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for ramp to finish: %v", ramp.snapshot())
+	}
+	return ramp.snapshot()
+}
+
+func waitForRampSteps(t *testing.T, s *Server, id uint64, steps uint32) *pb.RampStatus {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		s.rampLock.Lock()
+		ramp := s.ramps[id]
+		s.rampLock.Unlock()
+		if ramp != nil {
+			if status := ramp.snapshot(); status.StepsDone >= steps {
+				return status
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for ramp step %d", steps)
+	return nil
+}
+
+func TestServer_RampCapacity(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+	version := s.r.Version()
+
+	status, err := s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 100, Step: 25, Interval: 1})
+	if err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	if status.Start != 1 || status.Target != 100 || status.Steps != 4 {
+		t.Errorf("RampCapacity returned unexpected status: %v", status)
+	}
+	status = waitForRamp(t, s, id)
+	if status.State != pb.RampState_RAMP_COMPLETE || status.StepsDone != 4 || status.Capacity != 100 {
+		t.Errorf("ramp finished with unexpected status: %v", status)
+	}
+	if capacity, _ := s.nodeCapacity(id); capacity != 100 {
+		t.Errorf("node capacity is %d after ramp, expected 100", capacity)
+	}
+	if s.r.Version() == version || status.RingVersion != s.r.Version() {
+		t.Errorf("ramp should have left the ring at version %d, ring is at %d", status.RingVersion, s.r.Version())
+	}
+
+	//ramping down with the default step
+	status, err = s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 0, Interval: 1})
+	if err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	if status.Step != 10 || status.Steps != 10 {
+		t.Errorf("RampCapacity returned unexpected status: %v", status)
+	}
+	status = waitForRamp(t, s, id)
+	if status.State != pb.RampState_RAMP_COMPLETE || status.StepsDone != 10 || status.Capacity != 0 {
+		t.Errorf("ramp finished with unexpected status: %v", status)
+	}
+
+	list, err := s.GetRampStatus(ctx, &pb.EmptyMsg{})
+	if err != nil || len(list.Ramps) != 1 || list.Ramps[0].Id != id || list.Ramps[0].Start != 100 {
+		t.Errorf("GetRampStatus returned unexpected result: %v, %v", list, err)
+	}
+
+	bad := []*pb.RampRequest{
+		{Id: 42, Target: 10},
+		{Id: id, Target: 0},
+		{Id: id, Target: 10, Interval: -1},
+		{Id: id, Target: 10, ConvergeTimeout: -1},
+	}
+	for _, r := range bad {
+		if _, err := s.RampCapacity(ctx, r); err == nil {
+			t.Errorf("RampCapacity(%v) should have returned an error", r)
+		}
+	}
+}
+
+func TestServer_RampCapacityControl(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	ctx := context.Background()
+	id := s.r.Nodes()[0].ID()
+
+	if _, err := s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 11, Step: 1, Interval: 20}); err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	if _, err := s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 50}); err != RampInProgress {
+		t.Errorf("second RampCapacity for the node returned: %v", err)
+	}
+	if _, err := s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Drain: true}); err != RampInProgress {
+		t.Errorf("SetMaintenance with drain for a ramping node returned: %v", err)
+	}
+	waitForRampSteps(t, s, id, 1)
+	status, err := s.PauseRamp(ctx, &pb.Node{Id: id})
+	if err != nil || status.State != pb.RampState_RAMP_PAUSED {
+		t.Fatalf("PauseRamp returned unexpected result: %v, %v", status, err)
+	}
+	//a step already under way when paused may still land
+	time.Sleep(50 * time.Millisecond)
+	paused := waitForRampSteps(t, s, id, 1).StepsDone
+	time.Sleep(100 * time.Millisecond)
+	if status := waitForRampSteps(t, s, id, 1); status.StepsDone != paused || status.State != pb.RampState_RAMP_PAUSED {
+		t.Errorf("paused ramp kept stepping: %v", status)
+	}
+
+	//steps are taken from the nodes current capacity
+	if _, err = s.SetCapacity(ctx, &pb.Node{Id: id, Capacity: 8}); err != nil {
+		t.Fatalf("SetCapacity returned unexpected error: %s", err)
+	}
+	if _, err = s.ResumeRamp(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("ResumeRamp returned unexpected error: %s", err)
+	}
+	if status := waitForRampSteps(t, s, id, paused+1); status.Capacity < 9 {
+		t.Errorf("resumed ramp should have stepped from capacity 8: %v", status)
+	}
+	if _, err = s.AbortRamp(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("AbortRamp returned unexpected error: %s", err)
+	}
+	status = waitForRamp(t, s, id)
+	if status.State != pb.RampState_RAMP_ABORTED || status.Capacity == 11 {
+		t.Errorf("ramp finished with unexpected status: %v", status)
+	}
+	if capacity, _ := s.nodeCapacity(id); capacity != status.Capacity {
+		t.Errorf("node capacity is %d after abort, expected it to stay at %d", capacity, status.Capacity)
+	}
+
+	if _, err = s.PauseRamp(ctx, &pb.Node{Id: id}); err != NoRamp {
+		t.Errorf("PauseRamp of a finished ramp returned: %v", err)
+	}
+	if _, err = s.ResumeRamp(ctx, &pb.Node{Id: 42}); err != NoRamp {
+		t.Errorf("ResumeRamp of an unknown node returned: %v", err)
+	}
+}
+
+func TestServer_RampCapacityConverge(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	ctx := context.Background()
+	fakes := make([]*fakeManagedNode, 0)
+	for _, n := range s.r.Nodes() {
+		f := &fakeManagedNode{address: n.Address(0)}
+		fakes = append(fakes, f)
+		s.managedNodes[n.ID()] = f
+	}
+	go s.RingChangeManager()
+	id := s.r.Nodes()[0].ID()
+
+	status, err := s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 4, Step: 1, Interval: 1, WaitConverged: true})
+	if err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	status = waitForRamp(t, s, id)
+	if status.State != pb.RampState_RAMP_COMPLETE || status.Capacity != 4 {
+		t.Errorf("ramp finished with unexpected status: %v", status)
+	}
+
+	//a node that never picks up the new ring fails the ramp
+	fakes[1].setStale(true)
+	if _, err = s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 1, Step: 1, Interval: 1, WaitConverged: true, ConvergeTimeout: 50}); err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	status = waitForRamp(t, s, id)
+	if status.State != pb.RampState_RAMP_FAILED || status.StepsDone != 1 || !strings.Contains(status.Error, "1 managed nodes") {
+		t.Errorf("ramp finished with unexpected status: %v", status)
+	}
+
+	//unless it's unreachable, then it's left out
+	fakes[1].setUnhealthy(true)
+	if _, err = s.RampCapacity(ctx, &pb.RampRequest{Id: id, Target: 0, Step: 1, Interval: 1, WaitConverged: true, ConvergeTimeout: 1000}); err != nil {
+		t.Fatalf("RampCapacity returned unexpected error: %s", err)
+	}
+	status = waitForRamp(t, s, id)
+	if status.State != pb.RampState_RAMP_COMPLETE || status.Capacity != 0 {
+		t.Errorf("ramp finished with unexpected status: %v", status)
+	}
+
+	//the ramps of removed nodes are dropped
+	if _, err = s.RemoveNode(ctx, &pb.Node{Id: id}); err != nil {
+		t.Fatalf("RemoveNode returned unexpected error: %s", err)
+	}
+	if list, _ := s.GetRampStatus(ctx, &pb.EmptyMsg{}); len(list.Ramps) != 0 {
+		t.Errorf("GetRampStatus should not list the ramps of removed nodes: %v", list.Ramps)
+	}
+}
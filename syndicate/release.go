@@ -2,7 +2,10 @@ package syndicate
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
+	log "github.com/Sirupsen/logrus"
 	pb "github.com/pandemicsyn/syndicate/api/proto"
 	"golang.org/x/net/context"
 )
@@ -28,3 +31,100 @@ func (s *Server) NodeUpgradeSoftwareVersion(c context.Context, n *pb.NodeUpgrade
 	}
 	return &pb.NodeUpgradeStatus{Status: false}, fmt.Errorf("Node %d not found or not managed node", n.Id)
 }
+
+//GetAllSoftwareVersions asks all the managed nodes for their running software version,
+//querying at most NodeConcurrency nodes at once. The nodes are grouped by version, most
+//common version first, and nodes that couldn't be queried are listed separately.
+func (s *Server) GetAllSoftwareVersions(c context.Context, e *pb.EmptyMsg) (*pb.SoftwareVersions, error) {
+	nodes := s.managedNodesSnapshot()
+	var mu sync.Mutex
+	results := make([]*pb.NodeVersion, 0, len(nodes))
+	forEachNode(nodes, s.cfg.NodeConcurrency, func(id uint64, n ManagedNode) {
+		nv := &pb.NodeVersion{Id: id, Address: n.Address()}
+		version, err := n.GetSoftwareVersion()
+		if err != nil {
+			s.ctxlog.WithFields(log.Fields{"id": id, "address": nv.Address, "err": err}).Debug("failed to get node software version")
+			nv.Error = err.Error()
+		} else {
+			nv.Version = version
+		}
+		mu.Lock()
+		results = append(results, nv)
+		mu.Unlock()
+	})
+	return groupSoftwareVersions(results), nil
+}
+
+//managedNodesSnapshot returns a copy of the managed nodes so they can be talked
+//to without holding the server lock the whole time.
+func (s *Server) managedNodesSnapshot() map[uint64]ManagedNode {
+	s.RLock()
+	defer s.RUnlock()
+	nodes := make(map[uint64]ManagedNode, len(s.managedNodes))
+	for id, n := range s.managedNodes {
+		nodes[id] = n
+	}
+	return nodes
+}
+
+//forEachNode calls fn for each of the nodes, with at most limit calls running at
+//once, and waits for them all to finish.
+func forEachNode(nodes map[uint64]ManagedNode, limit int, fn func(id uint64, n ManagedNode)) {
+	if limit < 1 {
+		limit = 1
+	}
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for id, n := range nodes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id uint64, n ManagedNode) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(id, n)
+		}(id, n)
+	}
+	wg.Wait()
+}
+
+//groupSoftwareVersions groups the node versions by version. Groups are ordered by
+//node count (largest first) then version, and nodes by id.
+func groupSoftwareVersions(results []*pb.NodeVersion) *pb.SoftwareVersions {
+	sort.Sort(nodeVersionByID(results))
+	res := &pb.SoftwareVersions{Nodes: uint32(len(results))}
+	groups := make(map[string]*pb.SoftwareVersionGroup)
+	for _, nv := range results {
+		if nv.Error != "" {
+			res.Failed = append(res.Failed, nv)
+			continue
+		}
+		g, ok := groups[nv.Version]
+		if !ok {
+			g = &pb.SoftwareVersionGroup{Version: nv.Version}
+			groups[nv.Version] = g
+			res.Versions = append(res.Versions, g)
+		}
+		g.Nodes = append(g.Nodes, nv)
+	}
+	sort.Sort(versionGroupsBySize(res.Versions))
+	return res
+}
+
+type nodeVersionByID []*pb.NodeVersion
+
+func (n nodeVersionByID) Len() int           { return len(n) }
+func (n nodeVersionByID) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodeVersionByID) Less(i, j int) bool { return n[i].Id < n[j].Id }
+
+type versionGroupsBySize []*pb.SoftwareVersionGroup
+
+func (v versionGroupsBySize) Len() int      { return len(v) }
+func (v versionGroupsBySize) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v versionGroupsBySize) Less(i, j int) bool {
+	if len(v[i].Nodes) != len(v[j].Nodes) {
+		return len(v[i].Nodes) > len(v[j].Nodes)
+	}
+	return v[i].Version < v[j].Version
+}
@@ -0,0 +1,274 @@
+package syndicate
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+//fakeManagedNode is a ManagedNode that doesn't talk to anything. Its calls share
+//a tracker so tests can see how many nodes were being talked to at once.
+type fakeManagedNode struct {
+	sync.RWMutex
+	mu         sync.Mutex
+	address    string
+	version    string
+	err        error         //returned by GetSoftwareVersion and UpgradeSoftwareVersion
+	down       bool          //Ping fails while the node is upgraded
+	unhealthy  bool          //Ping always fails
+	badVersion string        //reported by GetSoftwareVersion while the node is upgraded
+	lag        int           //GetSoftwareVersion reports the old version this many times after an upgrade
+	lagLeft    int           //the old version reports left since the last upgrade
+	previous   string        //the version before the last upgrade
+	gate       chan struct{} //if set UpgradeSoftwareVersion blocks until its closed
+	original   string        //the version before the first upgrade
+	upgrades   []string
+	ctlErr     error //returned by Start, Stop and Restart
+	controls   []string
+	bounce     int //Ping fails this many times after a Start or Restart
+	bouncing   int
+	starts     bool //Ping reports how many times the backend has been started
+	ringVer    int64
+	stale      bool //if set RingUpdate doesn't take
+	tracker    *callTracker
+}
+
+type callTracker struct {
+	sync.Mutex
+	running int
+	max     int
+	delay   time.Duration
+	order   []string //the names passed to named calls, in the order they were made
+}
+
+func (c *callTracker) call() {
+	c.namedCall("")
+}
+
+func (c *callTracker) namedCall(name string) {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	if name != "" {
+		c.order = append(c.order, name)
+	}
+	c.running++
+	if c.running > c.max {
+		c.max = c.running
+	}
+	c.Unlock()
+	time.Sleep(c.delay)
+	c.Lock()
+	c.running--
+	c.Unlock()
+}
+
+func (c *callTracker) maxRunning() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.max
+}
+
+func (c *callTracker) callOrder() []string {
+	c.Lock()
+	defer c.Unlock()
+	return append([]string{}, c.order...)
+}
+
+func (f *fakeManagedNode) Connect() error    { return nil }
+func (f *fakeManagedNode) Disconnect() error { return nil }
+func (f *fakeManagedNode) Address() string   { return f.address }
+
+func (f *fakeManagedNode) Start() error   { return f.control("start") }
+func (f *fakeManagedNode) Stop() error    { return f.control("stop") }
+func (f *fakeManagedNode) Restart() error { return f.control("restart") }
+
+func (f *fakeManagedNode) control(action string) error {
+	f.tracker.namedCall(f.address)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.controls = append(f.controls, action)
+	if f.ctlErr == nil && action != "stop" {
+		f.bouncing = f.bounce
+	}
+	return f.ctlErr
+}
+
+func (f *fakeManagedNode) controlRequests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.controls...)
+}
+
+//upgraded returns true if the node is running something other than its original
+//version, f.mu must be held.
+func (f *fakeManagedNode) upgraded() bool {
+	return len(f.upgrades) != 0 && f.version != f.original
+}
+
+func (f *fakeManagedNode) setDown(down bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.down = down
+}
+
+func (f *fakeManagedNode) Ping() (bool, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.unhealthy || (f.down && f.upgraded()) {
+		return false, "", fmt.Errorf("connection refused")
+	}
+	if f.bouncing > 0 {
+		f.bouncing--
+		return false, "", fmt.Errorf("connection refused")
+	}
+	if f.starts {
+		var started int
+		for _, c := range f.controls {
+			if c != "stop" {
+				started++
+			}
+		}
+		return true, fmt.Sprintf("started %d times", started), nil
+	}
+	return true, "pong", nil
+}
+
+func (f *fakeManagedNode) RingUpdate(r *[]byte, v int64) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.stale {
+		return false, fmt.Errorf("ring update failed")
+	}
+	f.ringVer = v
+	return true, nil
+}
+
+func (f *fakeManagedNode) RingVersion() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ringVer
+}
+
+func (f *fakeManagedNode) setUnhealthy(unhealthy bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unhealthy = unhealthy
+}
+
+func (f *fakeManagedNode) setStale(stale bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stale = stale
+}
+
+func (f *fakeManagedNode) GetSoftwareVersion() (string, error) {
+	f.tracker.call()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.upgraded() && f.badVersion != "" {
+		return f.badVersion, nil
+	}
+	if f.lagLeft > 0 {
+		f.lagLeft--
+		return f.previous, nil
+	}
+	return f.version, f.err
+}
+
+func (f *fakeManagedNode) UpgradeSoftwareVersion(version string) (bool, error) {
+	f.tracker.call()
+	if f.gate != nil {
+		<-f.gate
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return false, f.err
+	}
+	if len(f.upgrades) == 0 {
+		f.original = f.version
+	}
+	f.previous, f.lagLeft = f.version, f.lag
+	f.version = version
+	f.upgrades = append(f.upgrades, version)
+	return true, nil
+}
+
+func (f *fakeManagedNode) currentVersion() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.version
+}
+
+func (f *fakeManagedNode) upgradeRequests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.upgrades...)
+}
+
+func TestServer_GetAllSoftwareVersions(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	s.cfg.NodeConcurrency = 3
+	tracker := &callTracker{delay: 10 * time.Millisecond}
+	versions := []string{"1.0.0", "1.1.0", "1.0.0", "", "1.0.0", "1.1.0", "0.9.0", "1.0.0", "", "1.0.0"}
+	for i, v := range versions {
+		n := &fakeManagedNode{address: fmt.Sprintf("10.0.0.%d:4443", i+1), version: v, tracker: tracker}
+		if v == "" {
+			n.err = fmt.Errorf("node %d is down", i+1)
+		}
+		s.managedNodes[uint64(i+1)] = n
+	}
+
+	res, err := s.GetAllSoftwareVersions(context.Background(), &pb.EmptyMsg{})
+	if err != nil {
+		t.Fatalf("GetAllSoftwareVersions returned unexpected error: %s", err)
+	}
+	if tracker.maxRunning() > 3 {
+		t.Errorf("GetAllSoftwareVersions queried %d nodes at once, expected at most 3", tracker.maxRunning())
+	}
+	if res.Nodes != uint32(len(versions)) {
+		t.Errorf("GetAllSoftwareVersions reported %d nodes, expected %d", res.Nodes, len(versions))
+	}
+
+	expected := []struct {
+		version string
+		ids     []uint64
+	}{
+		{"1.0.0", []uint64{1, 3, 5, 8, 10}},
+		{"1.1.0", []uint64{2, 6}},
+		{"0.9.0", []uint64{7}},
+	}
+	if len(res.Versions) != len(expected) {
+		t.Fatalf("GetAllSoftwareVersions returned %d version groups, expected %d: %v", len(res.Versions), len(expected), res.Versions)
+	}
+	for i, e := range expected {
+		g := res.Versions[i]
+		if g.Version != e.version || len(g.Nodes) != len(e.ids) {
+			t.Errorf("version group %d is %s with %d nodes, expected %s with %d", i, g.Version, len(g.Nodes), e.version, len(e.ids))
+			continue
+		}
+		for j, id := range e.ids {
+			if g.Nodes[j].Id != id || g.Nodes[j].Version != e.version || g.Nodes[j].Address != fmt.Sprintf("10.0.0.%d:4443", id) {
+				t.Errorf("version group %s node %d is %#v, expected id %d", e.version, j, g.Nodes[j], id)
+			}
+		}
+	}
+	if len(res.Failed) != 2 || res.Failed[0].Id != 4 || res.Failed[1].Id != 9 {
+		t.Fatalf("GetAllSoftwareVersions returned unexpected failed nodes: %v", res.Failed)
+	}
+	if res.Failed[0].Error != "node 4 is down" || res.Failed[0].Version != "" {
+		t.Errorf("unexpected failed node: %#v", res.Failed[0])
+	}
+
+	s.managedNodes = make(map[uint64]ManagedNode)
+	res, err = s.GetAllSoftwareVersions(context.Background(), &pb.EmptyMsg{})
+	if err != nil || res.Nodes != 0 || len(res.Versions) != 0 || len(res.Failed) != 0 {
+		t.Errorf("GetAllSoftwareVersions with no managed nodes returned: %#v, %v", res, err)
+	}
+}
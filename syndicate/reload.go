@@ -0,0 +1,221 @@
+package syndicate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+var NoConfigReloader = errors.New("Config reloading isn't available")
+
+//liveConfigFields are the Config fields a reload applies to a running Server,
+//changes to any other field only take effect after a restart.
+var liveConfigFields = map[string]bool{
+	"Debug":            true,
+	"NetFilter":        true,
+	"TierFilter":       true,
+	"WeightAssignment": true,
+	"Slaves":           true,
+}
+
+//ConfigReloader re-reads the synd config file and applies it to the running
+//services, see Reload.
+type ConfigReloader struct {
+	sync.Mutex
+	path     string
+	services map[string]*Server
+}
+
+//NewConfigReloader returns a reloader for the services started from the config
+//file at path.
+func NewConfigReloader(path string, services map[string]*Server) *ConfigReloader {
+	return &ConfigReloader{path: path, services: services}
+}
+
+//Reload re-reads and validates the config file and, if every services config is
+//valid, applies the changes that can be applied live (see liveConfigFields) to
+//each running service. Changes to any other field, as well as added or removed
+//services, need a restart and are reported rather than applied. The log level is
+//debug if any service has Debug set and is reported along with the services.
+//Nothing is applied if the file can't be read or any services config is invalid.
+func (r *ConfigReloader) Reload() (*pb.ConfigReloadResult, error) {
+	r.Lock()
+	defer r.Unlock()
+	var tc map[string]Config
+	if _, err := toml.DecodeFile(r.path, &tc); err != nil {
+		return &pb.ConfigReloadResult{}, fmt.Errorf("Unable to read config %s: %s", r.path, err)
+	}
+	var names []string
+	for name := range r.services {
+		names = append(names, name)
+	}
+	for name := range tc {
+		if _, ok := r.services[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	res := &pb.ConfigReloadResult{}
+	configs := make(map[string]*Config)
+	for _, name := range names {
+		sr := &pb.ServiceReload{Service: name}
+		res.Services = append(res.Services, sr)
+		s, running := r.services[name]
+		cfg, configured := tc[name]
+		switch {
+		case !running:
+			sr.RestartRequired = []string{"service added"}
+		case !configured:
+			sr.RestartRequired = []string{"service removed"}
+		default:
+			var err error
+			sr.Applied, sr.RestartRequired, err = s.checkConfig(&cfg)
+			if err != nil {
+				return &pb.ConfigReloadResult{}, fmt.Errorf("Invalid config for %s: %s", name, err)
+			}
+			configs[name] = &cfg
+		}
+	}
+	for _, sr := range res.Services {
+		if cfg, ok := configs[sr.Service]; ok && len(sr.Applied) > 0 {
+			sr.Errors = r.services[sr.Service].applyConfig(cfg, sr.Applied)
+		}
+	}
+	//the log level is process wide, so it's debug if any service wants it
+	if len(configs) != 0 {
+		level := log.InfoLevel
+		for _, cfg := range configs {
+			if cfg.Debug {
+				level = log.DebugLevel
+			}
+		}
+		log.SetLevel(level)
+	}
+	res.LogLevel = log.GetLevel().String()
+	return res, nil
+}
+
+//checkConfig fills in cfgs defaults and validates it as NewServer would, then
+//returns the fields that differ from the running config, split into those a
+//reload applies and those that need a restart.
+func (s *Server) checkConfig(cfg *Config) (live, restart []string, err error) {
+	t := &Server{cfg: cfg, servicename: s.servicename, ctxlog: s.ctxlog}
+	t.parseConfig()
+	if _, err := parseNetFilter(cfg.NetFilter); err != nil {
+		return nil, nil, fmt.Errorf("Invalid network range provided: %s", err)
+	}
+	for _, v := range cfg.TierFilter {
+		if _, err := regexp.Compile(v); err != nil {
+			return nil, nil, fmt.Errorf("Invalid tier filter provided (%s): %s", v, err)
+		}
+	}
+	if err := t.parseCapacityPolicy(); err != nil {
+		return nil, nil, err
+	}
+	for _, n := range cfg.Notifiers {
+		if _, err := newWebhookNotifier(n, s.ctxlog); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	s.RLock()
+	cur := reflect.ValueOf(*s.cfg)
+	s.RUnlock()
+	next := reflect.ValueOf(*cfg)
+	for i := 0; i < cur.NumField(); i++ {
+		if reflect.DeepEqual(cur.Field(i).Interface(), next.Field(i).Interface()) {
+			continue
+		}
+		name := cur.Type().Field(i).Name
+		if liveConfigFields[name] {
+			live = append(live, name)
+		} else {
+			restart = append(restart, name)
+		}
+	}
+	return live, restart, nil
+}
+
+//applyConfig applies the given live fields of an already checked config,
+//returning any problems applying them. The log level is process wide so it's set
+//by Reload from every services Debug rather than here.
+func (s *Server) applyConfig(cfg *Config, fields []string) []string {
+	s.Lock()
+	defer s.Unlock()
+	var errs []string
+	for _, field := range fields {
+		switch field {
+		case "Debug":
+			s.cfg.Debug = cfg.Debug
+		case "NetFilter":
+			s.netlimits, _ = parseNetFilter(cfg.NetFilter)
+			s.cfg.NetFilter = cfg.NetFilter
+		case "TierFilter":
+			s.tierlimits = cfg.TierFilter
+			s.cfg.TierFilter = cfg.TierFilter
+		case "WeightAssignment":
+			s.cfg.WeightAssignment = cfg.WeightAssignment
+		case "Slaves":
+			errs = append(errs, s.reconnectSlaves(cfg.Slaves)...)
+			s.cfg.Slaves = cfg.Slaves
+		}
+	}
+	s.ctxlog.WithFields(log.Fields{"fields": strings.Join(fields, ","), "errors": len(errs)}).Info("applied config reload")
+	return errs
+}
+
+//reconnectSlaves brings the slaves in line with addrs, disconnecting removed
+//slaves and registering new ones. Slaves that fail to register aren't added,
+//their errors are returned. s.Lock must be held.
+func (s *Server) reconnectSlaves(addrs []string) []string {
+	want := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		want[addr] = true
+	}
+	var errs []string
+	slaves := make([]*RingSlave, 0, len(addrs))
+	have := make(map[string]bool, len(s.slaves))
+	for _, slave := range s.slaves {
+		if !want[slave.addr] {
+			s.ctxlog.WithField("slave", slave.addr).Info("disconnecting removed slave")
+			if slave.conn != nil {
+				slave.conn.Close()
+			}
+			continue
+		}
+		have[slave.addr] = true
+		slaves = append(slaves, slave)
+	}
+	for _, slave := range parseSlaveAddrs(addrs) {
+		if have[slave.addr] {
+			continue
+		}
+		if err := s.RegisterSlave(slave); err != nil {
+			s.ctxlog.WithFields(log.Fields{"slave": slave.addr, "err": err}).Warning("Error registering slave")
+			if slave.conn != nil {
+				slave.conn.Close()
+			}
+			errs = append(errs, fmt.Sprintf("slave %s: %s", slave.addr, err))
+			continue
+		}
+		slaves = append(slaves, slave)
+	}
+	s.slaves = slaves
+	return errs
+}
+
+//ReloadConfig isn't available on a lone Server, synd reloads through its
+//ServiceRouter instead.
+func (s *Server) ReloadConfig(c context.Context, e *pb.EmptyMsg) (*pb.ConfigReloadResult, error) {
+	return &pb.ConfigReloadResult{}, NoConfigReloader
+}
@@ -0,0 +1,156 @@
+package syndicate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func writeTestConfig(t *testing.T, path, config string) {
+	if err := ioutil.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConfigReloader_Reload(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	s.cfg.NetFilter = []string{"10.0.0.0/24", "1.2.3.0/24"}
+	s.cfg.Slaves = []string{"127.0.0.1:1"}
+	s.slaves = parseSlaveAddrs(s.cfg.Slaves)
+	s.parseConfig()
+	port := s.cfg.Port
+
+	f, err := ioutil.TempFile("", "syndicate-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	reloader := NewConfigReloader(f.Name(), map[string]*Server{"test": s})
+
+	writeTestConfig(t, f.Name(), fmt.Sprintf(`
+[test]
+RingDir = %q
+NetFilter = ["10.0.0.0/24", "1.2.3.0/24", "172.16.0.0/16"]
+TierFilter = ["z.*"]
+WeightAssignment = "self"
+Port = 9999
+
+[newsvc]
+Port = 9998
+`, s.cfg.RingDir))
+	res, err := reloader.Reload()
+	if err != nil {
+		t.Fatalf("Reload returned unexpected error: %s", err)
+	}
+	if len(res.Services) != 2 {
+		t.Fatalf("Reload should have reported 2 services: %v", res)
+	}
+	if svc := res.Services[0]; svc.Service != "newsvc" || !reflect.DeepEqual(svc.RestartRequired, []string{"service added"}) || len(svc.Applied) != 0 {
+		t.Errorf("Unexpected reload result for the added service: %v", svc)
+	}
+	svc := res.Services[1]
+	if !reflect.DeepEqual(svc.Applied, []string{"Slaves", "NetFilter", "TierFilter", "WeightAssignment"}) {
+		t.Errorf("Unexpected applied fields: %v", svc.Applied)
+	}
+	if !reflect.DeepEqual(svc.RestartRequired, []string{"Port"}) || len(svc.Errors) != 0 {
+		t.Errorf("Unexpected reload result: %v", svc)
+	}
+	if len(s.netlimits) != 3 || len(s.nodeAddrs([]string{"172.16.0.5/32"})) == 0 {
+		t.Errorf("NetFilter should have been applied: %v", s.netlimits)
+	}
+	if !reflect.DeepEqual(s.tierlimits, []string{"z.*"}) || s.cfg.WeightAssignment != "self" {
+		t.Errorf("TierFilter and WeightAssignment should have been applied: %v, %s", s.tierlimits, s.cfg.WeightAssignment)
+	}
+	if len(s.slaves) != 0 {
+		t.Errorf("The removed slave should have been dropped: %v", s.slaves)
+	}
+	if s.cfg.Port != port {
+		t.Errorf("Port shouldn't have been changed without a restart")
+	}
+
+	//invalid configs aren't applied at all
+	writeTestConfig(t, f.Name(), fmt.Sprintf(`
+[test]
+RingDir = %q
+NetFilter = ["bogus"]
+WeightAssignment = "fixed"
+`, s.cfg.RingDir))
+	if _, err := reloader.Reload(); err == nil {
+		t.Errorf("Reload with an invalid NetFilter should have failed")
+	}
+	if s.cfg.WeightAssignment != "self" || len(s.netlimits) != 3 {
+		t.Errorf("Nothing should have been applied from an invalid config")
+	}
+	writeTestConfig(t, f.Name(), "[test\n")
+	if _, err := reloader.Reload(); err == nil {
+		t.Errorf("Reload of an unparsable config should have failed")
+	}
+
+	//removed services need a restart too
+	writeTestConfig(t, f.Name(), "[other]\n")
+	if res, err = reloader.Reload(); err != nil || res.Services[1].Service != "test" || !reflect.DeepEqual(res.Services[1].RestartRequired, []string{"service removed"}) {
+		t.Errorf("Unexpected reload result for a removed service: %v, %v", res, err)
+	}
+
+	//the rpc
+	router := NewServiceRouter(map[string]*Server{"test": s}, "test")
+	if _, err := router.ReloadConfig(context.Background(), &pb.EmptyMsg{}); err != NoConfigReloader {
+		t.Errorf("ReloadConfig without a reloader should have returned NoConfigReloader, got: %v", err)
+	}
+	router.SetConfigReloader(reloader)
+	if res, err = router.ReloadConfig(context.Background(), &pb.EmptyMsg{}); err != nil || len(res.Services) != 2 {
+		t.Errorf("ReloadConfig returned unexpected result: %v, %v", res, err)
+	}
+}
+
+func TestConfigReloader_ReloadLogLevel(t *testing.T) {
+	defer log.SetLevel(log.GetLevel())
+	a, _ := newTestServerWithDefaults()
+	b, _ := newTestServerWithDefaults()
+	f, err := ioutil.TempFile("", "syndicate-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	reloader := NewConfigReloader(f.Name(), map[string]*Server{"a": a, "b": b})
+
+	//the last service doesn't get to turn debug back off for everyone
+	writeTestConfig(t, f.Name(), fmt.Sprintf(`
+[a]
+RingDir = %q
+Debug = true
+
+[b]
+RingDir = %q
+Debug = false
+`, a.cfg.RingDir, b.cfg.RingDir))
+	res, err := reloader.Reload()
+	if err != nil {
+		t.Fatalf("Reload returned unexpected error: %s", err)
+	}
+	if res.LogLevel != "debug" || log.GetLevel() != log.DebugLevel {
+		t.Errorf("Reload with a service wanting debug left the log level at %s (reported %s)", log.GetLevel(), res.LogLevel)
+	}
+	if !a.cfg.Debug || b.cfg.Debug {
+		t.Errorf("Reload should have applied each services Debug: %v, %v", a.cfg.Debug, b.cfg.Debug)
+	}
+
+	writeTestConfig(t, f.Name(), fmt.Sprintf(`
+[a]
+RingDir = %q
+
+[b]
+RingDir = %q
+`, a.cfg.RingDir, b.cfg.RingDir))
+	if res, err = reloader.Reload(); err != nil || res.LogLevel != "info" || log.GetLevel() != log.InfoLevel {
+		t.Errorf("Reload without debug left the log level at %s: %v, %v", log.GetLevel(), res, err)
+	}
+}
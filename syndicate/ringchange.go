@@ -1,10 +1,15 @@
 package syndicate
 
-import log "github.com/Sirupsen/logrus"
+import (
+	log "github.com/Sirupsen/logrus"
+	"github.com/gholt/ring"
+)
 
 type changeMsg struct {
-	rb *[]byte
-	v  int64
+	rb   *[]byte
+	v    int64
+	r    ring.Ring
+	prev ring.Ring
 }
 
 // NotifyNodes is called when a ring change occur's and just
@@ -12,8 +17,10 @@ type changeMsg struct {
 func (s *Server) NotifyNodes() {
 	s.RLock()
 	m := &changeMsg{
-		rb: s.rb,
-		v:  s.r.Version(),
+		rb:   s.rb,
+		v:    s.r.Version(),
+		r:    s.r,
+		prev: s.prevRing,
 	}
 	s.RUnlock()
 	s.changeChan <- m
@@ -0,0 +1,134 @@
+package syndicate
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+)
+
+//ringDelta returns the changes needed to turn the from ring into the to ring: the nodes
+//added or changed, the nodes removed, the partitions whose replica assignments changed,
+//and the ring config if it changed. Returns nil if the rings differ in partition bit
+//count or replica count, in which case a subscriber needs the full ring anyway.
+func ringDelta(from, to ring.Ring) *pb.RingDelta {
+	if from.PartitionBitCount() != to.PartitionBitCount() || from.ReplicaCount() != to.ReplicaCount() {
+		return nil
+	}
+	d := &pb.RingDelta{BaseVersion: from.Version()}
+	present := make(map[uint64]bool)
+	for _, n := range to.Nodes() {
+		present[n.ID()] = true
+		if o := from.Node(n.ID()); o != nil && nodesEqual(o, n) {
+			continue
+		}
+		d.Nodes = append(d.Nodes, nodeToPb(n))
+	}
+	for _, n := range from.Nodes() {
+		if !present[n.ID()] {
+			d.RemovedNodes = append(d.RemovedNodes, n.ID())
+		}
+	}
+	if !bytes.Equal(from.Config(), to.Config()) {
+		d.ConfChanged = true
+		d.Conf = to.Config()
+	}
+	partitions := uint64(1) << to.PartitionBitCount()
+	for p := uint64(0); p < partitions; p++ {
+		owners := to.ResponsibleNodes(uint32(p))
+		if sameNodeIDs(from.ResponsibleNodes(uint32(p)), owners) {
+			continue
+		}
+		pa := &pb.PartitionAssignment{Partition: uint32(p)}
+		for _, n := range owners {
+			pa.Nodes = append(pa.Nodes, n.ID())
+		}
+		d.Partitions = append(d.Partitions, pa)
+	}
+	return d
+}
+
+//compactDelta returns the delta between the from and to rings, or nil if there isn't
+//one or it wouldn't be any smaller than sending the full ring bytes.
+func compactDelta(from, to ring.Ring, fullSize int) *pb.RingDelta {
+	d := ringDelta(from, to)
+	if d == nil || d.Size() >= fullSize {
+		return nil
+	}
+	return d
+}
+
+//ringByVersion returns the given ring version if its still available locally,
+//either in memory or as a versioned ring file in the ring dir.
+func (s *Server) ringByVersion(version int64) (ring.Ring, error) {
+	if s.r.Version() == version {
+		return s.r, nil
+	}
+	if s.prevRing != nil && s.prevRing.Version() == version {
+		return s.prevRing, nil
+	}
+	r, err := s.getRing(fmt.Sprintf("%s/%d-%s.ring", s.cfg.RingDir, version, s.servicename))
+	if err != nil {
+		return nil, err
+	}
+	if r.Version() != version {
+		return nil, fmt.Errorf("Ring file for version %d contains version %d", version, r.Version())
+	}
+	return r, nil
+}
+
+//subscriberRing returns the ring message to send a subscriber that already has
+//the given ring version. If the subscriber accepts deltas and the version it has
+//is still available locally, that's a delta from it, otherwise its the full ring.
+func (s *Server) subscriberRing(req *pb.SubscriberID) *pb.Ring {
+	full := &pb.Ring{Version: s.r.Version(), Ring: *s.rb}
+	if !req.Deltas || req.Version == 0 {
+		return full
+	}
+	if req.Version == s.r.Version() {
+		return &pb.Ring{Version: s.r.Version(), Delta: &pb.RingDelta{BaseVersion: req.Version}}
+	}
+	base, err := s.ringByVersion(req.Version)
+	if err != nil {
+		s.ctxlog.WithField("err", err).Debug("subscriber ring version unavailable, sending full ring")
+		return full
+	}
+	if d := compactDelta(base, s.r, len(*s.rb)); d != nil {
+		return &pb.Ring{Version: s.r.Version(), Delta: d}
+	}
+	return full
+}
+
+func nodesEqual(a, b ring.Node) bool {
+	return a.Active() == b.Active() &&
+		a.Capacity() == b.Capacity() &&
+		a.Meta() == b.Meta() &&
+		stringsEqual(a.Tiers(), b.Tiers()) &&
+		stringsEqual(a.Addresses(), b.Addresses()) &&
+		bytes.Equal(a.Config(), b.Config())
+}
+
+func sameNodeIDs(a, b ring.NodeSlice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID() != b[i].ID() {
+			return false
+		}
+	}
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
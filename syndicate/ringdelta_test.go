@@ -0,0 +1,159 @@
+package syndicate
+
+import (
+	"testing"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+)
+
+func TestRingDelta(t *testing.T) {
+	b := ring.NewBuilder(64)
+	b.SetReplicaCount(2)
+	changed, _ := b.AddNode(true, 100, []string{"server1"}, []string{"10.0.0.1:8001"}, "server1", []byte(""))
+	removed, _ := b.AddNode(true, 100, []string{"server2"}, []string{"10.0.0.2:8001"}, "server2", []byte(""))
+	b.AddNode(true, 100, []string{"server3"}, []string{"10.0.0.3:8001"}, "server3", []byte(""))
+	from := b.Ring()
+
+	d := ringDelta(from, from)
+	if d == nil || d.BaseVersion != from.Version() || len(d.Nodes) != 0 || len(d.RemovedNodes) != 0 || len(d.Partitions) != 0 || d.ConfChanged {
+		t.Errorf("ringDelta of a ring with itself should be empty: %#v", d)
+	}
+
+	b.Node(changed.ID()).SetCapacity(300)
+	b.RemoveNode(removed.ID())
+	added, _ := b.AddNode(true, 100, []string{"server4"}, []string{"10.0.0.4:8001"}, "server4", []byte(""))
+	b.SetConfig([]byte("newconf"))
+	to := b.Ring()
+
+	d = ringDelta(from, to)
+	if d == nil {
+		t.Fatalf("ringDelta returned nil for compatible rings")
+	}
+	if d.BaseVersion != from.Version() || !d.ConfChanged || string(d.Conf) != "newconf" {
+		t.Errorf("ringDelta returned unexpected delta: %#v", d)
+	}
+	if len(d.Nodes) != 2 || len(d.RemovedNodes) != 1 || d.RemovedNodes[0] != removed.ID() {
+		t.Fatalf("ringDelta returned unexpected node changes: %v, removed %v", d.Nodes, d.RemovedNodes)
+	}
+	for _, n := range d.Nodes {
+		if n.Id != changed.ID() && n.Id != added.ID() {
+			t.Errorf("ringDelta included unchanged node %d", n.Id)
+		}
+		if n.Id == changed.ID() && n.Capacity != 300 {
+			t.Errorf("ringDelta should have included node %d's new capacity: %#v", n.Id, n)
+		}
+	}
+	moved := make(map[uint32][]uint64)
+	for _, pa := range d.Partitions {
+		moved[pa.Partition] = pa.Nodes
+	}
+	for p := uint32(0); p < uint32(1)<<to.PartitionBitCount(); p++ {
+		owners := to.ResponsibleNodes(p)
+		nodes, ok := moved[p]
+		if !ok {
+			if !sameNodeIDs(from.ResponsibleNodes(p), owners) {
+				t.Errorf("ringDelta missed changed partition %d", p)
+			}
+			continue
+		}
+		if len(nodes) != len(owners) {
+			t.Errorf("ringDelta partition %d has %d replicas, expected %d", p, len(nodes), len(owners))
+			continue
+		}
+		for i := range owners {
+			if nodes[i] != owners[i].ID() {
+				t.Errorf("ringDelta partition %d replica %d is %d, expected %d", p, i, nodes[i], owners[i].ID())
+			}
+		}
+	}
+
+	data, err := d.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal of delta failed: %s", err)
+	}
+	var rd pb.RingDelta
+	if err := rd.Unmarshal(data); err != nil || len(rd.Partitions) != len(d.Partitions) || len(rd.RemovedNodes) != 1 || rd.RemovedNodes[0] != removed.ID() {
+		t.Errorf("delta did not survive a marshal round trip: %v", err)
+	}
+
+	b.SetReplicaCount(3)
+	if d = ringDelta(to, b.Ring()); d != nil {
+		t.Errorf("ringDelta should return nil when the replica count changes: %#v", d)
+	}
+	if d = compactDelta(from, to, 10); d != nil {
+		t.Errorf("compactDelta should return nil when the delta isn't smaller than the full ring")
+	}
+}
+
+func TestServer_GetRingStream(t *testing.T) {
+	s, m := newTestServerWithDefaults()
+	s.cfg.RingSubscriberTimeout = DefaultRingSubscriberTimeout
+	s.subsChangeChan = make(chan *changeMsg, 1)
+	rb := make([]byte, 1<<20)
+
+	prev := s.r
+	id := prev.Nodes()[0].ID()
+	m.builder.Node(id).SetCapacity(42)
+	change := &RingChange{r: m.builder.Ring(), b: m.builder}
+	change.v = change.r.Version()
+	if err := s.applyRingChange(change); err != nil {
+		t.Fatalf("applyRingChange returned unexpected error: %s", err)
+	}
+	//wait for the change notification so it doesn't race with the one below
+	<-s.subsChangeChan
+	s.rb = &rb
+	current := s.r
+
+	tests := []struct {
+		req   *pb.SubscriberID
+		delta bool
+		base  int64
+	}{
+		{&pb.SubscriberID{Id: "full", Version: prev.Version()}, false, 0},
+		{&pb.SubscriberID{Id: "new", Deltas: true}, false, 0},
+		{&pb.SubscriberID{Id: "prev", Version: prev.Version(), Deltas: true}, true, prev.Version()},
+		{&pb.SubscriberID{Id: "current", Version: current.Version(), Deltas: true}, true, current.Version()},
+		{&pb.SubscriberID{Id: "unknown", Version: 42, Deltas: true}, false, 0},
+	}
+	streams := make(map[string]*fakeRingStream)
+	for _, test := range tests {
+		stream := newFakeRingStream(1)
+		streams[test.req.Id] = stream
+		go s.GetRingStream(test.req, stream)
+		r := stream.next(t)
+		if r.Version != current.Version() {
+			t.Errorf("%s: initial ring version %d, expected %d", test.req.Id, r.Version, current.Version())
+		}
+		if test.delta && (r.Delta == nil || r.Delta.BaseVersion != test.base || len(r.Ring) != 0) {
+			t.Errorf("%s: expected a delta from %d, got %#v", test.req.Id, test.base, r)
+		}
+		if !test.delta && (r.Delta != nil || len(r.Ring) != len(rb)) {
+			t.Errorf("%s: expected the full ring, got %#v", test.req.Id, r.Delta)
+		}
+	}
+
+	m.builder.Node(id).SetCapacity(7)
+	next := m.builder.Ring()
+	go s.ringSubscribersNotify()
+	s.subsChangeChan <- &changeMsg{rb: &rb, v: next.Version(), r: next, prev: current}
+	for id, stream := range streams {
+		r := stream.next(t)
+		if r.Version != next.Version() {
+			t.Errorf("%s: ring version %d, expected %d", id, r.Version, next.Version())
+		}
+		if id == "full" {
+			if r.Delta != nil || len(r.Ring) != len(rb) {
+				t.Errorf("%s: expected the full ring, got %#v", id, r.Delta)
+			}
+			continue
+		}
+		if r.Delta == nil || r.Delta.BaseVersion != current.Version() || len(r.Delta.Nodes) != 1 || r.Delta.Nodes[0].Capacity != 7 {
+			t.Errorf("%s: expected a delta from %d, got %#v", id, current.Version(), r.Delta)
+		}
+	}
+	close(s.subsChangeChan)
+	for id := range streams {
+		s.removeRingSubscriber(id)
+	}
+}
@@ -0,0 +1,210 @@
+package syndicate
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+)
+
+//maxQueuedRingEvents is how many events a WatchRingEvents watcher may fall
+//behind by before its evicted. Events can't be coalesced like rings can.
+const maxQueuedRingEvents = 1024
+
+type RingEventWatchers struct {
+	sync.RWMutex
+	watchers map[*ringEventWatcher]bool
+}
+
+//ringEventWatcher is the queue of events waiting to be sent to a single
+//WatchRingEvents stream, along with the filter for which events it wants.
+type ringEventWatcher struct {
+	sync.Mutex
+	types map[pb.RingEventType]bool
+	nodes map[uint64]bool
+	queue []*pb.RingEvent
+	ready chan struct{} //signaled when new events are queued
+	done  chan struct{} //closed when the watcher is removed
+}
+
+//newRingEventWatcher returns a watcher for the events matching the filter,
+//an empty filter matches all events.
+func newRingEventWatcher(f *pb.RingEventFilter) (*ringEventWatcher, error) {
+	w := &ringEventWatcher{
+		types: make(map[pb.RingEventType]bool),
+		nodes: make(map[uint64]bool),
+		ready: make(chan struct{}, 1),
+		done:  make(chan struct{}),
+	}
+	for _, t := range f.Types {
+		if _, ok := pb.RingEventType_name[int32(t)]; !ok || t == pb.RingEventType_UNKNOWN_EVENT {
+			return nil, fmt.Errorf("Invalid event type %d", t)
+		}
+		w.types[t] = true
+	}
+	for _, id := range f.Nodes {
+		w.nodes[id] = true
+	}
+	return w, nil
+}
+
+//matches returns true if the watcher wants the event. When the filter has nodes
+//only events for those nodes match, so ring wide events are left out.
+func (w *ringEventWatcher) matches(e *pb.RingEvent) bool {
+	if len(w.types) != 0 && !w.types[e.Type] {
+		return false
+	}
+	if len(w.nodes) != 0 && (e.Node == 0 || !w.nodes[e.Node]) {
+		return false
+	}
+	return true
+}
+
+//push queues the matching events. Returns false if the watcher has fallen too
+//far behind and needs to be evicted.
+func (w *ringEventWatcher) push(events []*pb.RingEvent) bool {
+	w.Lock()
+	queued := false
+	for _, e := range events {
+		if w.matches(e) {
+			w.queue = append(w.queue, e)
+			queued = true
+		}
+	}
+	overflowed := len(w.queue) > maxQueuedRingEvents
+	w.Unlock()
+	if queued {
+		select {
+		case w.ready <- struct{}{}:
+		default:
+		}
+	}
+	return !overflowed
+}
+
+//pop returns the queued events and clears the queue.
+func (w *ringEventWatcher) pop() []*pb.RingEvent {
+	w.Lock()
+	defer w.Unlock()
+	events := w.queue
+	w.queue = nil
+	return events
+}
+
+func (s *Server) addRingEventWatcher(w *ringEventWatcher) {
+	s.eventWatchers.Lock()
+	s.eventWatchers.watchers[w] = true
+	s.eventWatchers.Unlock()
+}
+
+func (s *Server) removeRingEventWatcher(w *ringEventWatcher) {
+	s.eventWatchers.Lock()
+	defer s.eventWatchers.Unlock()
+	if !s.eventWatchers.watchers[w] {
+		return
+	}
+	delete(s.eventWatchers.watchers, w)
+	close(w.done)
+}
+
+//publishRingEvents queues the events on all the watchers, evicting any that have
+//fallen too far behind.
+func (s *Server) publishRingEvents(events []*pb.RingEvent) {
+	if len(events) == 0 {
+		return
+	}
+	var evict []*ringEventWatcher
+	s.eventWatchers.RLock()
+	for w := range s.eventWatchers.watchers {
+		if !w.push(events) {
+			evict = append(evict, w)
+		}
+	}
+	s.eventWatchers.RUnlock()
+	for _, w := range evict {
+		s.ctxlog.Warning("evicting ring event watcher that fell too far behind")
+		s.removeRingEventWatcher(w)
+	}
+}
+
+//ringEvents returns the events that turned the from ring into the to ring: replica count
+//and config changes first, then the node changes ordered by node id.
+func ringEvents(from, to ring.Ring) []*pb.RingEvent {
+	var events []*pb.RingEvent
+	event := func(t pb.RingEventType) *pb.RingEvent {
+		e := &pb.RingEvent{Type: t, Version: to.Version(), PreviousVersion: from.Version()}
+		events = append(events, e)
+		return e
+	}
+	if from.ReplicaCount() != to.ReplicaCount() {
+		e := event(pb.RingEventType_REPLICAS_CHANGED)
+		e.OldReplicas = int32(from.ReplicaCount())
+		e.NewReplicas = int32(to.ReplicaCount())
+	}
+	if !bytes.Equal(from.Config(), to.Config()) {
+		e := event(pb.RingEventType_CONFIG_CHANGED)
+		e.OldConf = from.Config()
+		e.NewConf = to.Config()
+	}
+
+	ids := make(map[uint64]bool)
+	for _, n := range from.Nodes() {
+		ids[n.ID()] = true
+	}
+	for _, n := range to.Nodes() {
+		ids[n.ID()] = true
+	}
+	sorted := make([]uint64, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Sort(uint64Slice(sorted))
+
+	for _, id := range sorted {
+		o, n := from.Node(id), to.Node(id)
+		nodeEvent := func(t pb.RingEventType) {
+			e := event(t)
+			e.Node = id
+			if o != nil {
+				e.OldNode = nodeToPb(o)
+			}
+			if n != nil {
+				e.NewNode = nodeToPb(n)
+			}
+		}
+		switch {
+		case o == nil:
+			nodeEvent(pb.RingEventType_NODE_ADDED)
+			continue
+		case n == nil:
+			nodeEvent(pb.RingEventType_NODE_REMOVED)
+			continue
+		}
+		if o.Active() != n.Active() {
+			if n.Active() {
+				nodeEvent(pb.RingEventType_NODE_ACTIVATED)
+			} else {
+				nodeEvent(pb.RingEventType_NODE_DEACTIVATED)
+			}
+		}
+		if o.Capacity() != n.Capacity() {
+			nodeEvent(pb.RingEventType_CAPACITY_CHANGED)
+		}
+		if !stringsEqual(o.Tiers(), n.Tiers()) {
+			nodeEvent(pb.RingEventType_TIERS_CHANGED)
+		}
+		if !stringsEqual(o.Addresses(), n.Addresses()) {
+			nodeEvent(pb.RingEventType_ADDRESSES_CHANGED)
+		}
+	}
+	return events
+}
+
+type uint64Slice []uint64
+
+func (u uint64Slice) Len() int           { return len(u) }
+func (u uint64Slice) Swap(i, j int)      { u[i], u[j] = u[j], u[i] }
+func (u uint64Slice) Less(i, j int) bool { return u[i] < u[j] }
@@ -0,0 +1,234 @@
+package syndicate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type fakeEventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   chan *pb.RingEvent
+}
+
+func newFakeEventStream() *fakeEventStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fakeEventStream{ctx: ctx, cancel: cancel, sent: make(chan *pb.RingEvent, 100)}
+}
+
+func (f *fakeEventStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeEventStream) Send(e *pb.RingEvent) error {
+	select {
+	case f.sent <- e:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+func (f *fakeEventStream) next(t *testing.T) *pb.RingEvent {
+	select {
+	case e := <-f.sent:
+		return e
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timed out waiting for ring event")
+	}
+	return nil
+}
+
+func eventTypes(events []*pb.RingEvent) []pb.RingEventType {
+	var types []pb.RingEventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestRingEvents(t *testing.T) {
+	b := ring.NewBuilder(64)
+	b.SetReplicaCount(2)
+	n1, _ := b.AddNode(true, 100, []string{"server1", "rack1"}, []string{"10.0.0.1:8001"}, "server1", []byte(""))
+	n2, _ := b.AddNode(true, 100, []string{"server2", "rack1"}, []string{"10.0.0.2:8001"}, "server2", []byte(""))
+	n3, _ := b.AddNode(false, 100, []string{"server3", "rack2"}, []string{"10.0.0.3:8001"}, "server3", []byte(""))
+	from := b.Ring()
+
+	if events := ringEvents(from, from); len(events) != 0 {
+		t.Errorf("ringEvents of a ring with itself should be empty: %v", eventTypes(events))
+	}
+
+	b.SetReplicaCount(3)
+	b.SetConfig([]byte("newconf"))
+	b.Node(n1.ID()).SetActive(false)
+	b.Node(n1.ID()).SetCapacity(200)
+	b.Node(n1.ID()).ReplaceTiers([]string{"server1", "rack2"})
+	b.Node(n1.ID()).ReplaceAddresses([]string{"10.0.0.11:8001"})
+	b.Node(n3.ID()).SetActive(true)
+	b.RemoveNode(n2.ID())
+	n4, _ := b.AddNode(true, 100, []string{"server4", "rack3"}, []string{"10.0.0.4:8001"}, "server4", []byte(""))
+	to := b.Ring()
+
+	events := ringEvents(from, to)
+	byType := make(map[pb.RingEventType][]*pb.RingEvent)
+	for i, e := range events {
+		if e.Version != to.Version() || e.PreviousVersion != from.Version() {
+			t.Errorf("event %d has versions %d/%d, expected %d/%d", i, e.Version, e.PreviousVersion, to.Version(), from.Version())
+		}
+		if i > 1 && events[i-1].Node > e.Node {
+			t.Errorf("node events not ordered by node id: %v", eventTypes(events))
+		}
+		byType[e.Type] = append(byType[e.Type], e)
+	}
+	if len(events) != 9 || events[0].Type != pb.RingEventType_REPLICAS_CHANGED || events[1].Type != pb.RingEventType_CONFIG_CHANGED {
+		t.Fatalf("ringEvents returned unexpected events: %v", eventTypes(events))
+	}
+	if e := events[0]; e.OldReplicas != 2 || e.NewReplicas != 3 || e.Node != 0 {
+		t.Errorf("unexpected replicas event: %#v", e)
+	}
+	if e := events[1]; string(e.OldConf) != "" || string(e.NewConf) != "newconf" {
+		t.Errorf("unexpected config event: %#v", e)
+	}
+
+	expected := map[pb.RingEventType]uint64{
+		pb.RingEventType_NODE_DEACTIVATED:  n1.ID(),
+		pb.RingEventType_CAPACITY_CHANGED:  n1.ID(),
+		pb.RingEventType_TIERS_CHANGED:     n1.ID(),
+		pb.RingEventType_ADDRESSES_CHANGED: n1.ID(),
+		pb.RingEventType_NODE_REMOVED:      n2.ID(),
+		pb.RingEventType_NODE_ACTIVATED:    n3.ID(),
+		pb.RingEventType_NODE_ADDED:        n4.ID(),
+	}
+	for typ, id := range expected {
+		if len(byType[typ]) != 1 || byType[typ][0].Node != id {
+			t.Errorf("expected one %s event for node %d, got %v", typ, id, byType[typ])
+		}
+	}
+	if e := byType[pb.RingEventType_CAPACITY_CHANGED][0]; e.OldNode.Capacity != 100 || e.NewNode.Capacity != 200 {
+		t.Errorf("capacity event should carry the old and new node: %#v", e)
+	}
+	if e := byType[pb.RingEventType_NODE_REMOVED][0]; e.OldNode == nil || e.NewNode != nil {
+		t.Errorf("removed event should only carry the old node: %#v", e)
+	}
+	if e := byType[pb.RingEventType_NODE_ADDED][0]; e.OldNode != nil || e.NewNode == nil || e.NewNode.Meta != "server4" {
+		t.Errorf("added event should only carry the new node: %#v", e)
+	}
+}
+
+func TestRingEventWatcher(t *testing.T) {
+	if _, err := newRingEventWatcher(&pb.RingEventFilter{Types: []pb.RingEventType{pb.RingEventType_UNKNOWN_EVENT}}); err == nil {
+		t.Errorf("newRingEventWatcher should reject the unknown event type")
+	}
+	if _, err := newRingEventWatcher(&pb.RingEventFilter{Types: []pb.RingEventType{42}}); err == nil {
+		t.Errorf("newRingEventWatcher should reject invalid event types")
+	}
+
+	events := []*pb.RingEvent{
+		{Type: pb.RingEventType_REPLICAS_CHANGED},
+		{Type: pb.RingEventType_NODE_ADDED, Node: 1},
+		{Type: pb.RingEventType_CAPACITY_CHANGED, Node: 1},
+		{Type: pb.RingEventType_CAPACITY_CHANGED, Node: 2},
+	}
+	tests := []struct {
+		filter   *pb.RingEventFilter
+		expected int
+	}{
+		{&pb.RingEventFilter{}, 4},
+		{&pb.RingEventFilter{Types: []pb.RingEventType{pb.RingEventType_CAPACITY_CHANGED}}, 2},
+		{&pb.RingEventFilter{Nodes: []uint64{1}}, 2},
+		{&pb.RingEventFilter{Types: []pb.RingEventType{pb.RingEventType_CAPACITY_CHANGED}, Nodes: []uint64{2}}, 1},
+		{&pb.RingEventFilter{Types: []pb.RingEventType{pb.RingEventType_NODE_REMOVED}}, 0},
+	}
+	for _, test := range tests {
+		w, err := newRingEventWatcher(test.filter)
+		if err != nil {
+			t.Fatalf("newRingEventWatcher(%v) returned unexpected error: %s", test.filter, err)
+		}
+		w.push(events)
+		if got := w.pop(); len(got) != test.expected {
+			t.Errorf("watcher with filter %v queued %d events, expected %d", test.filter, len(got), test.expected)
+		}
+	}
+
+	w, _ := newRingEventWatcher(&pb.RingEventFilter{})
+	for i := 0; i < maxQueuedRingEvents/len(events); i++ {
+		if !w.push(events) {
+			t.Fatalf("watcher overflowed early after %d events", (i+1)*len(events))
+		}
+	}
+	if w.push(events) {
+		t.Errorf("watcher should have overflowed after %d events", maxQueuedRingEvents+len(events))
+	}
+}
+
+func TestServer_WatchRingEvents(t *testing.T) {
+	s, m := newTestServerWithDefaults()
+	s.cfg.RingSubscriberTimeout = DefaultRingSubscriberTimeout
+	id := s.r.Nodes()[0].ID()
+
+	if err := s.WatchRingEvents(&pb.RingEventFilter{Types: []pb.RingEventType{42}}, newFakeEventStream()); err == nil {
+		t.Errorf("WatchRingEvents with an invalid filter should have errored")
+	}
+
+	all := newFakeEventStream()
+	defer all.cancel()
+	deactivated := newFakeEventStream()
+	go s.WatchRingEvents(&pb.RingEventFilter{}, all)
+	deactivatedErrc := make(chan error, 1)
+	go func() {
+		deactivatedErrc <- s.WatchRingEvents(&pb.RingEventFilter{Types: []pb.RingEventType{pb.RingEventType_NODE_DEACTIVATED}, Nodes: []uint64{id}}, deactivated)
+	}()
+	for i := 0; i < 100; i++ {
+		s.eventWatchers.RLock()
+		n := len(s.eventWatchers.watchers)
+		s.eventWatchers.RUnlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	m.builder.Node(id).SetCapacity(42)
+	m.builder.Node(id).SetActive(false)
+	change := &RingChange{r: m.builder.Ring(), b: m.builder}
+	change.v = change.r.Version()
+	prev := s.r.Version()
+	if err := s.applyRingChange(change); err != nil {
+		t.Fatalf("applyRingChange returned unexpected error: %s", err)
+	}
+
+	if e := all.next(t); e.Type != pb.RingEventType_NODE_DEACTIVATED || e.Node != id || e.Version != change.v || e.PreviousVersion != prev {
+		t.Errorf("unexpected first event: %#v", e)
+	}
+	if e := all.next(t); e.Type != pb.RingEventType_CAPACITY_CHANGED || e.OldNode.Capacity != 1 || e.NewNode.Capacity != 42 {
+		t.Errorf("unexpected second event: %#v", e)
+	}
+	if e := deactivated.next(t); e.Type != pb.RingEventType_NODE_DEACTIVATED || e.Node != id {
+		t.Errorf("unexpected filtered event: %#v", e)
+	}
+	select {
+	case e := <-deactivated.sent:
+		t.Errorf("filtered watcher got unexpected event: %#v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	deactivated.cancel()
+	select {
+	case <-deactivatedErrc:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("WatchRingEvents didn't return after its stream finished")
+	}
+	s.eventWatchers.RLock()
+	n := len(s.eventWatchers.watchers)
+	s.eventWatchers.RUnlock()
+	if n != 1 {
+		t.Errorf("finished watcher should have been removed, %d watchers left", n)
+	}
+}
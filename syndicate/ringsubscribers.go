@@ -2,56 +2,170 @@ package syndicate
 
 import (
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	pb "github.com/pandemicsyn/syndicate/api/proto"
 )
 
 type RingSubscribers struct {
 	sync.RWMutex
-	subs map[string]chan *pb.Ring
+	subs map[string]*ringSubscriber
 }
 
-func (s *Server) addRingSubscriber(id string) chan *pb.Ring {
+//ringSubscriber is the queue of rings waiting to be sent to a single GetRingStream
+//subscriber. Only the newest pending ring is kept, a newer version supersedes any
+//older one that hasn't been sent yet so a slow subscriber never builds up a backlog.
+type ringSubscriber struct {
+	sync.Mutex
+	pending *pb.Ring
+	ready   chan struct{} //signaled when a new pending ring is available
+	done    chan struct{} //closed when the subscriber is removed
+	// what ListSubscribers reports
+	id          string
+	peer        string
+	deltas      bool
+	connected   time.Time
+	lastVersion int64
+	lastSend    time.Time
+}
+
+func newRingSubscriber() *ringSubscriber {
+	return &ringSubscriber{
+		ready: make(chan struct{}, 1),
+		done:  make(chan struct{}),
+	}
+}
+
+//push queues the ring for sending, replacing any older pending ring.
+func (rs *ringSubscriber) push(r *pb.Ring) {
+	rs.Lock()
+	if rs.pending == nil || r.Version > rs.pending.Version {
+		rs.pending = r
+	}
+	rs.Unlock()
+	select {
+	case rs.ready <- struct{}{}:
+	default:
+	}
+}
+
+//pop returns the pending ring (if any) and clears it.
+func (rs *ringSubscriber) pop() *pb.Ring {
+	rs.Lock()
+	defer rs.Unlock()
+	r := rs.pending
+	rs.pending = nil
+	return r
+}
+
+//delivered records that the given ring version was sent to the subscriber.
+func (rs *ringSubscriber) delivered(version int64) {
+	rs.Lock()
+	rs.lastVersion = version
+	rs.lastSend = time.Now()
+	rs.Unlock()
+}
+
+func (rs *ringSubscriber) info() *pb.SubscriberInfo {
+	rs.Lock()
+	defer rs.Unlock()
+	i := &pb.SubscriberInfo{
+		Id:          rs.id,
+		Peer:        rs.peer,
+		Deltas:      rs.deltas,
+		Connected:   rs.connected.Unix(),
+		LastVersion: rs.lastVersion,
+	}
+	if !rs.lastSend.IsZero() {
+		i.LastSend = rs.lastSend.Unix()
+	}
+	return i
+}
+
+//addRingSubscriber registers a new subscriber under the given id. If one
+//was already registered under the id its removed and its stream ends.
+func (s *Server) addRingSubscriber(id, peer string, deltas bool) *ringSubscriber {
 	s.ringSubs.Lock()
 	defer s.ringSubs.Unlock()
-	c, exists := s.ringSubs.subs[id]
+	sub, exists := s.ringSubs.subs[id]
 	if exists {
-		close(c)
-		s.ctxlog.WithField("id", id).Debug("ring subscriber entry already existed, closed origin chan")
+		close(sub.done)
+		s.ctxlog.WithField("id", id).Debug("ring subscriber entry already existed, closed original subscriber")
+	} else {
+		s.metrics.subscriberNodes.Inc()
 	}
-	s.ringSubs.subs[id] = make(chan *pb.Ring, 1)
-	s.metrics.subscriberNodes.Inc()
-	return s.ringSubs.subs[id]
+	sub = newRingSubscriber()
+	sub.id = id
+	sub.peer = peer
+	sub.deltas = deltas
+	sub.connected = time.Now()
+	s.ringSubs.subs[id] = sub
+	return sub
 }
 
+//removeRingSubscriber removes the subscriber registered under the given id.
 func (s *Server) removeRingSubscriber(id string) error {
 	s.ringSubs.Lock()
 	defer s.ringSubs.Unlock()
-	c, ok := s.ringSubs.subs[id]
+	sub, ok := s.ringSubs.subs[id]
 	if !ok {
 		return fmt.Errorf("subscriber id not present")
 	}
-	close(c)
+	close(sub.done)
 	delete(s.ringSubs.subs, id)
 	s.metrics.subscriberNodes.Dec()
 	return nil
 }
 
+//ringSubscribers returns the info for all the registered subscribers sorted by id.
+func (s *Server) ringSubscribers() []*pb.SubscriberInfo {
+	s.ringSubs.RLock()
+	defer s.ringSubs.RUnlock()
+	subs := make([]*pb.SubscriberInfo, 0, len(s.ringSubs.subs))
+	for _, sub := range s.ringSubs.subs {
+		subs = append(subs, sub.info())
+	}
+	sort.Sort(subscribersByID(subs))
+	return subs
+}
+
+type subscribersByID []*pb.SubscriberInfo
+
+func (s subscribersByID) Len() int           { return len(s) }
+func (s subscribersByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s subscribersByID) Less(i, j int) bool { return s[i].Id < s[j].Id }
+
+//dropRingSubscriber removes the given subscriber, but only if its still the one
+//registered under the id (it may have since been replaced by a new subscriber).
+func (s *Server) dropRingSubscriber(id string, sub *ringSubscriber) {
+	s.ringSubs.Lock()
+	defer s.ringSubs.Unlock()
+	if s.ringSubs.subs[id] != sub {
+		return
+	}
+	close(sub.done)
+	delete(s.ringSubs.subs, id)
+	s.metrics.subscriberNodes.Dec()
+}
+
 //ringSubscribersNotify listens for ring changes on s.subsChangeChan,
-// and distributes them out to the chan's used by connected GetRingStream
-// instances
+// and queues them on the subscribers used by connected GetRingStream
+// instances. When possible the delta from the previous ring version is
+// included, GetRingStream decides whether to send it or the full ring.
 func (s *Server) ringSubscribersNotify() {
 	for change := range s.subsChangeChan {
-		s.ringSubs.RLock()
 		ring := &pb.Ring{
 			Ring:    *change.rb,
 			Version: change.v,
 		}
-		for id, ch := range s.ringSubs.subs {
-			go func(id string, ch chan *pb.Ring, ring *pb.Ring) {
-				ch <- ring
-			}(id, ch, ring)
+		if change.prev != nil && change.r != nil {
+			ring.Delta = compactDelta(change.prev, change.r, len(*change.rb))
+		}
+		s.ringSubs.RLock()
+		for _, sub := range s.ringSubs.subs {
+			sub.push(ring)
 		}
 		s.ringSubs.RUnlock()
 	}
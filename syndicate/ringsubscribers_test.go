@@ -0,0 +1,251 @@
+package syndicate
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+//fakeRingStream is a Syndicate_GetRingStreamServer that hands sent rings
+//to the test over the sent chan, blocking if the test isn't receiving.
+type fakeRingStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   chan *pb.Ring
+}
+
+func newFakeRingStream(buffer int) *fakeRingStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fakeRingStream{ctx: ctx, cancel: cancel, sent: make(chan *pb.Ring, buffer)}
+}
+
+func (f *fakeRingStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeRingStream) Send(r *pb.Ring) error {
+	select {
+	case f.sent <- r:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+func (f *fakeRingStream) next(t *testing.T) *pb.Ring {
+	select {
+	case r := <-f.sent:
+		return r
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timed out waiting for ring stream send")
+	}
+	return nil
+}
+
+//startRingStream starts a GetRingStream for the id, waits for the initial send
+//and returns the stream and a chan that gets GetRingStream's return value.
+func startRingStream(t *testing.T, s *Server, id string, buffer int) (*fakeRingStream, chan error) {
+	stream := newFakeRingStream(buffer)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.GetRingStream(&pb.SubscriberID{Id: id}, stream)
+	}()
+	if r := stream.next(t); r.Version != s.r.Version() {
+		t.Fatalf("initial ring version %d, expected %d", r.Version, s.r.Version())
+	}
+	return stream, errc
+}
+
+func waitForStreamEnd(t *testing.T, errc chan error) error {
+	select {
+	case err := <-errc:
+		return err
+	case <-time.After(3 * time.Second):
+		t.Fatalf("timed out waiting for GetRingStream to return")
+	}
+	return nil
+}
+
+func subscriber(s *Server, id string) *ringSubscriber {
+	s.ringSubs.RLock()
+	defer s.ringSubs.RUnlock()
+	return s.ringSubs.subs[id]
+}
+
+func TestRingSubscriber_Push(t *testing.T) {
+	rs := newRingSubscriber()
+	if rs.pop() != nil {
+		t.Errorf("pop on an empty subscriber should return nil")
+	}
+	rs.push(&pb.Ring{Version: 2})
+	rs.push(&pb.Ring{Version: 1})
+	rs.push(&pb.Ring{Version: 3})
+	select {
+	case <-rs.ready:
+	default:
+		t.Errorf("push should have signaled ready")
+	}
+	select {
+	case <-rs.ready:
+		t.Errorf("ready should only be signaled once while a ring is pending")
+	default:
+	}
+	if r := rs.pop(); r == nil || r.Version != 3 {
+		t.Errorf("pop should have returned the newest pending ring, got %#v", r)
+	}
+	if rs.pop() != nil {
+		t.Errorf("pop should have cleared the pending ring")
+	}
+}
+
+func TestServer_GetRingStreamOrdering(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	s.cfg.RingSubscriberTimeout = DefaultRingSubscriberTimeout
+	v := s.r.Version()
+	stream, errc := startRingStream(t, s, "sub", 0)
+	sub := subscriber(s, "sub")
+
+	//versions at or below what the subscriber already has are never sent
+	sub.push(&pb.Ring{Version: v})
+	sub.push(&pb.Ring{Version: v + 5})
+	if r := stream.next(t); r.Version != v+5 {
+		t.Errorf("expected version %d, got %d", v+5, r.Version)
+	}
+	sub.push(&pb.Ring{Version: v + 4})
+	sub.push(&pb.Ring{Version: v + 6})
+	if r := stream.next(t); r.Version != v+6 {
+		t.Errorf("expected version %d after an out of order push, got %d", v+6, r.Version)
+	}
+
+	//a subscriber that isn't keeping up skips to the newest version
+	for i := int64(7); i <= 20; i++ {
+		sub.push(&pb.Ring{Version: v + i})
+	}
+	last := v + 6
+	for last != v+20 {
+		r := stream.next(t)
+		if r.Version <= last {
+			t.Fatalf("ring versions not increasing: %d after %d", r.Version, last)
+		}
+		last = r.Version
+	}
+	select {
+	case r := <-stream.sent:
+		t.Errorf("unexpected send of version %d after the newest version", r.Version)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	stream.cancel()
+	if err := waitForStreamEnd(t, errc); err == nil {
+		t.Errorf("GetRingStream should return an error when the stream context is done")
+	}
+	if subscriber(s, "sub") != nil {
+		t.Errorf("subscriber should have been removed when its stream finished")
+	}
+}
+
+func TestServer_GetRingStreamEviction(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	s.cfg.RingSubscriberTimeout = 1
+	stream, errc := startRingStream(t, s, "slow", 0)
+	defer stream.cancel()
+
+	//nothing ever receives from the stream again so the send blocks until evicted
+	subscriber(s, "slow").push(&pb.Ring{Version: s.r.Version() + 1})
+	if err := waitForStreamEnd(t, errc); err != SubscriberTimeout {
+		t.Errorf("GetRingStream should have returned SubscriberTimeout, got %v", err)
+	}
+	if subscriber(s, "slow") != nil {
+		t.Errorf("slow subscriber should have been evicted")
+	}
+}
+
+func TestServer_GetRingStreamReplaced(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	s.cfg.RingSubscriberTimeout = DefaultRingSubscriberTimeout
+	first, firstErrc := startRingStream(t, s, "dup", 0)
+	defer first.cancel()
+	second, secondErrc := startRingStream(t, s, "dup", 0)
+
+	if err := waitForStreamEnd(t, firstErrc); err == nil {
+		t.Errorf("replaced GetRingStream should have returned an error")
+	}
+	sub := subscriber(s, "dup")
+	if sub == nil {
+		t.Fatalf("replacement subscriber should still be registered")
+	}
+	sub.push(&pb.Ring{Version: s.r.Version() + 1})
+	if r := second.next(t); r.Version != s.r.Version()+1 {
+		t.Errorf("replacement stream got version %d, expected %d", r.Version, s.r.Version()+1)
+	}
+
+	if err := s.removeRingSubscriber("dup"); err != nil {
+		t.Errorf("removeRingSubscriber returned unexpected error: %s", err)
+	}
+	if err := waitForStreamEnd(t, secondErrc); err == nil {
+		t.Errorf("removed GetRingStream should have returned an error")
+	}
+	if err := s.removeRingSubscriber("dup"); err == nil {
+		t.Errorf("removeRingSubscriber of a missing id should have errored")
+	}
+}
+
+func TestServer_ListSubscribers(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	s.cfg.RingSubscriberTimeout = DefaultRingSubscriberTimeout
+	ctx := context.Background()
+
+	res, err := s.ListSubscribers(ctx, &pb.EmptyMsg{})
+	if err != nil || res.Version != s.r.Version() || len(res.Subscribers) != 0 {
+		t.Errorf("ListSubscribers with no subscribers returned %#v, %v", res, err)
+	}
+
+	before := time.Now().Unix()
+	b, bErrc := startRingStream(t, s, "b", 0)
+	defer b.cancel()
+	a, aErrc := startRingStream(t, s, "a", 0)
+	defer a.cancel()
+	subscriber(s, "b").push(&pb.Ring{Version: s.r.Version() + 1})
+	b.next(t)
+
+	res, err = s.ListSubscribers(ctx, &pb.EmptyMsg{})
+	if err != nil {
+		t.Fatalf("ListSubscribers returned unexpected error: %s", err)
+	}
+	if len(res.Subscribers) != 2 || res.Subscribers[0].Id != "a" || res.Subscribers[1].Id != "b" {
+		t.Fatalf("ListSubscribers returned unexpected subscribers: %v", res.Subscribers)
+	}
+	//deliveries are recorded once the sends return, wait for them
+	for i := 0; i < 100 && (res.Subscribers[0].LastSend == 0 || res.Subscribers[1].LastVersion != s.r.Version()+1); i++ {
+		time.Sleep(10 * time.Millisecond)
+		res, _ = s.ListSubscribers(ctx, &pb.EmptyMsg{})
+	}
+	for _, sub := range res.Subscribers {
+		if sub.Peer != "unknown" || sub.Connected < before || sub.LastSend < before {
+			t.Errorf("ListSubscribers returned unexpected subscriber info: %#v", sub)
+		}
+	}
+	if res.Subscribers[0].LastVersion != s.r.Version() || res.Subscribers[1].LastVersion != s.r.Version()+1 {
+		t.Errorf("ListSubscribers returned unexpected last versions: %d %d", res.Subscribers[0].LastVersion, res.Subscribers[1].LastVersion)
+	}
+
+	if _, err = s.DisconnectSubscriber(ctx, &pb.SubscriberID{Id: "a"}); err != nil {
+		t.Errorf("DisconnectSubscriber returned unexpected error: %s", err)
+	}
+	if err = waitForStreamEnd(t, aErrc); err == nil {
+		t.Errorf("disconnected GetRingStream should have returned an error")
+	}
+	if _, err = s.DisconnectSubscriber(ctx, &pb.SubscriberID{Id: "a"}); err == nil {
+		t.Errorf("DisconnectSubscriber of a missing subscriber should have errored")
+	}
+	res, _ = s.ListSubscribers(ctx, &pb.EmptyMsg{})
+	if len(res.Subscribers) != 1 || res.Subscribers[0].Id != "b" {
+		t.Errorf("ListSubscribers after disconnect returned %v", res.Subscribers)
+	}
+	b.cancel()
+	waitForStreamEnd(t, bErrc)
+}
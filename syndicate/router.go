@@ -0,0 +1,520 @@
+package syndicate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+//ServiceMetadataKey is the request metadata key naming the service a request is for.
+const ServiceMetadataKey = "service"
+
+var NoServiceName = errors.New("No service named in the request metadata")
+
+//ServiceRouter serves every service run by a synd process from a single listener,
+//handing each request to the Server of the service named in its metadata (see
+//ServiceMetadataKey), or to the default service if it doesn't name one.
+type ServiceRouter struct {
+	services map[string]*Server
+	def      string
+	reloader *ConfigReloader
+}
+
+//NewServiceRouter returns a router for the given services. def is the service
+//requests that don't name one go to, if empty they're refused.
+func NewServiceRouter(services map[string]*Server, def string) *ServiceRouter {
+	return &ServiceRouter{services: services, def: def}
+}
+
+//service returns the Server for the service named in the request metadata.
+func (r *ServiceRouter) service(c context.Context) (*Server, error) {
+	name := r.def
+	if md, ok := metadata.FromContext(c); ok && len(md[ServiceMetadataKey]) > 0 {
+		name = md[ServiceMetadataKey][0]
+	}
+	if name == "" {
+		return nil, NoServiceName
+	}
+	s, ok := r.services[name]
+	if !ok {
+		return nil, fmt.Errorf("Unknown service %q", name)
+	}
+	return s, nil
+}
+
+//ListServices lists every service the router serves.
+func (r *ServiceRouter) ListServices(c context.Context, e *pb.EmptyMsg) (*pb.ServiceList, error) {
+	names := make([]string, 0, len(r.services))
+	for name := range r.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := &pb.ServiceList{}
+	for _, name := range names {
+		info := r.services[name].serviceInfo()
+		info.Default = name == r.def
+		list.Services = append(list.Services, info)
+	}
+	return list, nil
+}
+
+//SetConfigReloader enables the ReloadConfig RPC.
+func (r *ServiceRouter) SetConfigReloader(c *ConfigReloader) {
+	r.reloader = c
+}
+
+//ReloadConfig re-reads the config file and applies what it can to the running
+//services, see ConfigReloader.Reload.
+func (r *ServiceRouter) ReloadConfig(c context.Context, e *pb.EmptyMsg) (*pb.ConfigReloadResult, error) {
+	if r.reloader == nil {
+		return &pb.ConfigReloadResult{}, NoConfigReloader
+	}
+	log.WithField("caller", callerFromContext(c)).Info("config reload requested")
+	return r.reloader.Reload()
+}
+
+//RegisterNodeMulti registers a host with several of the services the router
+//serves at once, see registerMulti.
+func (r *ServiceRouter) RegisterNodeMulti(c context.Context, m *pb.RegisterMultiRequest) (*pb.RegisterMultiResult, error) {
+	return registerMulti(c, r.services, m)
+}
+
+//ListServices lists just this service, a ServiceRouter lists all the services it serves.
+func (s *Server) ListServices(c context.Context, e *pb.EmptyMsg) (*pb.ServiceList, error) {
+	info := s.serviceInfo()
+	info.Default = true
+	return &pb.ServiceList{Services: []*pb.ServiceInfo{info}}, nil
+}
+
+func (s *Server) serviceInfo() *pb.ServiceInfo {
+	s.RLock()
+	defer s.RUnlock()
+	return &pb.ServiceInfo{
+		Name:    s.servicename,
+		Port:    int32(s.cfg.Port),
+		Version: s.r.Version(),
+		Master:  s.cfg.Master,
+		Nodes:   uint32(len(s.r.Nodes())),
+	}
+}
+
+func (r *ServiceRouter) AddNode(c context.Context, m *pb.Node) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.AddNode(c, m)
+}
+
+func (r *ServiceRouter) RemoveNode(c context.Context, m *pb.Node) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.RemoveNode(c, m)
+}
+
+func (r *ServiceRouter) ModNode(c context.Context, m *pb.ModifyMsg) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ModNode(c, m)
+}
+
+func (r *ServiceRouter) SetConf(c context.Context, m *pb.Conf) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetConf(c, m)
+}
+
+func (r *ServiceRouter) SetReplicas(c context.Context, m *pb.RingOpts) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetReplicas(c, m)
+}
+
+func (r *ServiceRouter) SetActive(c context.Context, m *pb.Node) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetActive(c, m)
+}
+
+func (r *ServiceRouter) SetCapacity(c context.Context, m *pb.Node) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetCapacity(c, m)
+}
+
+func (r *ServiceRouter) ReplaceAddresses(c context.Context, m *pb.Node) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReplaceAddresses(c, m)
+}
+
+func (r *ServiceRouter) ReplaceTiers(c context.Context, m *pb.Node) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReplaceTiers(c, m)
+}
+
+func (r *ServiceRouter) GetVersion(c context.Context, m *pb.EmptyMsg) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetVersion(c, m)
+}
+
+func (r *ServiceRouter) GetGlobalConfig(c context.Context, m *pb.EmptyMsg) (*pb.RingConf, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGlobalConfig(c, m)
+}
+
+func (r *ServiceRouter) GetNodeConfig(c context.Context, m *pb.Node) (*pb.RingConf, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetNodeConfig(c, m)
+}
+
+func (r *ServiceRouter) GetNodeSoftwareVersion(c context.Context, m *pb.Node) (*pb.NodeSoftwareVersion, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetNodeSoftwareVersion(c, m)
+}
+
+func (r *ServiceRouter) NodeUpgradeSoftwareVersion(c context.Context, m *pb.NodeUpgrade) (*pb.NodeUpgradeStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.NodeUpgradeSoftwareVersion(c, m)
+}
+
+func (r *ServiceRouter) GetAllSoftwareVersions(c context.Context, m *pb.EmptyMsg) (*pb.SoftwareVersions, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAllSoftwareVersions(c, m)
+}
+
+func (r *ServiceRouter) UpgradeCluster(c context.Context, m *pb.UpgradeRequest) (*pb.UpgradeStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpgradeCluster(c, m)
+}
+
+func (r *ServiceRouter) GetUpgradeStatus(c context.Context, m *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUpgradeStatus(c, m)
+}
+
+func (r *ServiceRouter) WatchUpgrade(m *pb.EmptyMsg, stream pb.Syndicate_WatchUpgradeServer) error {
+	s, err := r.service(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.WatchUpgrade(m, stream)
+}
+
+func (r *ServiceRouter) PauseUpgrade(c context.Context, m *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.PauseUpgrade(c, m)
+}
+
+func (r *ServiceRouter) ResumeUpgrade(c context.Context, m *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ResumeUpgrade(c, m)
+}
+
+func (r *ServiceRouter) AbortUpgrade(c context.Context, m *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.AbortUpgrade(c, m)
+}
+
+func (r *ServiceRouter) StartNodes(c context.Context, m *pb.NodeControlRequest) (*pb.NodeControlResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.StartNodes(c, m)
+}
+
+func (r *ServiceRouter) StopNodes(c context.Context, m *pb.NodeControlRequest) (*pb.NodeControlResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.StopNodes(c, m)
+}
+
+func (r *ServiceRouter) RestartNodes(c context.Context, m *pb.NodeControlRequest) (*pb.NodeControlResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.RestartNodes(c, m)
+}
+
+func (r *ServiceRouter) SetMaintenance(c context.Context, m *pb.MaintenanceRequest) (*pb.MaintenanceStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetMaintenance(c, m)
+}
+
+func (r *ServiceRouter) ClearMaintenance(c context.Context, m *pb.MaintenanceRequest) (*pb.MaintenanceStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ClearMaintenance(c, m)
+}
+
+func (r *ServiceRouter) ListMaintenance(c context.Context, m *pb.EmptyMsg) (*pb.MaintenanceList, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListMaintenance(c, m)
+}
+
+func (r *ServiceRouter) RampCapacity(c context.Context, m *pb.RampRequest) (*pb.RampStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.RampCapacity(c, m)
+}
+
+func (r *ServiceRouter) GetRampStatus(c context.Context, m *pb.EmptyMsg) (*pb.RampList, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRampStatus(c, m)
+}
+
+func (r *ServiceRouter) PauseRamp(c context.Context, m *pb.Node) (*pb.RampStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.PauseRamp(c, m)
+}
+
+func (r *ServiceRouter) ResumeRamp(c context.Context, m *pb.Node) (*pb.RampStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ResumeRamp(c, m)
+}
+
+func (r *ServiceRouter) AbortRamp(c context.Context, m *pb.Node) (*pb.RampStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.AbortRamp(c, m)
+}
+
+func (r *ServiceRouter) DecommissionNode(c context.Context, m *pb.DecommissionRequest) (*pb.DecommissionStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.DecommissionNode(c, m)
+}
+
+func (r *ServiceRouter) GetDecommissionStatus(c context.Context, m *pb.EmptyMsg) (*pb.DecommissionList, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetDecommissionStatus(c, m)
+}
+
+func (r *ServiceRouter) SearchNodes(c context.Context, m *pb.Node) (*pb.SearchResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SearchNodes(c, m)
+}
+
+func (r *ServiceRouter) QueryNodes(c context.Context, m *pb.NodeQuery) (*pb.NodeQueryResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.QueryNodes(c, m)
+}
+
+func (r *ServiceRouter) LookupPartition(c context.Context, m *pb.PartitionLookup) (*pb.PartitionLookupResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.LookupPartition(c, m)
+}
+
+func (r *ServiceRouter) LookupKey(c context.Context, m *pb.KeyLookup) (*pb.PartitionLookupResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.LookupKey(c, m)
+}
+
+func (r *ServiceRouter) GetRingStats(c context.Context, m *pb.EmptyMsg) (*pb.RingStats, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRingStats(c, m)
+}
+
+func (r *ServiceRouter) GetRing(c context.Context, m *pb.EmptyMsg) (*pb.Ring, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRing(c, m)
+}
+
+func (r *ServiceRouter) GetRingStream(m *pb.SubscriberID, stream pb.Syndicate_GetRingStreamServer) error {
+	s, err := r.service(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.GetRingStream(m, stream)
+}
+
+func (r *ServiceRouter) WatchRingEvents(m *pb.RingEventFilter, stream pb.Syndicate_WatchRingEventsServer) error {
+	s, err := r.service(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.WatchRingEvents(m, stream)
+}
+
+func (r *ServiceRouter) ListSubscribers(c context.Context, m *pb.EmptyMsg) (*pb.SubscriberList, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListSubscribers(c, m)
+}
+
+func (r *ServiceRouter) DisconnectSubscriber(c context.Context, m *pb.SubscriberID) (*pb.EmptyMsg, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.DisconnectSubscriber(c, m)
+}
+
+func (r *ServiceRouter) RegisterNode(c context.Context, m *pb.RegisterRequest) (*pb.NodeConfig, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.RegisterNode(c, m)
+}
+
+func (r *ServiceRouter) ReplaceNode(c context.Context, m *pb.ReplaceNodeRequest) (*pb.NodeConfig, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReplaceNode(c, m)
+}
+
+func (r *ServiceRouter) GetNodeHardware(c context.Context, m *pb.Node) (*pb.NodeHardware, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetNodeHardware(c, m)
+}
+
+func (r *ServiceRouter) ReportNodeStatus(c context.Context, m *pb.NodeStatusReport) (*pb.RingStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReportNodeStatus(c, m)
+}
+
+func (r *ServiceRouter) GetCapacityPolicy(c context.Context, m *pb.EmptyMsg) (*pb.CapacityPolicyStatus, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCapacityPolicy(c, m)
+}
+
+func (r *ServiceRouter) ApproveCapacityProposal(c context.Context, m *pb.Node) (*pb.CapacityProposal, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.ApproveCapacityProposal(c, m)
+}
+
+func (r *ServiceRouter) RejectCapacityProposal(c context.Context, m *pb.Node) (*pb.CapacityProposal, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.RejectCapacityProposal(c, m)
+}
+
+func (r *ServiceRouter) SimulateRing(c context.Context, m *pb.SimulateRequest) (*pb.SimulateResult, error) {
+	s, err := r.service(c)
+	if err != nil {
+		return nil, err
+	}
+	return s.SimulateRing(c, m)
+}
@@ -0,0 +1,78 @@
+package syndicate
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func serviceContext(service string) context.Context {
+	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	if service == "" {
+		return ctx
+	}
+	return metadata.NewContext(ctx, metadata.Pairs(ServiceMetadataKey, service))
+}
+
+func TestServiceRouter(t *testing.T) {
+	s1, _ := newTestServerWithDefaults()
+	s2, _ := newTestServerWithDefaults()
+	s2.servicename = "other"
+	n := &pb.Node{Active: true, Capacity: 1, Addresses: []string{"10.1.1.1:4242"}, Tiers: []string{"othernode"}}
+	if _, err := s2.AddNode(context.Background(), n); err != nil {
+		t.Fatalf("AddNode returned unexpected error: %s", err)
+	}
+	services := map[string]*Server{"test": s1, "other": s2}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gs := grpc.NewServer()
+	defer gs.Stop()
+	pb.RegisterSyndicateServer(gs, NewServiceRouter(services, "test"))
+	go gs.Serve(l)
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewSyndicateClient(conn)
+
+	for service, nodes := range map[string]uint32{"": 2, "test": 2, "other": 3} {
+		stats, err := client.GetRingStats(serviceContext(service), &pb.EmptyMsg{})
+		if err != nil {
+			t.Errorf("GetRingStats for service %q returned unexpected error: %s", service, err)
+			continue
+		}
+		if stats.ActiveNodes != nodes {
+			t.Errorf("GetRingStats for service %q should have had %d nodes, got %d", service, nodes, stats.ActiveNodes)
+		}
+	}
+	if _, err := client.GetRingStats(serviceContext("nope"), &pb.EmptyMsg{}); err == nil {
+		t.Errorf("GetRingStats for an unknown service should have failed")
+	}
+
+	list, err := client.ListServices(serviceContext(""), &pb.EmptyMsg{})
+	if err != nil {
+		t.Fatalf("ListServices returned unexpected error: %s", err)
+	}
+	if len(list.Services) != 2 || list.Services[0].Name != "other" || list.Services[0].Default || list.Services[0].Nodes != 3 || list.Services[1].Name != "test" || !list.Services[1].Default {
+		t.Errorf("ListServices returned unexpected list: %v", list)
+	}
+
+	//without a default service requests have to name theirs
+	r := NewServiceRouter(services, "")
+	if _, err := r.GetRingStats(context.Background(), &pb.EmptyMsg{}); err != NoServiceName {
+		t.Errorf("GetRingStats without a service should have returned NoServiceName, got: %v", err)
+	}
+	list, err = s1.ListServices(context.Background(), &pb.EmptyMsg{})
+	if err != nil || len(list.Services) != 1 || list.Services[0].Name != "test" || !list.Services[0].Default {
+		t.Errorf("Server.ListServices returned unexpected result: %v, %v", list, err)
+	}
+}
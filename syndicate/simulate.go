@@ -0,0 +1,196 @@
+package syndicate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+//SimulateRing reports how the ring would look with the requested nodes added,
+//removed or given new capacities, without changing (or persisting) anything.
+//Projected fill is based on the disk usage each node last reported.
+func (s *Server) SimulateRing(c context.Context, r *pb.SimulateRequest) (*pb.SimulateResult, error) {
+	s.RLock()
+	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
+	if err == nil {
+		//the builder we get handed isn't necessarily ours to modify
+		b, err = copyBuilder(b)
+	}
+	current := s.r
+	s.RUnlock()
+	if err != nil {
+		s.ctxlog.WithField("error", err).Error("Unable to load builder for simulation")
+		return &pb.SimulateResult{}, err
+	}
+	if r.Path == "" {
+		r.Path = s.cfg.CapacityPolicy.Path
+	}
+	hw := make(map[uint64]*pb.HardwareProfile)
+	s.hwLock.Lock()
+	for id, h := range s.hardware {
+		hw[id] = h.Hardware
+	}
+	s.hwLock.Unlock()
+	res, err := SimulateRing(b, current, r, hw)
+	if err != nil {
+		return &pb.SimulateResult{}, err
+	}
+	s.ctxlog.WithFields(log.Fields{
+		"caller":  callerFromContext(c),
+		"add":     len(r.Add),
+		"remove":  len(r.Remove),
+		"changes": len(r.Capacity),
+		"moved":   res.Moved,
+	}).Info("simulated ring change")
+	return res, nil
+}
+
+//copyBuilder returns a deep copy of b by round tripping it through a temp file.
+func copyBuilder(b *ring.Builder) (*ring.Builder, error) {
+	f, err := ioutil.TempFile("", "syndicate-simulate")
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	if err := ring.PersistRingOrBuilder(nil, b, f.Name()); err != nil {
+		return nil, err
+	}
+	_, c, err := ring.RingOrBuilder(f.Name())
+	return c, err
+}
+
+//SimulateRing applies the hypothetical changes in req to b (so callers should
+//hand it a copy) and compares the resulting ring to current, or to the ring b
+//produces as is if current is nil. hw holds the hardware profiles nodes last
+//reported, used to project how full req.Path (DefaultPolicyPath if empty) on
+//each node would get; it may be nil. Each group of simulated nodes gets a unique
+//tier0 (its meta, "simulated" if empty, plus a sequence number) with the given
+//tiers above that.
+func SimulateRing(b *ring.Builder, current ring.Ring, req *pb.SimulateRequest, hw map[uint64]*pb.HardwareProfile) (*pb.SimulateResult, error) {
+	if current == nil {
+		current = b.Ring()
+	}
+	path := req.Path
+	if path == "" {
+		path = DefaultPolicyPath
+	}
+	for _, n := range req.Capacity {
+		node := b.Node(n.Id)
+		if node == nil {
+			return nil, fmt.Errorf("Node %d not found", n.Id)
+		}
+		node.SetCapacity(n.Capacity)
+	}
+	for _, id := range req.Remove {
+		if b.Node(id) == nil {
+			return nil, fmt.Errorf("Node %d not found", id)
+		}
+		b.RemoveNode(id)
+	}
+	simulated := make(map[uint64]bool)
+	seq := 0
+	for _, a := range req.Add {
+		if a.Count == 0 {
+			return nil, fmt.Errorf("Simulated node count must be at least 1")
+		}
+		meta := a.Meta
+		if meta == "" {
+			meta = "simulated"
+		}
+		for i := uint32(0); i < a.Count; i++ {
+			seq++
+			name := fmt.Sprintf("%s%d", meta, seq)
+			n, err := b.AddNode(true, a.Capacity, append([]string{name}, a.Tiers...), nil, name, nil)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to add simulated node: %s", err)
+			}
+			simulated[n.ID()] = true
+		}
+	}
+	after := b.Ring()
+
+	res := &pb.SimulateResult{
+		Before: ringStats(current),
+		After:  ringStats(after),
+	}
+	res.Replicas, res.Moved = movedReplicas(current, after)
+	if res.Replicas > 0 {
+		res.MovedPercent = float64(res.Moved) / float64(res.Replicas) * 100
+	}
+
+	beforeCounts := partitionCounts(current)
+	afterCounts := partitionCounts(after)
+	for _, n := range current.Nodes() {
+		p := &pb.NodeProjection{
+			Id:        n.ID(),
+			Meta:      n.Meta(),
+			Capacity:  n.Capacity(),
+			Assigned:  beforeCounts[n.ID()],
+			Projected: afterCounts[n.ID()],
+		}
+		if an := after.Node(n.ID()); an != nil {
+			p.Capacity = an.Capacity()
+		} else {
+			p.Removed = true
+		}
+		if fill, ok := diskFill(hw[n.ID()], path); ok {
+			p.Reported = true
+			p.Fill = fill
+			if p.Assigned > 0 {
+				p.ProjectedFill = fill * float64(p.Projected) / float64(p.Assigned)
+			}
+		}
+		res.Nodes = append(res.Nodes, p)
+	}
+	for _, n := range after.Nodes() {
+		if !simulated[n.ID()] {
+			continue
+		}
+		res.Nodes = append(res.Nodes, &pb.NodeProjection{
+			Id:        n.ID(),
+			Meta:      n.Meta(),
+			Simulated: true,
+			Capacity:  n.Capacity(),
+			Projected: afterCounts[n.ID()],
+		})
+	}
+	sort.Sort(nodeProjectionByID(res.Nodes))
+	return res, nil
+}
+
+//movedReplicas returns the number of partition replicas in after and how many
+//of them are assigned to a node that didn't hold that partition in before. If
+//after has more partitions than before each before partition is split evenly.
+func movedReplicas(before, after ring.Ring) (total uint64, moved uint64) {
+	var shift uint16
+	if after.PartitionBitCount() > before.PartitionBitCount() {
+		shift = after.PartitionBitCount() - before.PartitionBitCount()
+	}
+	partitions := uint64(1) << after.PartitionBitCount()
+	for p := uint64(0); p < partitions; p++ {
+		held := make(map[uint64]bool)
+		for _, n := range before.ResponsibleNodes(uint32(p >> shift)) {
+			held[n.ID()] = true
+		}
+		for _, n := range after.ResponsibleNodes(uint32(p)) {
+			total++
+			if !held[n.ID()] {
+				moved++
+			}
+		}
+	}
+	return total, moved
+}
+
+type nodeProjectionByID []*pb.NodeProjection
+
+func (n nodeProjectionByID) Len() int           { return len(n) }
+func (n nodeProjectionByID) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodeProjectionByID) Less(i, j int) bool { return n[i].Id < n[j].Id }
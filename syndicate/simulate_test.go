@@ -0,0 +1,134 @@
+package syndicate
+
+import (
+	"testing"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func TestServer_SimulateRing(t *testing.T) {
+	s, m := newTestServerWithDefaults()
+	ctx := context.Background()
+	var server1, dummy1 uint64
+	for _, n := range s.r.Nodes() {
+		if n.Meta() == "server1|meta one" {
+			server1 = n.ID()
+		} else {
+			dummy1 = n.ID()
+		}
+	}
+	report := &pb.NodeStatusReport{Id: server1, Hardware: &pb.HardwareProfile{Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 100, Used: 60}}}}
+	if _, err := s.ReportNodeStatus(ctx, report); err != nil {
+		t.Fatalf("ReportNodeStatus returned unexpected error: %s", err)
+	}
+	version := s.r.Version()
+
+	req := &pb.SimulateRequest{Add: []*pb.SimulatedNodes{&pb.SimulatedNodes{Count: 2, Capacity: 1, Tiers: []string{"zone9"}}}}
+	res, err := s.SimulateRing(ctx, req)
+	if err != nil {
+		t.Fatalf("SimulateRing(ctx, %#v) returned unexpected error: %s", req, err)
+	}
+	if s.r.Version() != version || len(m.builder.Nodes()) != 2 || len(s.r.Nodes()) != 2 {
+		t.Errorf("SimulateRing shouldn't have changed the ring or builder")
+	}
+	if res.Moved == 0 || res.Moved > res.Replicas || res.MovedPercent <= 0 {
+		t.Errorf("SimulateRing should have reported moved replicas: %d of %d (%.2f%%)", res.Moved, res.Replicas, res.MovedPercent)
+	}
+	if len(res.Before.Nodes) != 2 || len(res.After.Nodes) != 4 {
+		t.Errorf("SimulateRing returned unexpected before/after stats: %v, %v", res.Before, res.After)
+	}
+	zone9 := false
+	for _, tb := range res.After.Tiers {
+		if tb.Level == 1 && tb.Tier == "zone9" && tb.Nodes == 2 && tb.Assigned > 0 {
+			zone9 = true
+		}
+	}
+	if !zone9 {
+		t.Errorf("SimulateRing should have reported the simulated tier balance: %v", res.After.Tiers)
+	}
+	simulated := 0
+	for _, p := range res.Nodes {
+		switch {
+		case p.Simulated:
+			simulated++
+			if p.Assigned != 0 || p.Projected == 0 || p.Reported {
+				t.Errorf("Unexpected projection for simulated node: %v", p)
+			}
+		case p.Id == server1:
+			if !p.Reported || p.Fill != 60 || p.Projected >= p.Assigned || p.ProjectedFill >= p.Fill {
+				t.Errorf("Unexpected projection for server1: %v", p)
+			}
+		case p.Id == dummy1:
+			if p.Reported {
+				t.Errorf("dummy1 didn't report its disk usage: %v", p)
+			}
+		}
+	}
+	if simulated != 2 {
+		t.Errorf("Expected 2 simulated nodes got %d: %v", simulated, res.Nodes)
+	}
+
+	//removing a node moves everything it held
+	req = &pb.SimulateRequest{Remove: []uint64{dummy1}}
+	res, err = s.SimulateRing(ctx, req)
+	if err != nil {
+		t.Fatalf("SimulateRing(ctx, %#v) returned unexpected error: %s", req, err)
+	}
+	for _, p := range res.Nodes {
+		if p.Id == dummy1 && (!p.Removed || p.Projected != 0) {
+			t.Errorf("Unexpected projection for removed node: %v", p)
+		}
+		if p.Id == server1 && p.ProjectedFill <= p.Fill {
+			t.Errorf("server1 should be projected to fill up: %v", p)
+		}
+	}
+	if s.b.Node(dummy1) == nil {
+		t.Errorf("SimulateRing shouldn't have removed the node from the builder")
+	}
+
+	//capacity changes
+	req = &pb.SimulateRequest{Capacity: []*pb.Node{&pb.Node{Id: server1, Capacity: 3}}}
+	if res, err = s.SimulateRing(ctx, req); err != nil {
+		t.Fatalf("SimulateRing(ctx, %#v) returned unexpected error: %s", req, err)
+	}
+	for _, p := range res.Nodes {
+		if p.Id == server1 && (p.Capacity != 3 || p.Projected <= p.Assigned) {
+			t.Errorf("Unexpected projection for server1: %v", p)
+		}
+	}
+	if s.b.Node(server1).Capacity() != 1 {
+		t.Errorf("SimulateRing shouldn't have changed the builders capacity")
+	}
+
+	//failures
+	for _, req := range []*pb.SimulateRequest{
+		&pb.SimulateRequest{Remove: []uint64{42}},
+		&pb.SimulateRequest{Capacity: []*pb.Node{&pb.Node{Id: 42, Capacity: 1}}},
+		&pb.SimulateRequest{Add: []*pb.SimulatedNodes{&pb.SimulatedNodes{Capacity: 1}}},
+	} {
+		if _, err := s.SimulateRing(ctx, req); err == nil {
+			t.Errorf("SimulateRing(ctx, %#v) should have failed", req)
+		}
+	}
+}
+
+func TestSimulateRing_Offline(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	b, err := copyBuilder(s.b)
+	if err != nil {
+		t.Fatalf("copyBuilder returned unexpected error: %s", err)
+	}
+	res, err := SimulateRing(b, nil, &pb.SimulateRequest{}, nil)
+	if err != nil {
+		t.Fatalf("SimulateRing returned unexpected error: %s", err)
+	}
+	if res.Moved != 0 || res.Replicas == 0 {
+		t.Errorf("An empty simulation shouldn't move anything: %d of %d", res.Moved, res.Replicas)
+	}
+	for _, p := range res.Nodes {
+		if p.Assigned != p.Projected || p.Reported {
+			t.Errorf("Unexpected projection: %v", p)
+		}
+	}
+}
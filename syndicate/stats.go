@@ -0,0 +1,113 @@
+package syndicate
+
+import (
+	"sort"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+)
+
+//ringStats reports how well balanced the ring is. Each active node with capacity
+//is expected to hold a share of the partition replicas proportional to its capacity,
+//the weight of a node or tier is how far (in percent) its assigned partition replicas
+//are over (positive) or under (negative) that. Nodes or tiers that shouldn't be
+//assigned anything but are get reported as 100% overweight.
+func ringStats(r ring.Ring) *pb.RingStats {
+	partitions := uint64(1) << r.PartitionBitCount()
+	stats := &pb.RingStats{
+		Version:    r.Version(),
+		Partitions: partitions,
+		Replicas:   int32(r.ReplicaCount()),
+	}
+	counts := partitionCounts(r)
+	nodes := r.Nodes()
+
+	var totalCapacity uint64
+	for _, n := range nodes {
+		if n.Active() {
+			stats.ActiveNodes++
+			totalCapacity += uint64(n.Capacity())
+		} else {
+			stats.InactiveNodes++
+		}
+		if n.Capacity() == 0 {
+			stats.ZeroCapacityNodes++
+		}
+	}
+	total := float64(partitions) * float64(r.ReplicaCount())
+
+	tiers := make(map[tierKey]*pb.TierBalance)
+	for _, n := range nodes {
+		nb := &pb.NodeBalance{
+			Id:       n.ID(),
+			Meta:     n.Meta(),
+			Active:   n.Active(),
+			Capacity: n.Capacity(),
+			Assigned: counts[n.ID()],
+		}
+		if n.Active() && totalCapacity > 0 {
+			nb.Desired = total * float64(n.Capacity()) / float64(totalCapacity)
+		}
+		nb.Weight = balanceWeight(nb.Desired, nb.Assigned)
+		if nb.Weight > stats.MaxOverWeight {
+			stats.MaxOverWeight = nb.Weight
+		}
+		if -nb.Weight > stats.MaxUnderWeight {
+			stats.MaxUnderWeight = -nb.Weight
+		}
+		stats.Nodes = append(stats.Nodes, nb)
+
+		for level, tier := range n.Tiers() {
+			k := tierKey{level: level, tier: tier}
+			tb, ok := tiers[k]
+			if !ok {
+				tb = &pb.TierBalance{Level: int32(level), Tier: tier}
+				tiers[k] = tb
+			}
+			tb.Nodes++
+			tb.Capacity += uint64(n.Capacity())
+			tb.Desired += nb.Desired
+			tb.Assigned += nb.Assigned
+		}
+	}
+	for _, tb := range tiers {
+		tb.Weight = balanceWeight(tb.Desired, tb.Assigned)
+		stats.Tiers = append(stats.Tiers, tb)
+	}
+	sort.Sort(nodeBalanceByID(stats.Nodes))
+	sort.Sort(tierBalanceByName(stats.Tiers))
+	return stats
+}
+
+//balanceWeight returns the percentage assigned is over (or under) desired.
+func balanceWeight(desired float64, assigned uint64) float64 {
+	if desired == 0 {
+		if assigned == 0 {
+			return 0
+		}
+		return 100
+	}
+	return (float64(assigned) - desired) / desired * 100
+}
+
+type tierKey struct {
+	level int
+	tier  string
+}
+
+type nodeBalanceByID []*pb.NodeBalance
+
+func (n nodeBalanceByID) Len() int           { return len(n) }
+func (n nodeBalanceByID) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodeBalanceByID) Less(i, j int) bool { return n[i].Id < n[j].Id }
+
+type tierBalanceByName []*pb.TierBalance
+
+func (t tierBalanceByName) Len() int      { return len(t) }
+func (t tierBalanceByName) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+func (t tierBalanceByName) Less(i, j int) bool {
+	if t[i].Level != t[j].Level {
+		return t[i].Level < t[j].Level
+	}
+	return t[i].Tier < t[j].Tier
+}
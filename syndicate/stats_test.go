@@ -0,0 +1,119 @@
+package syndicate
+
+import (
+	"testing"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+func TestBalanceWeight(t *testing.T) {
+	tests := []struct {
+		desired  float64
+		assigned uint64
+		expected float64
+	}{
+		{0, 0, 0},
+		{0, 5, 100},
+		{100, 100, 0},
+		{100, 150, 50},
+		{100, 25, -75},
+		{100, 0, -100},
+	}
+	for _, test := range tests {
+		if w := balanceWeight(test.desired, test.assigned); w != test.expected {
+			t.Errorf("balanceWeight(%v, %d) returned %v, expected %v", test.desired, test.assigned, w, test.expected)
+		}
+	}
+}
+
+func TestRingStats(t *testing.T) {
+	b := ring.NewBuilder(64)
+	b.SetReplicaCount(2)
+	b.AddNode(true, 100, []string{"server1", "rack1"}, []string{"10.0.0.1:8001"}, "server1", []byte(""))
+	b.AddNode(true, 300, []string{"server2", "rack1"}, []string{"10.0.0.2:8001"}, "server2", []byte(""))
+	b.AddNode(true, 200, []string{"server3", "rack2"}, []string{"10.0.0.3:8001"}, "server3", []byte(""))
+	b.AddNode(false, 400, []string{"server4", "rack2"}, []string{"10.0.0.4:8001"}, "server4", []byte(""))
+	b.AddNode(true, 0, []string{"server5", "rack3"}, []string{"10.0.0.5:8001"}, "server5", []byte(""))
+	r := b.Ring()
+
+	stats := ringStats(r)
+	partitions := uint64(1) << r.PartitionBitCount()
+	if stats.Version != r.Version() || stats.Partitions != partitions || stats.Replicas != 2 {
+		t.Errorf("ringStats returned unexpected ring info: %#v", stats)
+	}
+	if stats.ActiveNodes != 4 || stats.InactiveNodes != 1 || stats.ZeroCapacityNodes != 1 {
+		t.Errorf("ringStats returned unexpected node counts: active %d inactive %d zero capacity %d", stats.ActiveNodes, stats.InactiveNodes, stats.ZeroCapacityNodes)
+	}
+	if len(stats.Nodes) != 5 {
+		t.Fatalf("ringStats returned %d nodes, expected 5", len(stats.Nodes))
+	}
+
+	byMeta := make(map[string]*pb.NodeBalance)
+	total := float64(partitions * 2)
+	var desired float64
+	var assigned uint64
+	for i, n := range stats.Nodes {
+		if i > 0 && stats.Nodes[i-1].Id > n.Id {
+			t.Errorf("ringStats nodes not sorted by id")
+		}
+		if n.Assigned != partitionCounts(r)[n.Id] {
+			t.Errorf("node %d assigned %d, expected %d", n.Id, n.Assigned, partitionCounts(r)[n.Id])
+		}
+		if n.Weight > stats.MaxOverWeight || -n.Weight > stats.MaxUnderWeight {
+			t.Errorf("node %d weight %v is outside of the reported max over/under weight", n.Id, n.Weight)
+		}
+		byMeta[n.Meta] = n
+		desired += n.Desired
+		assigned += n.Assigned
+	}
+	if desired < total-0.001 || desired > total+0.001 || assigned != uint64(total) {
+		t.Errorf("ringStats desired %v and assigned %d should both total %v", desired, assigned, total)
+	}
+	if byMeta["server2"].Desired != total/2 {
+		t.Errorf("server2 should desire half the partition replicas, got %v", byMeta["server2"].Desired)
+	}
+	if byMeta["server4"].Desired != 0 || byMeta["server5"].Desired != 0 {
+		t.Errorf("inactive and zero capacity nodes should desire nothing: %#v %#v", byMeta["server4"], byMeta["server5"])
+	}
+
+	expectedTiers := []struct {
+		level    int32
+		tier     string
+		nodes    uint32
+		capacity uint64
+	}{
+		{0, "server1", 1, 100},
+		{0, "server2", 1, 300},
+		{0, "server3", 1, 200},
+		{0, "server4", 1, 400},
+		{0, "server5", 1, 0},
+		{1, "rack1", 2, 400},
+		{1, "rack2", 2, 600},
+		{1, "rack3", 1, 0},
+	}
+	if len(stats.Tiers) != len(expectedTiers) {
+		t.Fatalf("ringStats returned %d tiers, expected %d", len(stats.Tiers), len(expectedTiers))
+	}
+	for i, e := range expectedTiers {
+		tb := stats.Tiers[i]
+		if tb.Level != e.level || tb.Tier != e.tier || tb.Nodes != e.nodes || tb.Capacity != e.capacity {
+			t.Errorf("tier %d was %#v, expected %v", i, tb, e)
+		}
+	}
+	if stats.Tiers[5].Desired != byMeta["server1"].Desired+byMeta["server2"].Desired || stats.Tiers[5].Assigned != byMeta["server1"].Assigned+byMeta["server2"].Assigned {
+		t.Errorf("rack1 should total its nodes desired and assigned partitions: %#v", stats.Tiers[5])
+	}
+}
+
+func TestServer_GetRingStats(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	stats, err := s.GetRingStats(context.Background(), &pb.EmptyMsg{})
+	if err != nil {
+		t.Fatalf("GetRingStats returned unexpected error: %s", err)
+	}
+	if stats.Version != s.r.Version() || stats.ActiveNodes != 2 || len(stats.Nodes) != 2 || len(stats.Tiers) != 4 {
+		t.Errorf("GetRingStats returned unexpected stats: %#v", stats)
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"io/ioutil"
 	"net"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/gholt/ring"
@@ -17,23 +19,27 @@ import (
 )
 
 const (
-	_SYN_REGISTER_TIMEOUT = 4
-	_SYN_DIAL_TIMEOUT     = 2
-	DefaultPort           = 8443                        //The default port to use for the main backend service
-	DefaultCmdCtrlPort    = 4443                        //The default port to use for cmdctrl (address0)
-	DefaultMsgRingPort    = 8001                        //The default port the TCPMsgRing should use (address1)
-	DefaultStorePort      = 6379                        //The default port the Store's should use (address2)
-	DefaultRingDir        = "/etc/syndicate/ring"       //The default directory where to store the rings
-	DefaultCertFile       = "/etc/syndicate/server.crt" //The default SSL Cert
-	DefaultCertKey        = "/etc/syndicate/server.key" //The default SSL Key
+	_SYN_REGISTER_TIMEOUT        = 4
+	_SYN_DIAL_TIMEOUT            = 2
+	DefaultPort                  = 8443                        //The default port to use for the main backend service
+	DefaultCmdCtrlPort           = 4443                        //The default port to use for cmdctrl (address0)
+	DefaultMsgRingPort           = 8001                        //The default port the TCPMsgRing should use (address1)
+	DefaultStorePort             = 6379                        //The default port the Store's should use (address2)
+	DefaultRingDir               = "/etc/syndicate/ring"       //The default directory where to store the rings
+	DefaultCertFile              = "/etc/syndicate/server.crt" //The default SSL Cert
+	DefaultCertKey               = "/etc/syndicate/server.key" //The default SSL Key
+	DefaultRingSubscriberTimeout = 30                          //The default seconds a ring stream send may take before the subscriber is evicted
+	DefaultNodeConcurrency       = 16                          //The default number of managed nodes to talk to at once for cluster wide requests
+	DefaultNodeReportStaleAfter  = 180                         //The default seconds without a status report before a node is considered stale
 )
 
 var (
 	DefaultNetFilter  = []string{"10.0.0.0/8", "192.168.0.0/16"} //Default the netfilters to private networks
 	DefaultTierFilter = []string{".*"}                           //Default to ...anything
 
-	InvalidTiers = errors.New("Tier0 already present in ring")
-	InvalidAddrs = errors.New("No valid addresses provided")
+	InvalidTiers      = errors.New("Tier0 already present in ring")
+	InvalidAddrs      = errors.New("No valid addresses provided")
+	SubscriberTimeout = errors.New("Ring subscriber send timed out")
 )
 
 //Config options for syndicate manager
@@ -52,6 +58,19 @@ type Config struct {
 	CertFile         string
 	KeyFile          string
 	WeightAssignment string
+	//RingSubscriberTimeout is the number of seconds a GetRingStream send may
+	//block before the subscriber is considered dead and evicted.
+	RingSubscriberTimeout int
+	//NodeConcurrency is the max number of managed nodes synd will talk to at
+	//once for cluster wide requests like GetAllSoftwareVersions.
+	NodeConcurrency int
+	//NodeReportStaleAfter is the number of seconds a node that's been sending
+	//ReportNodeStatus heartbeats may go without one before it's considered stale.
+	NodeReportStaleAfter int
+	//CapacityPolicy adjusts node capacities based on their reported disk usage.
+	CapacityPolicy CapacityPolicyConfig
+	//Notifiers are the webhooks sent a summary of every applied or failed ring change.
+	Notifiers []NotifierConfig
 }
 
 func parseSlaveAddrs(slaveAddrs []string) []*RingSlave {
@@ -66,8 +85,17 @@ func parseSlaveAddrs(slaveAddrs []string) []*RingSlave {
 }
 
 type syndicateMetrics struct {
-	managedNodes    prometheus.Gauge
-	subscriberNodes prometheus.Gauge
+	managedNodes        prometheus.Gauge
+	subscriberNodes     prometheus.Gauge
+	subscriberEvictions prometheus.Counter
+	nodeDiskUsed        *prometheus.GaugeVec
+	nodeDiskFree        *prometheus.GaugeVec
+	nodeMemTotal        *prometheus.GaugeVec
+	nodeMemFree         *prometheus.GaugeVec
+	nodeLoad            *prometheus.GaugeVec
+	nodeRingVersion     *prometheus.GaugeVec
+	nodeReportAge       *prometheus.GaugeVec
+	nodeReportStale     *prometheus.GaugeVec
 }
 
 func metricsInit(servicename string) *syndicateMetrics {
@@ -82,8 +110,62 @@ func metricsInit(servicename string) *syndicateMetrics {
 		Help:        "Current number of unmanaged nodes subscribed for ring changes.",
 		ConstLabels: prometheus.Labels{"servicename": servicename},
 	})
+	m.subscriberEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Name:        "SubscriberEvictions",
+		Help:        "Number of ring subscribers evicted for not keeping up with ring changes.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	})
+	m.nodeDiskUsed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeDiskUsedBytes",
+		Help:        "Bytes used on each disk of a node, as last reported by the node.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node", "path"})
+	m.nodeDiskFree = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeDiskFreeBytes",
+		Help:        "Bytes free on each disk of a node, as last reported by the node.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node", "path"})
+	m.nodeMemTotal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeMemoryTotalBytes",
+		Help:        "Total memory of a node, as last reported by the node.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node"})
+	m.nodeMemFree = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeMemoryFreeBytes",
+		Help:        "Free memory of a node, as last reported by the node.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node"})
+	m.nodeLoad = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeLoad",
+		Help:        "Load average of a node over the 1, 5 and 15 minute periods, as last reported by the node.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node", "period"})
+	m.nodeRingVersion = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeRingVersion",
+		Help:        "Ring version a node is running, as last reported by the node.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node"})
+	m.nodeReportAge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeReportAgeSeconds",
+		Help:        "Seconds since a node last sent a status report.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node"})
+	m.nodeReportStale = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "NodeReportStale",
+		Help:        "1 if a node has stopped sending status reports, 0 otherwise.",
+		ConstLabels: prometheus.Labels{"servicename": servicename},
+	}, []string{"node"})
 	prometheus.Register(m.managedNodes)
 	prometheus.Register(m.subscriberNodes)
+	prometheus.Register(m.subscriberEvictions)
+	prometheus.Register(m.nodeDiskUsed)
+	prometheus.Register(m.nodeDiskFree)
+	prometheus.Register(m.nodeMemTotal)
+	prometheus.Register(m.nodeMemFree)
+	prometheus.Register(m.nodeLoad)
+	prometheus.Register(m.nodeRingVersion)
+	prometheus.Register(m.nodeReportAge)
+	prometheus.Register(m.nodeReportStale)
 	return &m
 }
 
@@ -95,6 +177,7 @@ type Server struct {
 	ctxlog         *log.Entry
 	metrics        *syndicateMetrics
 	r              ring.Ring
+	prevRing       ring.Ring // the ring version prior to r, nil if unknown
 	b              *ring.Builder
 	slaves         []*RingSlave
 	localAddress   string
@@ -105,7 +188,25 @@ type Server struct {
 	managedNodes   map[uint64]ManagedNode
 	changeChan     chan *changeMsg
 	ringSubs       *RingSubscribers
+	eventWatchers  *RingEventWatchers
 	subsChangeChan chan *changeMsg
+	notifiers      []*webhookNotifier
+	upgradeLock    sync.Mutex
+	upgrade        *clusterUpgrade // the current or last cluster upgrade
+	maintLock      sync.Mutex
+	maintenance    map[uint64]*nodeMaintenance
+	drainLock      sync.Mutex // serializes drain steps with ending maintenance
+	rampLock       sync.Mutex
+	ramps          map[uint64]*capacityRamp // the running and last ramp for each node
+	decomLock      sync.Mutex
+	decommissions  map[uint64]*decommission
+	hwLock         sync.Mutex
+	hardware       map[uint64]*pb.NodeHardware // the latest hardware profile reported by each node
+	hwDirty        bool                        // hardware has been refreshed by a node report but not persisted
+	reportLock     sync.Mutex
+	reports        map[uint64]*nodeReport // the last status report from each node
+	policyLock     sync.Mutex
+	policy         *capacityPolicy
 	// mostly just present to aid mocking
 	rbLoaderFn   func(path string) ([]byte, error)
 	rbPersistFn  func(c *RingChange, renameMaster bool) (error, error)
@@ -178,13 +279,13 @@ func NewServer(cfg *Config, servicename string, opts ...MockOpt) (*Server, error
 	//TODO: verify ring version in bytes matches what we expect
 	s.rb, s.bb, err = s.loadRingBuilderBytes(s.r.Version())
 	FatalIf(err, "Attempting to load ring/builder bytes")
+	s.prevRing = s.loadPreviousRing()
 
-	for _, v := range cfg.NetFilter {
-		_, n, err := net.ParseCIDR(v)
-		if err != nil {
-			FatalIf(err, "Invalid network range provided")
-		}
-		s.netlimits = append(s.netlimits, n)
+	s.netlimits, err = parseNetFilter(cfg.NetFilter)
+	FatalIf(err, "Invalid network range provided")
+	for _, v := range cfg.TierFilter {
+		_, err := regexp.Compile(v)
+		FatalIf(err, fmt.Sprintf("Invalid tier filter provided (%s)", v))
 	}
 	s.tierlimits = cfg.TierFilter
 	s.managedNodes = bootstrapManagedNodes(s.r, s.cfg.CmdCtrlPort, s.ctxlog)
@@ -193,12 +294,28 @@ func NewServer(cfg *Config, servicename string, opts ...MockOpt) (*Server, error
 	s.subsChangeChan = make(chan *changeMsg, 1)
 	go s.RingChangeManager()
 	s.ringSubs = &RingSubscribers{
-		subs: make(map[string]chan *pb.Ring),
+		subs: make(map[string]*ringSubscriber),
 	}
 	go s.ringSubscribersNotify()
+	s.eventWatchers = &RingEventWatchers{
+		watchers: make(map[*ringEventWatcher]bool),
+	}
+	err = s.startNotifiers()
+	FatalIf(err, "Invalid notifier config")
+	err = s.loadMaintenance()
+	FatalIf(err, "Unable to load maintenance state")
+	err = s.loadHardware()
+	FatalIf(err, "Unable to load hardware profiles")
+	err = s.parseCapacityPolicy()
+	FatalIf(err, "Invalid capacity policy")
+	err = s.loadCapacityPolicy()
+	FatalIf(err, "Unable to load capacity policy state")
+	err = s.loadDecommissions()
+	FatalIf(err, "Unable to load decommissions")
 	s.slaves = parseSlaveAddrs(cfg.Slaves)
 	if len(s.slaves) == 0 {
 		s.ctxlog.Debug("running without slaves")
+		s.startManagers()
 		return s, nil
 	}
 
@@ -217,9 +334,32 @@ func NewServer(cfg *Config, servicename string, opts ...MockOpt) (*Server, error
 	if failcount > (len(s.slaves) / 2) {
 		return s, fmt.Errorf("More than half of the ring slaves failed to respond. Exiting.")
 	}
+	s.startManagers()
 	return s, nil
 }
 
+//startManagers starts the background work that makes ring changes on its own
+//(maintenance drains, the capacity policy and resumed decommissions). It runs
+//once the slaves are set up so those changes are replicated to them.
+func (s *Server) startManagers() {
+	go s.maintenanceManager()
+	go s.nodeReportManager()
+	s.resumeDecommissions()
+}
+
+//parseNetFilter parses the NetFilter network ranges.
+func parseNetFilter(cidrs []string) ([]*net.IPNet, error) {
+	var netlimits []*net.IPNet
+	for _, v := range cidrs {
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, err
+		}
+		netlimits = append(netlimits, n)
+	}
+	return netlimits, nil
+}
+
 func (s *Server) parseConfig() {
 	if s.cfg.NetFilter == nil {
 		s.cfg.NetFilter = DefaultNetFilter
@@ -253,6 +393,18 @@ func (s *Server) parseConfig() {
 		s.cfg.RingDir = filepath.Join(DefaultRingDir, s.servicename)
 		s.ctxlog.Debugln("Config didn't specify ringdir, using default:", s.cfg.RingDir)
 	}
+	if s.cfg.RingSubscriberTimeout == 0 {
+		s.ctxlog.Debugln("Config didn't specify ring subscriber timeout, using default:", DefaultRingSubscriberTimeout)
+		s.cfg.RingSubscriberTimeout = DefaultRingSubscriberTimeout
+	}
+	if s.cfg.NodeConcurrency == 0 {
+		s.ctxlog.Debugln("Config didn't specify node concurrency, using default:", DefaultNodeConcurrency)
+		s.cfg.NodeConcurrency = DefaultNodeConcurrency
+	}
+	if s.cfg.NodeReportStaleAfter == 0 {
+		s.ctxlog.Debugln("Config didn't specify node report stale after, using default:", DefaultNodeReportStaleAfter)
+		s.cfg.NodeReportStaleAfter = DefaultNodeReportStaleAfter
+	}
 	if s.cfg.CertFile == "" {
 		s.ctxlog.Debugln("Config didn't specify certfile, using default:", DefaultCertFile)
 		s.cfg.CertFile = DefaultCertFile
@@ -280,6 +432,9 @@ type RingChange struct {
 	r            ring.Ring
 	v            int64
 	removedNodes []uint64
+	kind         string   // the request that made the change, i.e. SetActive
+	nodes        []uint64 // the nodes the change affects, if any
+	caller       string   // the address of the client that made the request
 }
 
 //ringBuilderPersisterFn is the default ring & builder persistence method used when a ring change is triggered.
@@ -309,7 +464,10 @@ func (s *Server) ringBuilderPersisterFn(c *RingChange, renameMaster bool) (error
 }
 
 //applyRingChange attempts to actually apply and persist the disk the given ring change.
-func (s *Server) applyRingChange(c *RingChange) error {
+//The notifiers are told about the change whether it succeeds or fails.
+func (s *Server) applyRingChange(c *RingChange) (err error) {
+	prev := s.r.Version()
+	defer func() { s.notifyRingChange(c, prev, err) }()
 	builderErr, ringErr := s.rbPersistFn(c, false)
 	if builderErr != nil {
 		s.ctxlog.WithFields(log.Fields{
@@ -337,12 +495,18 @@ func (s *Server) applyRingChange(c *RingChange) error {
 	}
 	//now update the current working ring
 	builderErr, ringErr = s.rbPersistFn(c, true)
+	s.publishRingEvents(ringEvents(s.r, c.r))
 	s.rb = newRB
 	s.bb = newBB
 	s.b = c.b
+	s.prevRing = s.r
 	s.r = c.r
 	if len(c.removedNodes) != 0 {
 		s.removeManagedNodes(c.removedNodes)
+		s.forgetNodeHardware(c.removedNodes)
+		s.forgetNodeReports(c.removedNodes)
+		s.forgetNodeMaintenance(c.removedNodes)
+		s.forgetNodeRamps(c.removedNodes)
 	}
 	go s.NotifyNodes()
 	return nil
@@ -377,7 +541,7 @@ func (s *Server) AddNode(c context.Context, e *pb.Node) (*pb.RingStatus, error)
 	}).Debug("proposed ring entry")
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "AddNode", nodes: []uint64{n.ID()}, caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
@@ -402,14 +566,35 @@ func (s *Server) getRing(path string) (ring.Ring, error) {
 	return r, err
 }
 
+//loadPreviousRing loads the most recent versioned ring older than the active
+//ring from the ring dir. Returns nil if there isn't one or it fails to load.
+func (s *Server) loadPreviousRing() ring.Ring {
+	path, err := findPreviousRing(s.cfg, s.servicename, s.r.Version())
+	if err != nil || path == "" {
+		s.ctxlog.WithField("err", err).Debug("no previous ring version found")
+		return nil
+	}
+	r, err := s.getRing(path)
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{"path": path, "err": err}).Warning("failed to load previous ring version")
+		return nil
+	}
+	return r
+}
+
 //RemoveNode removes a node given node to the ring. If any errors are encountered
 //the ring change is discarded. The response RingStatus message should only have True
 //Status if the ring change succeeded. The active Ring Version at the end of the call
 //is always returned.
 func (s *Server) RemoveNode(c context.Context, n *pb.Node) (*pb.RingStatus, error) {
+	s.ctxlog.Debug("Got RemoveNode request")
+	return s.removeNode(c, n, "RemoveNode")
+}
+
+//removeNode removes the node as a ring change of the given kind.
+func (s *Server) removeNode(c context.Context, n *pb.Node, kind string) (*pb.RingStatus, error) {
 	s.Lock()
 	defer s.Unlock()
-	s.ctxlog.Debug("Got RemoveNode request")
 	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
@@ -429,6 +614,9 @@ func (s *Server) RemoveNode(c context.Context, n *pb.Node) (*pb.RingStatus, erro
 		r:            newRing,
 		v:            newRing.Version(),
 		removedNodes: []uint64{n.Id},
+		kind:         kind,
+		nodes:        []uint64{n.Id},
+		caller:       callerFromContext(c),
 	}
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
 	err = s.applyRingChange(&change)
@@ -466,7 +654,7 @@ func (s *Server) SetConf(c context.Context, conf *pb.Conf) (*pb.RingStatus, erro
 	b.SetConfig(conf.Conf)
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "SetConf", caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
@@ -497,7 +685,7 @@ func (s *Server) SetActive(c context.Context, n *pb.Node) (*pb.RingStatus, error
 	node.SetActive(n.Active)
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "SetActive", nodes: []uint64{n.Id}, caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
@@ -527,7 +715,7 @@ func (s *Server) SetReplicas(c context.Context, n *pb.RingOpts) (*pb.RingStatus,
 		"replicas":         n.Replicas,
 		"proposed-ringver": newRing.Version(),
 	}).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "SetReplicas", caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"replicas":         n.Replicas,
@@ -559,7 +747,7 @@ func (s *Server) SetCapacity(c context.Context, n *pb.Node) (*pb.RingStatus, err
 	node.SetCapacity(n.Capacity)
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "SetCapacity", nodes: []uint64{n.Id}, caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
@@ -596,7 +784,7 @@ func (s *Server) ReplaceTiers(c context.Context, n *pb.Node) (*pb.RingStatus, er
 	node.ReplaceTiers(n.Tiers)
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "ReplaceTiers", nodes: []uint64{n.Id}, caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
@@ -646,7 +834,7 @@ func (s *Server) ReplaceAddresses(c context.Context, n *pb.Node) (*pb.RingStatus
 	node.ReplaceAddresses(n.Addresses)
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "ReplaceAddresses", nodes: []uint64{n.Id}, caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
@@ -723,6 +911,91 @@ func (s *Server) SearchNodes(c context.Context, n *pb.Node) (*pb.SearchResult, e
 	return &pb.SearchResult{Nodes: res}, nil
 }
 
+//QueryNodes returns the nodes matching the provided query, see nodeQuery for
+//the query syntax. Partition counts are only included if requested.
+func (s *Server) QueryNodes(c context.Context, q *pb.NodeQuery) (*pb.NodeQueryResult, error) {
+	s.RLock()
+	defer s.RUnlock()
+	query, err := parseNodeQuery(q.Query)
+	if err != nil {
+		return &pb.NodeQueryResult{}, fmt.Errorf("Invalid query: %s", err)
+	}
+	var counts map[uint64]uint64
+	if query.needPartitions {
+		counts = partitionCounts(s.r)
+	}
+	res := &pb.NodeQueryResult{Version: s.r.Version(), PartitionCounts: query.partitions}
+	for _, n := range query.run(s.r.Nodes(), counts) {
+		m := &pb.NodeQueryMatch{
+			Node: &pb.Node{
+				Id:        n.ID(),
+				Active:    n.Active(),
+				Capacity:  n.Capacity(),
+				Tiers:     n.Tiers(),
+				Addresses: n.Addresses(),
+				Meta:      n.Meta(),
+				Conf:      n.Config(),
+			},
+		}
+		if query.partitions {
+			m.Partitions = counts[n.ID()]
+		}
+		res.Nodes = append(res.Nodes, m)
+	}
+	return res, nil
+}
+
+//LookupPartition returns the nodes responsible for each replica of the given partition,
+//and if requested the nodes that were responsible for it in the previous ring version.
+func (s *Server) LookupPartition(c context.Context, l *pb.PartitionLookup) (*pb.PartitionLookupResult, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if uint64(l.Partition) >= uint64(1)<<s.r.PartitionBitCount() {
+		return &pb.PartitionLookupResult{}, fmt.Errorf("Partition %d out of range, ring has %d partitions", l.Partition, uint64(1)<<s.r.PartitionBitCount())
+	}
+	res := &pb.PartitionLookupResult{
+		Version:   s.r.Version(),
+		Partition: l.Partition,
+		Replicas:  partitionReplicas(s.r, l.Partition),
+	}
+	if l.Previous && s.prevRing != nil {
+		res.PreviousVersion = s.prevRing.Version()
+		res.PreviousPartition = translatePartition(l.Partition, s.r.PartitionBitCount(), s.prevRing.PartitionBitCount())
+		res.PreviousReplicas = partitionReplicas(s.prevRing, res.PreviousPartition)
+	}
+	return res, nil
+}
+
+//LookupKey hashes the given key the same way the oort stores do and returns the nodes
+//responsible for each replica of the partition it falls in, and if requested the nodes
+//that were responsible for it in the previous ring version.
+func (s *Server) LookupKey(c context.Context, l *pb.KeyLookup) (*pb.PartitionLookupResult, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if len(l.Key) == 0 {
+		return &pb.PartitionLookupResult{}, fmt.Errorf("No key provided")
+	}
+	partition := keyPartition(l.Key, s.r.PartitionBitCount())
+	res := &pb.PartitionLookupResult{
+		Version:   s.r.Version(),
+		Partition: partition,
+		Replicas:  partitionReplicas(s.r, partition),
+	}
+	if l.Previous && s.prevRing != nil {
+		res.PreviousVersion = s.prevRing.Version()
+		res.PreviousPartition = keyPartition(l.Key, s.prevRing.PartitionBitCount())
+		res.PreviousReplicas = partitionReplicas(s.prevRing, res.PreviousPartition)
+	}
+	return res, nil
+}
+
+//GetRingStats reports how well balanced the active ring is, see ringStats.
+func (s *Server) GetRingStats(c context.Context, e *pb.EmptyMsg) (*pb.RingStats, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return ringStats(s.r), nil
+}
+
 //GetNodeConfig retrieves a specific nodes ring config []bytes or an error if the node is not found.
 func (s *Server) GetNodeConfig(c context.Context, n *pb.Node) (*pb.RingConf, error) {
 	s.RLock()
@@ -747,36 +1020,122 @@ func (s *Server) GetRing(c context.Context, e *pb.EmptyMsg) (*pb.Ring, error) {
 	return &pb.Ring{Version: s.r.Version(), Ring: *s.rb}, nil
 }
 
-//GetRingStream return a stream of rings as they become available
+//GetRingStream return a stream of rings as they become available. Subscribers that
+//accept deltas and provide the ring version they already have are sent just the
+//changes from that version when its still available, otherwise the full ring.
+//Versions sent on a stream only ever increase, if the subscriber falls behind it
+//skips straight to the newest version, and if a send blocks for longer than the
+//RingSubscriberTimeout the subscriber is evicted.
 func (s *Server) GetRingStream(req *pb.SubscriberID, stream pb.Syndicate_GetRingStreamServer) error {
+	timeout := time.Duration(s.cfg.RingSubscriberTimeout) * time.Second
 	s.RLock()
-	ringChange := s.addRingSubscriber(req.Id)
-	streamFinished := false
-	if err := stream.Send(&pb.Ring{Version: s.r.Version(), Ring: *s.rb}); err != nil {
-		s.RUnlock()
-		s.ctxlog.WithField("err", err).Error("Error GetRingStream initial send")
-		streamFinished = true
-		return s.removeRingSubscriber(req.Id)
-	}
+	sub := s.addRingSubscriber(req.Id, callerFromContext(stream.Context()), req.Deltas)
+	initial := s.subscriberRing(req)
 	s.RUnlock()
-	for ring := range ringChange {
-		if err := stream.Send(ring); err != nil {
-			s.ctxlog.WithField("err", err).Error("Error GetRingStream send")
-			streamFinished = true
-			break
+	if err := sendWithTimeout(func() error { return stream.Send(initial) }, timeout); err != nil {
+		s.ctxlog.WithFields(log.Fields{"id": req.Id, "err": err}).Error("Error GetRingStream initial send")
+		s.dropRingSubscriber(req.Id, sub)
+		return err
+	}
+	have := initial.Version
+	sub.delivered(have)
+	for {
+		select {
+		case <-sub.done:
+			s.ctxlog.WithField("id", req.Id).Debug("closing ring sub stream")
+			return fmt.Errorf("ring subscriber removed")
+		case <-stream.Context().Done():
+			s.ctxlog.WithField("id", req.Id).Debug("ring sub stream finished")
+			s.dropRingSubscriber(req.Id, sub)
+			return stream.Context().Err()
+		case <-sub.ready:
+			ring := sub.pop()
+			if ring == nil || ring.Version <= have {
+				continue
+			}
+			msg := &pb.Ring{Version: ring.Version, Ring: ring.Ring}
+			if req.Deltas && ring.Delta != nil && ring.Delta.BaseVersion == have {
+				msg = &pb.Ring{Version: ring.Version, Delta: ring.Delta}
+			}
+			if err := sendWithTimeout(func() error { return stream.Send(msg) }, timeout); err != nil {
+				if err == SubscriberTimeout {
+					s.metrics.subscriberEvictions.Inc()
+					s.ctxlog.WithFields(log.Fields{"id": req.Id, "timeout": timeout}).Warning("evicting slow ring subscriber")
+				} else {
+					s.ctxlog.WithFields(log.Fields{"id": req.Id, "err": err}).Error("Error GetRingStream send")
+				}
+				s.dropRingSubscriber(req.Id, sub)
+				return err
+			}
+			have = ring.Version
+			sub.delivered(have)
 		}
 	}
-	s.ctxlog.Debug("closing ring sub stream")
-	//our chan got closed before expected
-	if !streamFinished {
-		err := s.removeRingSubscriber(req.Id)
-		if err != nil {
-			s.ctxlog.WithFields(log.Fields{"key": req.Id,
-				"err": err}).Error("ring sub remove failed after chan closed while stream active")
+}
+
+//WatchRingEvents streams typed events (node added/removed/activated/deactivated,
+//capacity/tier/address changes, config and replica changes) as ring changes are applied.
+//Watchers can filter by event type and node id. A watcher that falls too far behind,
+//or whose send blocks for longer than the RingSubscriberTimeout, is evicted.
+func (s *Server) WatchRingEvents(f *pb.RingEventFilter, stream pb.Syndicate_WatchRingEventsServer) error {
+	w, err := newRingEventWatcher(f)
+	if err != nil {
+		return err
+	}
+	timeout := time.Duration(s.cfg.RingSubscriberTimeout) * time.Second
+	s.addRingEventWatcher(w)
+	defer s.removeRingEventWatcher(w)
+	for {
+		select {
+		case <-w.done:
+			return fmt.Errorf("ring event watcher evicted")
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-w.ready:
+			for _, e := range w.pop() {
+				if err := sendWithTimeout(func() error { return stream.Send(e) }, timeout); err != nil {
+					s.ctxlog.WithField("err", err).Warning("Error WatchRingEvents send")
+					return err
+				}
+			}
 		}
-		return fmt.Errorf("ring change chan closed")
 	}
-	return s.removeRingSubscriber(req.Id)
+}
+
+//ListSubscribers returns the connected GetRingStream subscribers along with the
+//active ring version, so subscribers stuck on older versions stand out.
+func (s *Server) ListSubscribers(c context.Context, e *pb.EmptyMsg) (*pb.SubscriberList, error) {
+	s.RLock()
+	version := s.r.Version()
+	s.RUnlock()
+	return &pb.SubscriberList{Version: version, Subscribers: s.ringSubscribers()}, nil
+}
+
+//DisconnectSubscriber removes the given GetRingStream subscriber, ending its stream.
+func (s *Server) DisconnectSubscriber(c context.Context, id *pb.SubscriberID) (*pb.EmptyMsg, error) {
+	if err := s.removeRingSubscriber(id.Id); err != nil {
+		return &pb.EmptyMsg{}, fmt.Errorf("Subscriber %s not found", id.Id)
+	}
+	s.ctxlog.WithField("id", id.Id).Info("disconnected ring subscriber")
+	return &pb.EmptyMsg{}, nil
+}
+
+//sendWithTimeout calls the stream send func, giving up with SubscriberTimeout if it
+//takes longer than the timeout. A blocked Send can't be interrupted, so its left
+//to error out on its own once the stream is torn down after we return.
+func sendWithTimeout(send func() error, timeout time.Duration) error {
+	errc := make(chan error, 1)
+	go func() {
+		errc <- send()
+	}()
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case err := <-errc:
+		return err
+	case <-t.C:
+		return SubscriberTimeout
+	}
 }
 
 //validNodeIP verifies that the provided ip is not a loopback or multicast address
@@ -806,21 +1165,34 @@ func (s *Server) validTiers(t []string) bool {
 	if len(r) != 0 || err != nil {
 		return false
 	}
-	/*
-		//we're not using multiple tiers anymore
-		for i := 1; i <= len(t); i++ {
-			for _, v := range s.tierlimits {
-				matched, err := regexp.MatchString(v, t[i])
-				if err != nil {
-					return false
-				}
-				if matched {
-					return true
-				}
+	return true
+}
+
+//tierFilterErrors verifies tier1 and up against the configured TierFilter
+//regexes. Every tier must be non empty and match at least one filter. A
+//description of each tier that fails validation is returned.
+func (s *Server) tierFilterErrors(t []string) []string {
+	var errs []string
+	for i := 1; i < len(t); i++ {
+		if t[i] == "" {
+			errs = append(errs, fmt.Sprintf("tier%d is empty", i))
+			continue
+		}
+		if len(s.tierlimits) == 0 {
+			continue
+		}
+		matched := false
+		for _, v := range s.tierlimits {
+			if ok, err := regexp.MatchString(v, t[i]); err == nil && ok {
+				matched = true
+				break
 			}
 		}
-	*/
-	return true
+		if !matched {
+			errs = append(errs, fmt.Sprintf("tier%d (%s) does not match any TierFilter %v", i, t[i], s.tierlimits))
+		}
+	}
+	return errs
 }
 
 //nodeInRing just checks to see if the hostname or addresses appear
@@ -838,21 +1210,11 @@ func (s *Server) nodeInRing(hostname string, addrs []string) bool {
 	return false
 }
 
-func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.NodeConfig, error) {
-	s.Lock()
-	defer s.Unlock()
-	s.ctxlog.Debugf("Got Register request: %#v", r)
-	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
-	if err != nil {
-		s.ctxlog.WithFields(log.Fields{
-			"path": fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename),
-			"err":  err,
-		}).Warning("Unable to load builder for change")
-		return &pb.NodeConfig{}, err
-	}
-
+//nodeAddrs turns the network addrs (in CIDR notation) a node reports into its
+//ring addresses, one per service port for every addr within the NetFilter ranges.
+func (s *Server) nodeAddrs(cidrs []string) []string {
 	var addrs []string
-	for _, v := range r.Addrs {
+	for _, v := range cidrs {
 		i, _, err := net.ParseCIDR(v)
 		if err != nil {
 			s.ctxlog.WithFields(log.Fields{"addr": v, "err": err}).Warning("Unknown network addr received during registration")
@@ -864,9 +1226,137 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 			addrs = append(addrs, fmt.Sprintf("%s:%d", i.String(), s.cfg.StorePort))
 		}
 	}
+	return addrs
+}
+
+//nodeWeight determines a registering nodes capacity and whether it should start
+//out active according to the configured WeightAssignment strategy.
+func (s *Server) nodeWeight(hw *pb.HardwareProfile) (uint32, bool, error) {
+	if s.cfg.WeightAssignment == "fixed" {
+		return 1000, true, nil
+	}
+	if hw == nil {
+		return 0, false, fmt.Errorf("No hardware profile provided but required")
+	}
+	if len(hw.Disks) == 0 {
+		return 0, false, fmt.Errorf("No disks in hardware profile")
+	}
+	weight := ExtractCapacity("/data", hw.Disks)
+	switch s.cfg.WeightAssignment {
+	case "self":
+		return weight, weight != 0, nil
+	case "manual":
+		return weight, false, nil
+	default:
+		s.ctxlog.Debug("No weight assignment strategy specified, adding unconfigured node!")
+		return weight, false, nil
+	}
+}
+
+//reconcileNode brings an existing ring entry in line with a re-registering node.
+//Capacity is recomputed under the active WeightAssignment strategy, but only if
+//the hardware derived weight changed since the nodes last stored profile so
+//capacity changes made through synd (SetCapacity, ramps, maintenance drains,
+//decommissions and the capacity policy) survive a re-registration. Entries under
+//the manual strategy, requests without a hardware profile and nodes synd is
+//still managing the capacity of (see capacityManaged) keep their capacity. The
+//addresses are replaced with the nodes current (NetFilter'd) addresses, and the
+//Meta is updated if the entry was only found by address. Everything that differs
+//is applied as a single ring change, if nothing differs the ring is left untouched.
+//The entry as it was beforehand is returned along with its NodeConfig.
+//s.Lock and s.policyLock must be held.
+func (s *Server) reconcileNode(c context.Context, b *ring.Builder, id uint64, metaMatch bool, r *pb.RegisterRequest, addrs []string) (*pb.NodeConfig, *nodeState, error) {
+	node := b.Node(id)
+	if node == nil {
+		return &pb.NodeConfig{}, nil, fmt.Errorf("Node %d found in ring but not in builder", id)
+	}
+	prev := s.nodeState(node)
+	var changed []string
+	if s.cfg.WeightAssignment != "manual" && r.Hardware != nil && len(r.Hardware.Disks) != 0 {
+		weight, _, err := s.nodeWeight(r.Hardware)
+		if err != nil {
+			return &pb.NodeConfig{}, nil, err
+		}
+		switch reason := s.capacityManaged(id); {
+		case weight == node.Capacity():
+		case !s.hardwareWeightChanged(id, weight):
+			s.ctxlog.WithFields(log.Fields{"id": id, "capacity": node.Capacity(), "weight": weight}).Debug("hardware unchanged, keeping capacity")
+		case reason != "":
+			s.ctxlog.WithFields(log.Fields{"id": id, "capacity": node.Capacity(), "weight": weight, "reason": reason}).Info("keeping capacity of managed node")
+		default:
+			s.ctxlog.WithFields(log.Fields{"id": id, "old": node.Capacity(), "new": weight}).Debug("capacity changed")
+			node.SetCapacity(weight)
+			changed = append(changed, "capacity")
+		}
+	}
+	oldCmdCtrlAddr := node.Address(s.cfg.CmdCtrlIndex)
+	if strings.Join(node.Addresses(), "|") != strings.Join(addrs, "|") {
+		s.ctxlog.WithFields(log.Fields{"id": id, "old": strings.Join(node.Addresses(), "|"), "new": strings.Join(addrs, "|")}).Debug("addresses changed")
+		node.ReplaceAddresses(addrs)
+		changed = append(changed, "addresses")
+	}
+	if !metaMatch {
+		s.ctxlog.WithFields(log.Fields{"id": id, "old": node.Meta(), "new": r.Hostname}).Debug("meta changed")
+		node.SetMeta(r.Hostname)
+		changed = append(changed, "meta")
+	}
+	if len(changed) == 0 {
+		s.ctxlog.WithField("id", id).Info("reregistered existing node")
+		s.setNodeHardware(id, r.Hostname, r.Hardware)
+		return &pb.NodeConfig{Localid: id, Ring: *s.rb}, prev, nil
+	}
+	newRing := b.Ring()
+	s.ctxlog.WithFields(log.Fields{
+		"id":               id,
+		"changed":          strings.Join(changed, "|"),
+		"proposed-ringver": newRing.Version(),
+	}).Info("attempting to apply ring version")
+	err := s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "RegisterNode", nodes: []uint64{id}, caller: callerFromContext(c)})
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{
+			"proposed-ringver": newRing.Version(),
+			"ringver":          s.r.Version(),
+			"err":              err,
+		}).Warning("failed to apply ring change")
+		return &pb.NodeConfig{}, nil, fmt.Errorf("Unable to apply ring change during registration")
+	}
+	s.ctxlog.WithFields(log.Fields{"id": id, "ringver": s.r.Version()}).Info("reregistered and updated existing node")
+	if newCmdCtrlAddr := node.Address(s.cfg.CmdCtrlIndex); newCmdCtrlAddr != oldCmdCtrlAddr {
+		s.replaceManagedNode(id, newCmdCtrlAddr)
+	}
+	s.setNodeHardware(id, r.Hostname, r.Hardware)
+	return &pb.NodeConfig{Localid: id, Ring: *s.rb}, prev, nil
+}
+
+//RegisterNode adds a new node to the ring or, if the node is already present,
+//reconciles its existing entry with the provided hardware profile and addresses.
+func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.NodeConfig, error) {
+	nc, _, err := s.registerNode(c, r)
+	return nc, err
+}
+
+//registerNode does the work of RegisterNode and also returns the state of the
+//existing entry before it was reconciled, or nil if the node was newly added.
+func (s *Server) registerNode(c context.Context, r *pb.RegisterRequest) (*pb.NodeConfig, *nodeState, error) {
+	//policyLock is taken first as the policy applies its changes while holding it
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	s.Lock()
+	defer s.Unlock()
+	s.ctxlog.Debugf("Got Register request: %#v", r)
+	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{
+			"path": fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename),
+			"err":  err,
+		}).Warning("Unable to load builder for change")
+		return &pb.NodeConfig{}, nil, err
+	}
+
+	addrs := s.nodeAddrs(r.Addrs)
 	switch {
 	case len(addrs) == 0:
-		return &pb.NodeConfig{}, InvalidAddrs
+		return &pb.NodeConfig{}, nil, InvalidAddrs
 	case s.nodeInRing(r.Hostname, addrs):
 		a := strings.Join(addrs, "|")
 		metanodes, _ := s.r.Nodes().Filter([]string{fmt.Sprintf("meta~=%s.*", r.Hostname)})
@@ -877,7 +1367,7 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 				"meta-search":  r.Hostname,
 				"err":          "more than one meta match when search for node ID",
 			}).Warning("error registering node")
-			return &pb.NodeConfig{}, fmt.Errorf("Node already in ring/unable to obtain ID (too many matches)")
+			return &pb.NodeConfig{}, nil, fmt.Errorf("Node already in ring/unable to obtain ID (too many matches)")
 		}
 		addrnodes, _ := s.r.Nodes().Filter([]string{fmt.Sprintf("address~=%s", a)})
 		if len(addrnodes) > 1 {
@@ -887,7 +1377,7 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 				"meta-search":  r.Hostname,
 				"err":          "more than one addr match when search for node ID",
 			}).Warning("error registering node")
-			return &pb.NodeConfig{}, fmt.Errorf("Node already in ring/unable to obtain ID (too many matches)")
+			return &pb.NodeConfig{}, nil, fmt.Errorf("Node already in ring/unable to obtain ID (too many matches)")
 		}
 		var metaid uint64
 		if len(metanodes) == 1 {
@@ -897,7 +1387,7 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 		if len(addrnodes) == 1 {
 			addrid = addrnodes[0].ID()
 		}
-		if metaid != addrid {
+		if metaid != 0 && addrid != 0 && metaid != addrid {
 			s.ctxlog.WithFields(log.Fields{
 				"ringver":      s.r.Version(),
 				"addrid":       addrid,
@@ -906,61 +1396,36 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 				"meta-search":  r.Hostname,
 				"err":          "addrid and metaid conflict (are not the same)",
 			}).Warning("error registering node")
-			return &pb.NodeConfig{}, fmt.Errorf("Node already in ring, unable to obtain ID (id by addr and id by meta do not match")
+			return &pb.NodeConfig{}, nil, fmt.Errorf("Registration conflict: hostname %s matches node %d but addresses match node %d", r.Hostname, metaid, addrid)
+		}
+		id := metaid
+		if id == 0 {
+			id = addrid
 		}
-		s.ctxlog.WithField("id", addrid).Info("reregistered existing node")
-		return &pb.NodeConfig{Localid: addrid, Ring: *s.rb}, nil
+		return s.reconcileNode(c, b, id, metaid == id, r, addrs)
 	case len(r.Tiers) == 0:
-		return &pb.NodeConfig{}, fmt.Errorf("No tier0 provided")
+		return &pb.NodeConfig{}, nil, fmt.Errorf("No tier0 provided")
 	case len(r.Tiers) > 0:
 		if !s.validTiers(r.Tiers) {
-			return &pb.NodeConfig{}, InvalidTiers
+			return &pb.NodeConfig{}, nil, InvalidTiers
+		}
+		if errs := s.tierFilterErrors(r.Tiers); len(errs) != 0 {
+			s.ctxlog.WithFields(log.Fields{
+				"hostname": r.Hostname,
+				"tiers":    strings.Join(r.Tiers, "|"),
+				"err":      strings.Join(errs, ", "),
+			}).Warning("error registering node")
+			return &pb.NodeConfig{}, nil, fmt.Errorf("Invalid tiers: %s", strings.Join(errs, ", "))
 		}
 	}
 
-	var weight uint32
-	nodeEnabled := false
-
-	switch s.cfg.WeightAssignment {
-	case "fixed":
-		weight = 1000
-		nodeEnabled = true
-	case "self":
-		if r.Hardware == nil {
-			return &pb.NodeConfig{}, fmt.Errorf("No hardware profile provided but required")
-		}
-		if len(r.Hardware.Disks) == 0 {
-			return &pb.NodeConfig{}, fmt.Errorf("No disks in hardware profile")
-		}
-		weight = ExtractCapacity("/data", r.Hardware.Disks)
-		if weight == 0 {
-			nodeEnabled = false
-		} else {
-			nodeEnabled = true
-		}
-	case "manual":
-		if r.Hardware == nil {
-			return &pb.NodeConfig{}, fmt.Errorf("No hardware profile provided but required")
-		}
-		if len(r.Hardware.Disks) == 0 {
-			return &pb.NodeConfig{}, fmt.Errorf("No disks in hardware profile")
-		}
-		weight = ExtractCapacity("/data", r.Hardware.Disks)
-		nodeEnabled = false
-	default:
-		s.ctxlog.Debug("No weight assignment strategy specified, adding unconfigured node!")
-		if r.Hardware == nil {
-			return &pb.NodeConfig{}, fmt.Errorf("No hardware profile provided but required")
-		}
-		if len(r.Hardware.Disks) == 0 {
-			return &pb.NodeConfig{}, fmt.Errorf("No disks in hardware profile")
-		}
-		weight = ExtractCapacity("/data", r.Hardware.Disks)
-		nodeEnabled = false
+	weight, nodeEnabled, err := s.nodeWeight(r.Hardware)
+	if err != nil {
+		return &pb.NodeConfig{}, nil, err
 	}
 	n, err := b.AddNode(nodeEnabled, weight, r.Tiers, addrs, r.Hostname, []byte(""))
 	if err != nil {
-		return &pb.NodeConfig{}, err
+		return &pb.NodeConfig{}, nil, err
 	}
 	s.ctxlog.WithFields(log.Fields{
 		"ID":        n.ID(),
@@ -973,14 +1438,14 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 	}).Debug("proposed ring entry")
 	newRing := b.Ring()
 	s.ctxlog.WithField("proposed-ringver", newRing.Version()).Info("attempting to apply ring version")
-	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version()})
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "RegisterNode", nodes: []uint64{n.ID()}, caller: callerFromContext(c)})
 	if err != nil {
 		s.ctxlog.WithFields(log.Fields{
 			"proposed-ringver": newRing.Version(),
 			"ringver":          s.r.Version(),
 			"err":              err,
 		}).Warning("failed to apply ring change")
-		return &pb.NodeConfig{}, fmt.Errorf("Unable to apply ring change during registration")
+		return &pb.NodeConfig{}, nil, fmt.Errorf("Unable to apply ring change during registration")
 	}
 	s.ctxlog.WithField("ringver", s.r.Version()).Info("updated ring")
 	s.managedNodes[n.ID()], err = NewManagedNode(&ManagedNodeOpts{Address: n.Address(s.cfg.CmdCtrlIndex)})
@@ -993,5 +1458,106 @@ func (s *Server) RegisterNode(c context.Context, r *pb.RegisterRequest) (*pb.Nod
 	}
 	s.metrics.managedNodes.Inc()
 	s.ctxlog.WithField("id", n.ID()).Debug("added managed node")
-	return &pb.NodeConfig{Localid: n.ID(), Ring: *s.rb}, nil
+	s.setNodeHardware(n.ID(), r.Hostname, r.Hardware)
+	return &pb.NodeConfig{Localid: n.ID(), Ring: *s.rb}, nil, nil
+}
+
+//metaHostname returns the hostname a ring entry's Meta starts with, i.e. everything
+//before any "|" separated extras.
+func metaHostname(meta string) string {
+	if i := strings.Index(meta, "|"); i >= 0 {
+		return meta[:i]
+	}
+	return meta
+}
+
+//sharesAddress reports whether any of the addresses appear in both lists.
+func sharesAddress(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//ReplaceNode moves an existing ring entry onto a new host, i.e. one rebuilt with new
+//hardware or IPs. The entry keeps its ID and tiers so no data is reshuffled beyond
+//what a capacity change causes. The addresses (NetFilter'd as in RegisterNode) and
+//Meta are swapped for the new hosts and capacity is recomputed from its hardware
+//profile (entries under the manual strategy and nodes synd is still managing the
+//capacity of keep their capacity), all as a single ring change. The nodes managed node is then reconnected to the new host.
+func (s *Server) ReplaceNode(c context.Context, r *pb.ReplaceNodeRequest) (*pb.NodeConfig, error) {
+	//policyLock is taken first as the policy applies its changes while holding it
+	s.policyLock.Lock()
+	defer s.policyLock.Unlock()
+	s.Lock()
+	defer s.Unlock()
+	s.ctxlog.Debugf("Got Replace request: %#v", r)
+	if s.r.Node(r.Id) == nil {
+		return &pb.NodeConfig{}, fmt.Errorf("Node %d not found", r.Id)
+	}
+	if r.Hostname == "" {
+		return &pb.NodeConfig{}, fmt.Errorf("No hostname provided")
+	}
+	addrs := s.nodeAddrs(r.Addrs)
+	if len(addrs) == 0 {
+		return &pb.NodeConfig{}, InvalidAddrs
+	}
+	for _, n := range s.r.Nodes() {
+		if n.ID() != r.Id && (metaHostname(n.Meta()) == r.Hostname || sharesAddress(n.Addresses(), addrs)) {
+			return &pb.NodeConfig{}, fmt.Errorf("Hostname %s or addresses already in ring for node %d", r.Hostname, n.ID())
+		}
+	}
+
+	b, err := s.getBuilderFn(fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename))
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{
+			"path": fmt.Sprintf("%s/%s.builder", s.cfg.RingDir, s.servicename),
+			"err":  err,
+		}).Warning("Unable to load builder for change")
+		return &pb.NodeConfig{}, err
+	}
+	node := b.Node(r.Id)
+	if node == nil {
+		return &pb.NodeConfig{}, fmt.Errorf("Node %d found in ring but not in builder", r.Id)
+	}
+	if s.cfg.WeightAssignment != "manual" {
+		weight, _, err := s.nodeWeight(r.Hardware)
+		if err != nil {
+			return &pb.NodeConfig{}, err
+		}
+		if reason := s.capacityManaged(r.Id); reason != "" {
+			s.ctxlog.WithFields(log.Fields{"id": r.Id, "capacity": node.Capacity(), "weight": weight, "reason": reason}).Info("keeping capacity of managed node")
+		} else {
+			node.SetCapacity(weight)
+		}
+	}
+	s.ctxlog.WithFields(log.Fields{
+		"id":           r.Id,
+		"oldAddresses": strings.Join(node.Addresses(), "|"),
+		"newAddresses": strings.Join(addrs, "|"),
+		"oldMeta":      node.Meta(),
+		"newMeta":      r.Hostname,
+		"capacity":     node.Capacity(),
+	}).Debug("proposed ring entry")
+	node.ReplaceAddresses(addrs)
+	node.SetMeta(r.Hostname)
+	newRing := b.Ring()
+	s.ctxlog.WithFields(log.Fields{"id": r.Id, "proposed-ringver": newRing.Version()}).Info("attempting to apply ring version")
+	err = s.applyRingChange(&RingChange{b: b, r: newRing, v: newRing.Version(), kind: "ReplaceNode", nodes: []uint64{r.Id}, caller: callerFromContext(c)})
+	if err != nil {
+		s.ctxlog.WithFields(log.Fields{
+			"proposed-ringver": newRing.Version(),
+			"ringver":          s.r.Version(),
+			"err":              err,
+		}).Warning("failed to apply ring change")
+		return &pb.NodeConfig{}, fmt.Errorf("Unable to apply ring change during replacement")
+	}
+	s.ctxlog.WithFields(log.Fields{"id": r.Id, "hostname": r.Hostname, "ringver": s.r.Version()}).Info("replaced node")
+	s.replaceManagedNode(r.Id, node.Address(s.cfg.CmdCtrlIndex))
+	s.setNodeHardware(r.Id, r.Hostname, r.Hardware)
+	return &pb.NodeConfig{Localid: r.Id, Ring: *s.rb}, nil
 }
@@ -85,6 +85,17 @@ func newTestServerWithDefaults() (*Server, *MockRingBuilderThings) {
 	return s, mock
 }
 
+//useTempRingDir points the servers RingDir at a new temp dir, returning a func
+//that removes it.
+func useTempRingDir(t *testing.T, s *Server) func() {
+	dir, err := ioutil.TempDir("", "syndicate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.cfg.RingDir = dir
+	return func() { os.RemoveAll(dir) }
+}
+
 func newTestServer(cfg *Config, servicename string, mockinfo *MockRingBuilderThings) *Server {
 	s := &Server{}
 	s.cfg = cfg
@@ -101,6 +112,8 @@ func newTestServer(cfg *Config, servicename string, mockinfo *MockRingBuilderThi
 	s.managedNodes = make(map[uint64]ManagedNode, 0)
 	s.slaves = mockinfo.slaves
 	s.changeChan = mockinfo.changeChan
+	s.ringSubs = &RingSubscribers{subs: make(map[string]*ringSubscriber)}
+	s.eventWatchers = &RingEventWatchers{watchers: make(map[*ringEventWatcher]bool)}
 	s.metrics = metricsInit(s.servicename)
 	return s
 }
@@ -744,6 +757,7 @@ func TestServer_GetRing(t *testing.T) {
 
 func TestServer_RegisterNode(t *testing.T) {
 	s, m := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
 	ctx := context.Background()
 
 	okHwProfile := &pb.HardwareProfile{
@@ -760,12 +774,6 @@ func TestServer_RegisterNode(t *testing.T) {
 			Tiers:    []string{"badnetiface.test.com", "zone1"},
 			Hardware: okHwProfile,
 		},
-		"Duplicate server name and addr": &pb.RegisterRequest{
-			Hostname: "server1",
-			Addrs:    []string{"1.2.3.4/32", "127.0.0.1/32", "192.168.2.2/32"},
-			Tiers:    []string{"server1", "zone1"},
-			Hardware: okHwProfile,
-		},
 		"Bad tier": &pb.RegisterRequest{
 			Hostname: "server42",
 			Addrs:    []string{"10.0.0.42/32", "127.0.0.1/32", "192.168.2.2/32"},
@@ -781,6 +789,29 @@ func TestServer_RegisterNode(t *testing.T) {
 		}
 	}
 
+	//a duplicate server name and addr is the existing node re-registering, it gets
+	//its own entry back. The first registration brings the entry's addresses in
+	//line, after that repeating it leaves the ring alone.
+	dup := &pb.RegisterRequest{
+		Hostname: "server1",
+		Addrs:    []string{"1.2.3.4/32", "127.0.0.1/32", "192.168.2.2/32"},
+		Tiers:    []string{"server1", "zone1"},
+		Hardware: okHwProfile,
+	}
+	dupid := s.r.Nodes()[0].ID()
+	r, err := s.RegisterNode(ctx, dup)
+	if err != nil || r.Localid != dupid {
+		t.Errorf("RegisterNode(ctx, %#v) should have returned the existing id %d: (%#v, %v)", dup, dupid, r, err)
+	}
+	origVersion := s.r.Version()
+	r, err = s.RegisterNode(ctx, dup)
+	if err != nil || r.Localid != dupid {
+		t.Errorf("RegisterNode(ctx, %#v) again should have returned the existing id %d: (%#v, %v)", dup, dupid, r, err)
+	}
+	if s.r.Version() != origVersion {
+		t.Errorf("RegisterNode(ctx, %#v) of an unchanged node should not have changed the ring version", dup)
+	}
+
 	//now add a valid entry with the default strategy
 	validRequest := &pb.RegisterRequest{
 		Hostname: "server2",
@@ -788,7 +819,7 @@ func TestServer_RegisterNode(t *testing.T) {
 		Tiers:    []string{"server2", "zone2"},
 		Hardware: okHwProfile,
 	}
-	r, err := s.RegisterNode(ctx, validRequest)
+	r, err = s.RegisterNode(ctx, validRequest)
 	if err != nil {
 		t.Errorf("RegisterNode(ctx, %#v) (%#v, %s) should have succeeded", validRequest, r, err.Error())
 	}
@@ -963,6 +994,225 @@ func TestServer_RegisterNode(t *testing.T) {
 
 }
 
+func TestServer_RegisterNodeReconcile(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	s.cfg.WeightAssignment = "self"
+	ctx := context.Background()
+
+	hw := &pb.HardwareProfile{
+		Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 10 * 1024 * 1024 * 1024}},
+	}
+	rr := &pb.RegisterRequest{
+		Hostname: "server2",
+		Addrs:    []string{"10.0.0.2/32", "127.0.0.1/32"},
+		Tiers:    []string{"server2", "zone2"},
+		Hardware: hw,
+	}
+	nc, err := s.RegisterNode(ctx, rr)
+	if err != nil {
+		t.Fatalf("RegisterNode(ctx, %#v) should have succeeded: %s", rr, err)
+	}
+	id := nc.Localid
+
+	//nothing differs, ring should be untouched
+	origVersion := s.r.Version()
+	nc, err = s.RegisterNode(ctx, rr)
+	if err != nil || nc.Localid != id {
+		t.Errorf("RegisterNode(ctx, %#v) should have returned existing id %d: (%#v, %v)", rr, id, nc, err)
+	}
+	if s.r.Version() != origVersion {
+		t.Errorf("RegisterNode(ctx, %#v) should have been a no-op but ring version changed", rr)
+	}
+
+	//new disks and a new ip, should be a single ring change
+	rr.Hardware = &pb.HardwareProfile{
+		Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 20 * 1024 * 1024 * 1024}},
+	}
+	rr.Addrs = []string{"10.0.0.20/32", "127.0.0.1/32"}
+	origVersion = s.r.Version()
+	nc, err = s.RegisterNode(ctx, rr)
+	if err != nil || nc.Localid != id {
+		t.Fatalf("RegisterNode(ctx, %#v) should have reconciled existing id %d: (%#v, %v)", rr, id, nc, err)
+	}
+	if s.r.Version() == origVersion {
+		t.Errorf("RegisterNode(ctx, %#v) should have changed the ring", rr)
+	}
+	node := s.r.Node(id)
+	if node.Capacity() != 20 {
+		t.Errorf("RegisterNode(ctx, %#v) capacity should have been recomputed to 20 but is %d", rr, node.Capacity())
+	}
+	if node.Address(0) != "10.0.0.20:0" {
+		t.Errorf("RegisterNode(ctx, %#v) addresses should have been refreshed but are %v", rr, node.Addresses())
+	}
+	if len(s.r.Nodes()) != 3 {
+		t.Errorf("RegisterNode(ctx, %#v) should not have added a new ring entry", rr)
+	}
+
+	//renamed host, found by address so meta gets updated
+	rr.Hostname = "renamed2"
+	nc, err = s.RegisterNode(ctx, rr)
+	if err != nil || nc.Localid != id {
+		t.Fatalf("RegisterNode(ctx, %#v) should have reconciled existing id %d: (%#v, %v)", rr, id, nc, err)
+	}
+	if s.r.Node(id).Meta() != "renamed2" {
+		t.Errorf("RegisterNode(ctx, %#v) meta should have been updated but is %s", rr, s.r.Node(id).Meta())
+	}
+
+	//capacity set through synd survives a re-registration with unchanged hardware
+	if _, err = s.SetCapacity(ctx, &pb.Node{Id: id, Capacity: 5}); err != nil {
+		t.Fatalf("SetCapacity returned unexpected error: %s", err)
+	}
+	if _, err = s.RegisterNode(ctx, rr); err != nil || s.r.Node(id).Capacity() != 5 {
+		t.Errorf("RegisterNode(ctx, %#v) with unchanged hardware should have kept capacity 5: %d, %v", rr, s.r.Node(id).Capacity(), err)
+	}
+
+	//nodes in maintenance keep their capacity even if the hardware changed
+	if _, err = s.SetMaintenance(ctx, &pb.MaintenanceRequest{Id: id, Duration: 3600}); err != nil {
+		t.Fatalf("SetMaintenance returned unexpected error: %s", err)
+	}
+	rr.Hardware = &pb.HardwareProfile{
+		Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 30 * 1024 * 1024 * 1024}},
+	}
+	if _, err = s.RegisterNode(ctx, rr); err != nil || s.r.Node(id).Capacity() != 5 {
+		t.Errorf("RegisterNode(ctx, %#v) in maintenance should have kept capacity 5: %d, %v", rr, s.r.Node(id).Capacity(), err)
+	}
+	if _, err = s.ClearMaintenance(ctx, &pb.MaintenanceRequest{Id: id}); err != nil {
+		t.Fatalf("ClearMaintenance returned unexpected error: %s", err)
+	}
+
+	//manual strategy leaves capacity alone
+	s.cfg.WeightAssignment = "manual"
+	rr.Hardware = hw
+	nc, err = s.RegisterNode(ctx, rr)
+	if err != nil || nc.Localid != id {
+		t.Errorf("RegisterNode(ctx, %#v) should have returned existing id %d: (%#v, %v)", rr, id, nc, err)
+	}
+	if s.r.Node(id).Capacity() != 5 {
+		t.Errorf("RegisterNode(ctx, %#v) manual strategy should not have touched capacity but is %d", rr, s.r.Node(id).Capacity())
+	}
+
+	//hostname belongs to one node, addresses to another
+	conflict := &pb.RegisterRequest{
+		Hostname: "server1",
+		Addrs:    []string{"10.0.0.20/32"},
+		Tiers:    []string{"server1", "zone1"},
+		Hardware: hw,
+	}
+	origVersion = s.r.Version()
+	_, err = s.RegisterNode(ctx, conflict)
+	if err == nil || !strings.Contains(err.Error(), "conflict") {
+		t.Errorf("RegisterNode(ctx, %#v) should have reported a conflict but got: %v", conflict, err)
+	}
+	if s.r.Version() != origVersion {
+		t.Errorf("RegisterNode(ctx, %#v) conflict should not have changed the ring", conflict)
+	}
+}
+
+func TestServer_ReplaceNode(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	s.cfg.WeightAssignment = "self"
+	ctx := context.Background()
+	old := s.r.Nodes()[0]
+	id := old.ID()
+	fake := &fakeManagedNode{address: old.Address(0)}
+	s.managedNodes[id] = fake
+
+	hw := &pb.HardwareProfile{
+		Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 30 * 1024 * 1024 * 1024}},
+	}
+	badRequests := map[string]*pb.ReplaceNodeRequest{
+		"Unknown node":          &pb.ReplaceNodeRequest{Id: 42, Hostname: "server1b", Addrs: []string{"10.0.0.11/32"}, Hardware: hw},
+		"No hostname":           &pb.ReplaceNodeRequest{Id: id, Addrs: []string{"10.0.0.11/32"}, Hardware: hw},
+		"Bad Network Interface": &pb.ReplaceNodeRequest{Id: id, Hostname: "server1b", Addrs: []string{"127.0.0.1/32", "192.168.2.2/32"}, Hardware: hw},
+		"Hostname in use":       &pb.ReplaceNodeRequest{Id: id, Hostname: "dummy1", Addrs: []string{"10.0.0.11/32"}, Hardware: hw},
+		"No hardware profile":   &pb.ReplaceNodeRequest{Id: id, Hostname: "server1b", Addrs: []string{"10.0.0.11/32"}},
+	}
+	origVersion := s.r.Version()
+	for k, r := range badRequests {
+		if _, err := s.ReplaceNode(ctx, r); err == nil {
+			t.Errorf("ReplaceNode(ctx, %#v) should have returned error because %s", r, k)
+		}
+	}
+	if s.r.Version() != origVersion {
+		t.Errorf("failed ReplaceNode requests should not have changed the ring")
+	}
+
+	r := &pb.ReplaceNodeRequest{Id: id, Hostname: "server1b", Addrs: []string{"10.0.0.11/32", "127.0.0.1/32"}, Hardware: hw}
+	nc, err := s.ReplaceNode(ctx, r)
+	if err != nil || nc.Localid != id {
+		t.Fatalf("ReplaceNode(ctx, %#v) should have kept id %d: (%#v, %v)", r, id, nc, err)
+	}
+	if s.r.Version() == origVersion {
+		t.Errorf("ReplaceNode(ctx, %#v) should have changed the ring", r)
+	}
+	node := s.r.Node(id)
+	if node.Address(0) != "10.0.0.11:0" || node.Meta() != "server1b" || node.Capacity() != 30 {
+		t.Errorf("ReplaceNode(ctx, %#v) left unexpected entry: %v %s %d", r, node.Addresses(), node.Meta(), node.Capacity())
+	}
+	if strings.Join(node.Tiers(), "|") != strings.Join(old.Tiers(), "|") || !node.Active() {
+		t.Errorf("ReplaceNode(ctx, %#v) should have kept tiers %v and active, got %v %v", r, old.Tiers(), node.Tiers(), node.Active())
+	}
+	if len(s.r.Nodes()) != 2 {
+		t.Errorf("ReplaceNode(ctx, %#v) should not have added a ring entry", r)
+	}
+	if mn, ok := s.managedNodes[id]; !ok || mn == fake || mn.Address() != "10.0.0.11:0" {
+		t.Errorf("ReplaceNode(ctx, %#v) should have reconnected the managed node to the new host", r)
+	}
+
+	//manual strategy keeps the capacity
+	s.cfg.WeightAssignment = "manual"
+	r = &pb.ReplaceNodeRequest{Id: id, Hostname: "server1c", Addrs: []string{"10.0.0.12/32"}}
+	if _, err := s.ReplaceNode(ctx, r); err != nil {
+		t.Fatalf("ReplaceNode(ctx, %#v) returned unexpected error: %s", r, err)
+	}
+	if node := s.r.Node(id); node.Capacity() != 30 || node.Meta() != "server1c" {
+		t.Errorf("ReplaceNode(ctx, %#v) manual strategy should have only swapped the host: %s %d", r, node.Meta(), node.Capacity())
+	}
+
+	//capacity synd is still managing isn't overwritten by the new hardware
+	s.cfg.WeightAssignment = "self"
+	s.policy = &capacityPolicy{Nodes: []*policyNode{{Id: id, OriginalCapacity: 40}}}
+	big := &pb.HardwareProfile{
+		Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 60 * 1024 * 1024 * 1024}},
+	}
+	r = &pb.ReplaceNodeRequest{Id: id, Hostname: "server10", Addrs: []string{"10.0.0.13/32"}, Hardware: big}
+	if _, err := s.ReplaceNode(ctx, r); err != nil {
+		t.Fatalf("ReplaceNode(ctx, %#v) returned unexpected error: %s", r, err)
+	}
+	if node := s.r.Node(id); node.Capacity() != 30 || node.Meta() != "server10" {
+		t.Errorf("ReplaceNode(ctx, %#v) should have kept the policy managed capacity: %s %d", r, node.Meta(), node.Capacity())
+	}
+	s.policy = nil
+
+	//hostnames only conflict on an exact match
+	other := s.r.Nodes()[1].ID()
+	if other == id {
+		other = s.r.Nodes()[0].ID()
+	}
+	r = &pb.ReplaceNodeRequest{Id: other, Hostname: "server1", Addrs: []string{"10.0.0.21/32"}, Hardware: hw}
+	if _, err := s.ReplaceNode(ctx, r); err != nil {
+		t.Errorf("ReplaceNode(ctx, %#v) shouldn't conflict with server10: %s", r, err)
+	}
+	r = &pb.ReplaceNodeRequest{Id: id, Hostname: "db1xexample.com", Addrs: []string{"10.0.0.14/32"}, Hardware: hw}
+	if _, err := s.ReplaceNode(ctx, r); err != nil {
+		t.Fatalf("ReplaceNode(ctx, %#v) returned unexpected error: %s", r, err)
+	}
+	r = &pb.ReplaceNodeRequest{Id: other, Hostname: "db1.example.com", Addrs: []string{"10.0.0.22/32"}, Hardware: hw}
+	if _, err := s.ReplaceNode(ctx, r); err != nil {
+		t.Errorf("ReplaceNode(ctx, %#v) shouldn't conflict with db1xexample.com: %s", r, err)
+	}
+	r = &pb.ReplaceNodeRequest{Id: id, Hostname: "db1.example.com", Addrs: []string{"10.0.0.15/32"}, Hardware: hw}
+	if _, err := s.ReplaceNode(ctx, r); err == nil {
+		t.Errorf("ReplaceNode(ctx, %#v) should have returned error because the hostname is in use", r)
+	}
+	r = &pb.ReplaceNodeRequest{Id: id, Hostname: "server1d", Addrs: []string{"10.0.0.22/32"}, Hardware: hw}
+	if _, err := s.ReplaceNode(ctx, r); err == nil {
+		t.Errorf("ReplaceNode(ctx, %#v) should have returned error because the address is in use", r)
+	}
+}
+
 func TestParseSlaveAddrs(t *testing.T) {
 	slaves := []string{"1.1.1.1:8000", "2.2.2.2:8000"}
 	rslaves := parseSlaveAddrs(slaves)
@@ -1018,6 +1268,9 @@ func TestServer_ParseConfig(t *testing.T) {
 	if s.cfg.KeyFile != DefaultCertKey {
 		t.Errorf("Failed to set default KeyFile: %#v", s.cfg.KeyFile)
 	}
+	if s.cfg.RingSubscriberTimeout != DefaultRingSubscriberTimeout {
+		t.Errorf("Failed to set default RingSubscriberTimeout: %#v", s.cfg.RingSubscriberTimeout)
+	}
 }
 
 func TestServer_LoadRingBuilderBytes(t *testing.T) {
@@ -1196,6 +1449,50 @@ func TestServer_ValidTiers(t *testing.T) {
 
 }
 
+func TestServer_TierFilterErrors(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	defer useTempRingDir(t, s)()
+	s.tierlimits = []string{"^rack[0-9]+$", "^row[0-9]+$", "^dc-.*"}
+
+	oktiers := []string{"server42", "rack1", "row2", "dc-east"}
+	if errs := s.tierFilterErrors(oktiers); len(errs) != 0 {
+		t.Errorf("tierFilterErrors(%#v) returned unexpected errors: %v", oktiers, errs)
+	}
+
+	hostonly := []string{"server42"}
+	if errs := s.tierFilterErrors(hostonly); len(errs) != 0 {
+		t.Errorf("tierFilterErrors(%#v) returned unexpected errors: %v", hostonly, errs)
+	}
+
+	badtiers := []string{"server42", "rack1", "nope", ""}
+	errs := s.tierFilterErrors(badtiers)
+	if len(errs) != 2 {
+		t.Fatalf("tierFilterErrors(%#v) should have returned 2 errors but got: %v", badtiers, errs)
+	}
+	if !strings.HasPrefix(errs[0], "tier2 (nope)") {
+		t.Errorf("tierFilterErrors(%#v) first error should have been for tier2 but got: %s", badtiers, errs[0])
+	}
+	if errs[1] != "tier3 is empty" {
+		t.Errorf("tierFilterErrors(%#v) second error should have been for empty tier3 but got: %s", badtiers, errs[1])
+	}
+
+	ctx := context.Background()
+	rr := &pb.RegisterRequest{
+		Hostname: "server42",
+		Addrs:    []string{"10.0.0.42/32"},
+		Tiers:    badtiers,
+		Hardware: &pb.HardwareProfile{Disks: []*pb.Disk{&pb.Disk{Path: "/data", Size_: 10000000000}}},
+	}
+	_, err := s.RegisterNode(ctx, rr)
+	if err == nil || !strings.Contains(err.Error(), "tier2 (nope)") {
+		t.Errorf("RegisterNode(ctx, %#v) should have failed with per tier errors but got: %v", rr, err)
+	}
+	rr.Tiers = oktiers
+	if _, err = s.RegisterNode(ctx, rr); err != nil {
+		t.Errorf("RegisterNode(ctx, %#v) should have succeeded: %s", rr, err)
+	}
+}
+
 func TestServer_NodeInRing(t *testing.T) {
 	s, _ := newTestServerWithDefaults()
 
@@ -1216,3 +1513,69 @@ func TestServer_NodeInRing(t *testing.T) {
 		t.Errorf("nodeInRing(server2, 1.2.3.5:56789), should have been false because node is not in ring")
 	}
 }
+
+func TestServer_LookupPartition(t *testing.T) {
+	s, m := newTestServerWithDefaults()
+	ctx := context.Background()
+	partitions := uint32(1) << s.r.PartitionBitCount()
+
+	res, err := s.LookupPartition(ctx, &pb.PartitionLookup{Partition: partitions - 1, Previous: true})
+	if err != nil {
+		t.Fatalf("LookupPartition returned unexpected error: %s", err)
+	}
+	if res.Version != s.r.Version() || res.Partition != partitions-1 || res.PreviousVersion != 0 || len(res.PreviousReplicas) != 0 {
+		t.Errorf("LookupPartition returned unexpected result: %#v", res)
+	}
+	owners := s.r.ResponsibleNodes(partitions - 1)
+	if len(res.Replicas) != len(owners) {
+		t.Fatalf("LookupPartition returned %d replicas, expected %d", len(res.Replicas), len(owners))
+	}
+	for i, n := range owners {
+		if res.Replicas[i].Id != n.ID() || res.Replicas[i].Meta != n.Meta() || res.Replicas[i].Addresses[0] != n.Addresses()[0] {
+			t.Errorf("LookupPartition replica %d was %#v, expected node %d", i, res.Replicas[i], n.ID())
+		}
+	}
+
+	if _, err = s.LookupPartition(ctx, &pb.PartitionLookup{Partition: partitions}); err == nil {
+		t.Errorf("LookupPartition with an out of range partition should have errored")
+	}
+
+	prev := s.r
+	m.builder.SetConfig([]byte("lookuptest"))
+	change := &RingChange{r: m.builder.Ring(), b: m.builder}
+	change.v = change.r.Version()
+	if err := s.applyRingChange(change); err != nil {
+		t.Fatalf("applyRingChange returned unexpected error: %s", err)
+	}
+	res, err = s.LookupPartition(ctx, &pb.PartitionLookup{Partition: 1, Previous: true})
+	if err != nil {
+		t.Fatalf("LookupPartition returned unexpected error: %s", err)
+	}
+	if res.Version != change.v || res.PreviousVersion != prev.Version() || res.PreviousPartition != 1 || len(res.PreviousReplicas) != len(prev.ResponsibleNodes(1)) {
+		t.Errorf("LookupPartition returned unexpected previous ring result: %#v", res)
+	}
+	res, err = s.LookupPartition(ctx, &pb.PartitionLookup{Partition: 1})
+	if err != nil || res.PreviousVersion != 0 || len(res.PreviousReplicas) != 0 {
+		t.Errorf("LookupPartition should only include the previous ring when asked: %#v, %v", res, err)
+	}
+}
+
+func TestServer_LookupKey(t *testing.T) {
+	s, _ := newTestServerWithDefaults()
+	ctx := context.Background()
+
+	res, err := s.LookupKey(ctx, &pb.KeyLookup{Key: []byte("somekey")})
+	if err != nil {
+		t.Fatalf("LookupKey returned unexpected error: %s", err)
+	}
+	if expected := keyPartition([]byte("somekey"), s.r.PartitionBitCount()); res.Partition != expected {
+		t.Errorf("LookupKey returned partition %d, expected %d", res.Partition, expected)
+	}
+	if len(res.Replicas) != len(s.r.ResponsibleNodes(res.Partition)) {
+		t.Errorf("LookupKey returned %d replicas, expected %d", len(res.Replicas), len(s.r.ResponsibleNodes(res.Partition)))
+	}
+
+	if _, err = s.LookupKey(ctx, &pb.KeyLookup{}); err == nil {
+		t.Errorf("LookupKey with an empty key should have errored")
+	}
+}
@@ -0,0 +1,587 @@
+package syndicate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	DefaultUpgradeBatchSize     = 1   //The default number of nodes upgraded at once
+	DefaultUpgradeHealthTimeout = 300 //The default seconds upgraded nodes have to answer a Ping
+	DefaultUpgradeSoak          = 300 //The default seconds canaries have to stay healthy before the rest are upgraded
+)
+
+var (
+	//upgradePingInterval is how often upgraded nodes are pinged while waiting for
+	//them to come back healthy.
+	upgradePingInterval = 5 * time.Second
+
+	UpgradeInProgress = errors.New("An upgrade is already in progress")
+	NoUpgrade         = errors.New("No upgrade in progress")
+)
+
+//clusterUpgrade is a rolling software upgrade of the managed nodes. Nodes are
+//grouped into failure domains by the requested tier and upgraded in batches that
+//never span more than one domain. Each batch has to come back healthy and report
+//the new version before the next one starts. If canaries are requested they're
+//upgraded first, as their own batch, and have to stay healthy for the soak period
+//before anything else is upgraded. If a canary fails every node that was asked to
+//upgrade is rolled back to its previous version. Nodes in maintenance are left
+//out of the batches and reported as skipped.
+type clusterUpgrade struct {
+	sync.Mutex
+	s       *Server
+	status  *pb.UpgradeStatus
+	batches [][]*pb.UpgradeNode
+	nodes   map[uint64]ManagedNode
+	timeout time.Duration
+	soak    time.Duration
+	changed chan struct{} //closed and replaced whenever the status changes
+	done    chan struct{} //closed once the upgrade has stopped running
+	ctxlog  *log.Entry
+}
+
+//planUpgrade works out the batches for upgrading the managed nodes.
+func (s *Server) planUpgrade(r *pb.UpgradeRequest) (*clusterUpgrade, error) {
+	if r.Version == "" {
+		return nil, fmt.Errorf("No version provided")
+	}
+	if r.Tier < 0 {
+		return nil, fmt.Errorf("Invalid tier %d", r.Tier)
+	}
+	if r.HealthTimeout < 0 {
+		return nil, fmt.Errorf("Invalid health timeout %d", r.HealthTimeout)
+	}
+	if r.Soak < 0 {
+		return nil, fmt.Errorf("Invalid soak %d", r.Soak)
+	}
+	u := &clusterUpgrade{
+		s: s,
+		status: &pb.UpgradeStatus{
+			Version:   r.Version,
+			State:     pb.UpgradeState_UPGRADE_RUNNING,
+			BatchSize: r.BatchSize,
+			Tier:      r.Tier,
+			Started:   time.Now().Unix(),
+		},
+		nodes:   make(map[uint64]ManagedNode),
+		timeout: time.Duration(r.HealthTimeout) * time.Second,
+		soak:    time.Duration(r.Soak) * time.Second,
+		changed: make(chan struct{}),
+		done:    make(chan struct{}),
+		ctxlog:  s.ctxlog.WithField("upgrade", r.Version),
+	}
+	if u.status.BatchSize == 0 {
+		u.status.BatchSize = DefaultUpgradeBatchSize
+	}
+	if u.timeout == 0 {
+		u.timeout = DefaultUpgradeHealthTimeout * time.Second
+	}
+	if u.soak == 0 {
+		u.soak = DefaultUpgradeSoak * time.Second
+	}
+	canaries := make(map[uint64]bool, len(r.Canaries))
+	for _, id := range r.Canaries {
+		canaries[id] = true
+	}
+
+	domains := make(map[string][]*pb.UpgradeNode)
+	var canaryBatch, skipped []*pb.UpgradeNode
+	s.RLock()
+	for id, mn := range s.managedNodes {
+		n := s.r.Node(id)
+		if n == nil {
+			continue
+		}
+		var domain string
+		if tiers := n.Tiers(); int(r.Tier) < len(tiers) {
+			domain = tiers[r.Tier]
+		}
+		un := &pb.UpgradeNode{Id: id, Address: mn.Address(), Domain: domain, Canary: canaries[id]}
+		if s.inMaintenance(id) {
+			if un.Canary {
+				s.RUnlock()
+				return nil, fmt.Errorf("Canary %d is in maintenance", id)
+			}
+			un.State = pb.UpgradeNodeState_NODE_SKIPPED
+			un.Error = "In maintenance"
+			skipped = append(skipped, un)
+			continue
+		}
+		if un.Canary {
+			canaryBatch = append(canaryBatch, un)
+		} else {
+			domains[domain] = append(domains[domain], un)
+		}
+		u.nodes[id] = mn
+	}
+	s.RUnlock()
+	if len(u.nodes) == 0 {
+		return nil, fmt.Errorf("No managed nodes to upgrade")
+	}
+	if len(canaryBatch) != len(canaries) {
+		for id := range canaries {
+			if _, ok := u.nodes[id]; !ok {
+				return nil, fmt.Errorf("Canary %d not found or not managed node", id)
+			}
+		}
+	}
+	if len(canaryBatch) != 0 {
+		sort.Sort(upgradeNodesByID(canaryBatch))
+		for _, n := range canaryBatch {
+			n.Batch = 1
+			u.status.Nodes = append(u.status.Nodes, n)
+		}
+		u.batches = append(u.batches, canaryBatch)
+	}
+
+	names := make([]string, 0, len(domains))
+	for domain := range domains {
+		names = append(names, domain)
+	}
+	sort.Strings(names)
+	for _, domain := range names {
+		nodes := domains[domain]
+		sort.Sort(upgradeNodesByID(nodes))
+		for len(nodes) > 0 {
+			size := int(u.status.BatchSize)
+			if size > len(nodes) {
+				size = len(nodes)
+			}
+			batch := nodes[:size]
+			nodes = nodes[size:]
+			for _, n := range batch {
+				n.Batch = uint32(len(u.batches) + 1)
+				u.status.Nodes = append(u.status.Nodes, n)
+			}
+			u.batches = append(u.batches, batch)
+		}
+	}
+	sort.Sort(upgradeNodesByID(skipped))
+	u.status.Nodes = append(u.status.Nodes, skipped...)
+	u.status.Batches = uint32(len(u.batches))
+	return u, nil
+}
+
+//run upgrades the batches in order, stopping at the first batch with a failure.
+//Pausing or aborting takes effect once the current batch (or canary soak) is finished.
+func (u *clusterUpgrade) run() {
+	for i, batch := range u.batches {
+		if !u.waitWhilePaused() {
+			u.ctxlog.Info("upgrade aborted")
+			u.finish(pb.UpgradeState_UPGRADE_ABORTED, "")
+			return
+		}
+		u.update(func() { u.status.Batch = uint32(i + 1) })
+		u.ctxlog.WithFields(log.Fields{"batch": i + 1, "domain": batch[0].Domain, "nodes": len(batch), "canary": batch[0].Canary}).Info("upgrading batch")
+		err := u.upgradeBatch(batch)
+		if err == nil && batch[0].Canary {
+			err = u.soakCanaries(batch)
+		}
+		if err != nil {
+			u.ctxlog.WithFields(log.Fields{"batch": i + 1, "err": err}).Warning("upgrade failed")
+			if batch[0].Canary {
+				u.rollback(err)
+				return
+			}
+			u.finish(pb.UpgradeState_UPGRADE_FAILED, err.Error())
+			return
+		}
+	}
+	u.ctxlog.Info("upgrade complete")
+	u.finish(pb.UpgradeState_UPGRADE_COMPLETE, "")
+}
+
+//upgradeBatch upgrades the nodes in the batch at once, returning an error if any
+//of them failed. Nodes that have gone into maintenance since the upgrade was
+//planned are skipped.
+func (u *clusterUpgrade) upgradeBatch(batch []*pb.UpgradeNode) error {
+	nodes := make(map[uint64]ManagedNode, len(batch))
+	byID := make(map[uint64]*pb.UpgradeNode, len(batch))
+	for _, n := range batch {
+		nodes[n.Id] = u.nodes[n.Id]
+		byID[n.Id] = n
+	}
+	forEachNode(nodes, len(nodes), func(id uint64, mn ManagedNode) {
+		n := byID[id]
+		//nodes can be put into maintenance while the upgrade is running
+		if u.s.inMaintenance(id) {
+			u.ctxlog.WithField("id", id).Info("skipping node in maintenance")
+			u.update(func() {
+				n.State = pb.UpgradeNodeState_NODE_SKIPPED
+				n.Error = "In maintenance"
+			})
+			return
+		}
+		if err := u.upgradeNode(n, mn); err != nil {
+			u.ctxlog.WithFields(log.Fields{"id": id, "err": err}).Warning("node upgrade failed")
+			u.update(func() {
+				n.State = pb.UpgradeNodeState_NODE_FAILED
+				n.Error = err.Error()
+			})
+			return
+		}
+		u.update(func() { n.State = pb.UpgradeNodeState_NODE_UPGRADED })
+	})
+	for _, n := range batch {
+		if n.State == pb.UpgradeNodeState_NODE_FAILED {
+			return fmt.Errorf("Node %d failed to upgrade: %s", n.Id, n.Error)
+		}
+	}
+	return nil
+}
+
+//upgradeNode asks the node to upgrade then waits for it to come back healthy
+//reporting the new version.
+func (u *clusterUpgrade) upgradeNode(n *pb.UpgradeNode, mn ManagedNode) error {
+	u.update(func() { n.State = pb.UpgradeNodeState_NODE_UPGRADING })
+	prev, err := mn.GetSoftwareVersion()
+	if err != nil {
+		return fmt.Errorf("Unable to get current version: %s", err)
+	}
+	u.update(func() { n.PreviousVersion = prev })
+	ok, err := mn.UpgradeSoftwareVersion(u.status.Version)
+	if err != nil {
+		return fmt.Errorf("Upgrade request failed: %s", err)
+	}
+	if !ok {
+		return fmt.Errorf("Node declined upgrade")
+	}
+	u.update(func() { n.State = pb.UpgradeNodeState_NODE_CHECKING })
+	version, err := waitVersion(mn, u.status.Version, u.timeout)
+	u.update(func() { n.Version = version })
+	return err
+}
+
+//soakCanaries keeps pinging the upgraded canaries for the soak period, then checks
+//they still report the new version. Returns an error as soon as a canary fails.
+func (u *clusterUpgrade) soakCanaries(canaries []*pb.UpgradeNode) error {
+	deadline := time.Now().Add(u.soak)
+	u.update(func() { u.status.SoakUntil = deadline.Unix() })
+	u.ctxlog.WithField("soak", u.soak.String()).Info("soaking canaries")
+	for {
+		for _, n := range canaries {
+			if n.State == pb.UpgradeNodeState_NODE_SKIPPED {
+				continue
+			}
+			ok, _, err := u.nodes[n.Id].Ping()
+			if err == nil && ok {
+				continue
+			}
+			if err == nil {
+				err = fmt.Errorf("ping not ok")
+			}
+			u.update(func() {
+				n.State = pb.UpgradeNodeState_NODE_FAILED
+				n.Error = fmt.Sprintf("Failed health check during soak: %s", err)
+			})
+			return fmt.Errorf("Canary %d failed health check during soak: %s", n.Id, err)
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(upgradePingInterval)
+	}
+	for _, n := range canaries {
+		if n.State == pb.UpgradeNodeState_NODE_SKIPPED {
+			continue
+		}
+		version, err := u.nodes[n.Id].GetSoftwareVersion()
+		if err == nil && version != u.status.Version {
+			err = fmt.Errorf("reports version %s, expected %s", version, u.status.Version)
+		}
+		if err != nil {
+			u.update(func() {
+				n.Version = version
+				n.State = pb.UpgradeNodeState_NODE_FAILED
+				n.Error = fmt.Sprintf("Failed version check after soak: %s", err)
+			})
+			return fmt.Errorf("Canary %d failed version check after soak: %s", n.Id, err)
+		}
+	}
+	return nil
+}
+
+//rollback asks every node that was asked to upgrade to go back to the version it
+//was running before, then finishes the upgrade as rolled back.
+func (u *clusterUpgrade) rollback(cause error) {
+	u.ctxlog.WithField("err", cause).Warning("rolling back upgrade")
+	nodes := make(map[uint64]ManagedNode)
+	byID := make(map[uint64]*pb.UpgradeNode)
+	u.update(func() {
+		u.status.State = pb.UpgradeState_UPGRADE_ROLLING_BACK
+		for _, n := range u.status.Nodes {
+			//the previous version is only recorded right before the upgrade request
+			if n.PreviousVersion == "" {
+				continue
+			}
+			nodes[n.Id] = u.nodes[n.Id]
+			byID[n.Id] = n
+		}
+	})
+	var failed int
+	var mu sync.Mutex
+	forEachNode(nodes, len(nodes), func(id uint64, mn ManagedNode) {
+		n := byID[id]
+		u.update(func() { n.State = pb.UpgradeNodeState_NODE_ROLLING_BACK })
+		if err := u.rollbackNode(n, mn); err != nil {
+			u.ctxlog.WithFields(log.Fields{"id": id, "err": err}).Error("node rollback failed")
+			mu.Lock()
+			failed++
+			mu.Unlock()
+			u.update(func() {
+				n.State = pb.UpgradeNodeState_NODE_ROLLBACK_FAILED
+				n.Error = err.Error()
+			})
+			return
+		}
+		u.update(func() { n.State = pb.UpgradeNodeState_NODE_ROLLED_BACK })
+	})
+	msg := cause.Error()
+	if failed != 0 {
+		msg = fmt.Sprintf("%s (%d nodes failed to roll back)", msg, failed)
+	}
+	u.finish(pb.UpgradeState_UPGRADE_ROLLED_BACK, msg)
+}
+
+//rollbackNode asks the node to go back to its previous version then waits for it
+//to come back healthy reporting that version.
+func (u *clusterUpgrade) rollbackNode(n *pb.UpgradeNode, mn ManagedNode) error {
+	ok, err := mn.UpgradeSoftwareVersion(n.PreviousVersion)
+	if err != nil {
+		return fmt.Errorf("Rollback request failed: %s", err)
+	}
+	if !ok {
+		return fmt.Errorf("Node declined rollback")
+	}
+	version, err := waitVersion(mn, n.PreviousVersion, u.timeout)
+	u.update(func() { n.Version = version })
+	return err
+}
+
+//waitVersion pings the node and checks its version until it answers reporting
+//version or the timeout passes. The old process may still answer for a while
+//after an upgrade request, so a successful ping alone doesn't mean the node is
+//back. Returns the last version the node reported.
+func waitVersion(mn ManagedNode, version string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	var reported string
+	for {
+		ok, _, err := mn.Ping()
+		switch {
+		case err != nil:
+			err = fmt.Errorf("Node not healthy after %s: %s", timeout, err)
+		case !ok:
+			err = fmt.Errorf("Node not healthy after %s: ping not ok", timeout)
+		default:
+			var v string
+			if v, err = mn.GetSoftwareVersion(); err != nil {
+				err = fmt.Errorf("Unable to get version after %s: %s", timeout, err)
+				break
+			}
+			reported = v
+			if v == version {
+				return reported, nil
+			}
+			err = fmt.Errorf("Node reports version %s after %s, expected %s", v, timeout, version)
+		}
+		if time.Now().After(deadline) {
+			return reported, err
+		}
+		time.Sleep(upgradePingInterval)
+	}
+}
+
+//waitWhilePaused blocks while the upgrade is paused. Returns false if the upgrade
+//has been aborted.
+func (u *clusterUpgrade) waitWhilePaused() bool {
+	for {
+		u.Lock()
+		state, changed := u.status.State, u.changed
+		u.Unlock()
+		switch state {
+		case pb.UpgradeState_UPGRADE_ABORTED:
+			return false
+		case pb.UpgradeState_UPGRADE_PAUSED:
+			<-changed
+		default:
+			return true
+		}
+	}
+}
+
+//update applies fn to the status and wakes up anyone watching it.
+func (u *clusterUpgrade) update(fn func()) {
+	u.Lock()
+	defer u.Unlock()
+	fn()
+	close(u.changed)
+	u.changed = make(chan struct{})
+}
+
+//finish records the final state of the upgrade and marks it done, any nodes
+//that didn't get upgraded are marked skipped.
+func (u *clusterUpgrade) finish(state pb.UpgradeState, err string) {
+	u.update(func() {
+		u.status.State = state
+		u.status.Error = err
+		u.status.Finished = time.Now().Unix()
+		for _, n := range u.status.Nodes {
+			if n.State == pb.UpgradeNodeState_NODE_PENDING {
+				n.State = pb.UpgradeNodeState_NODE_SKIPPED
+			}
+		}
+		close(u.done)
+	})
+}
+
+//setState moves a running or paused upgrade to the given state.
+func (u *clusterUpgrade) setState(state pb.UpgradeState) error {
+	var err error
+	u.update(func() {
+		switch u.status.State {
+		case pb.UpgradeState_UPGRADE_RUNNING, pb.UpgradeState_UPGRADE_PAUSED:
+			u.status.State = state
+		default:
+			err = NoUpgrade
+		}
+	})
+	return err
+}
+
+func (u *clusterUpgrade) finished() bool {
+	select {
+	case <-u.done:
+		return true
+	default:
+		return false
+	}
+}
+
+//watch returns a copy of the current status, a chan thats closed when it next
+//changes, and whether the upgrade has stopped running.
+func (u *clusterUpgrade) watch() (*pb.UpgradeStatus, chan struct{}, bool) {
+	u.Lock()
+	defer u.Unlock()
+	return u.snapshotLocked(), u.changed, u.finished()
+}
+
+func (u *clusterUpgrade) snapshot() *pb.UpgradeStatus {
+	u.Lock()
+	defer u.Unlock()
+	return u.snapshotLocked()
+}
+
+func (u *clusterUpgrade) snapshotLocked() *pb.UpgradeStatus {
+	status := *u.status
+	status.Nodes = make([]*pb.UpgradeNode, len(u.status.Nodes))
+	for i, n := range u.status.Nodes {
+		node := *n
+		status.Nodes[i] = &node
+	}
+	return &status
+}
+
+func (s *Server) currentUpgrade() *clusterUpgrade {
+	s.upgradeLock.Lock()
+	defer s.upgradeLock.Unlock()
+	return s.upgrade
+}
+
+//UpgradeCluster starts a rolling upgrade of all the managed nodes to the requested
+//version. Only one upgrade may run at a time.
+func (s *Server) UpgradeCluster(c context.Context, r *pb.UpgradeRequest) (*pb.UpgradeStatus, error) {
+	s.upgradeLock.Lock()
+	defer s.upgradeLock.Unlock()
+	if s.upgrade != nil && !s.upgrade.finished() {
+		return s.upgrade.snapshot(), UpgradeInProgress
+	}
+	u, err := s.planUpgrade(r)
+	if err != nil {
+		return &pb.UpgradeStatus{}, err
+	}
+	s.ctxlog.WithFields(log.Fields{
+		"version":   r.Version,
+		"batches":   len(u.batches),
+		"batchsize": u.status.BatchSize,
+		"tier":      r.Tier,
+		"caller":    callerFromContext(c),
+	}).Info("starting cluster upgrade")
+	s.upgrade = u
+	go u.run()
+	return u.snapshot(), nil
+}
+
+//GetUpgradeStatus returns the status of the current (or last) upgrade.
+func (s *Server) GetUpgradeStatus(c context.Context, e *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	u := s.currentUpgrade()
+	if u == nil {
+		return &pb.UpgradeStatus{}, nil
+	}
+	return u.snapshot(), nil
+}
+
+//WatchUpgrade streams the status of the current upgrade every time it changes,
+//ending once the upgrade has stopped running.
+func (s *Server) WatchUpgrade(e *pb.EmptyMsg, stream pb.Syndicate_WatchUpgradeServer) error {
+	timeout := time.Duration(s.cfg.RingSubscriberTimeout) * time.Second
+	u := s.currentUpgrade()
+	if u == nil {
+		return stream.Send(&pb.UpgradeStatus{})
+	}
+	for {
+		status, changed, finished := u.watch()
+		if err := sendWithTimeout(func() error { return stream.Send(status) }, timeout); err != nil {
+			s.ctxlog.WithField("err", err).Warning("Error WatchUpgrade send")
+			return err
+		}
+		if finished {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+//PauseUpgrade stops the current upgrade from starting any more batches.
+func (s *Server) PauseUpgrade(c context.Context, e *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	return s.controlUpgrade(c, pb.UpgradeState_UPGRADE_PAUSED)
+}
+
+//ResumeUpgrade resumes a paused upgrade.
+func (s *Server) ResumeUpgrade(c context.Context, e *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	return s.controlUpgrade(c, pb.UpgradeState_UPGRADE_RUNNING)
+}
+
+//AbortUpgrade stops the current upgrade once the batch in progress is finished.
+func (s *Server) AbortUpgrade(c context.Context, e *pb.EmptyMsg) (*pb.UpgradeStatus, error) {
+	return s.controlUpgrade(c, pb.UpgradeState_UPGRADE_ABORTED)
+}
+
+func (s *Server) controlUpgrade(c context.Context, state pb.UpgradeState) (*pb.UpgradeStatus, error) {
+	u := s.currentUpgrade()
+	if u == nil {
+		return &pb.UpgradeStatus{}, NoUpgrade
+	}
+	if err := u.setState(state); err != nil {
+		return u.snapshot(), err
+	}
+	s.ctxlog.WithFields(log.Fields{"state": state.String(), "caller": callerFromContext(c)}).Info("upgrade state changed")
+	return u.snapshot(), nil
+}
+
+type upgradeNodesByID []*pb.UpgradeNode
+
+func (n upgradeNodesByID) Len() int           { return len(n) }
+func (n upgradeNodesByID) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n upgradeNodesByID) Less(i, j int) bool { return n[i].Id < n[j].Id }
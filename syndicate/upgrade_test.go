@@ -0,0 +1,507 @@
+package syndicate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gholt/ring"
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	upgradePingInterval = 10 * time.Millisecond
+}
+
+type fakeUpgradeStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   chan *pb.UpgradeStatus
+}
+
+func newFakeUpgradeStream() *fakeUpgradeStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fakeUpgradeStream{ctx: ctx, cancel: cancel, sent: make(chan *pb.UpgradeStatus, 1000)}
+}
+
+func (f *fakeUpgradeStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeUpgradeStream) Send(status *pb.UpgradeStatus) error {
+	select {
+	case f.sent <- status:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+//newUpgradeTestServer returns a test server whose ring has six nodes spread
+//across zoneA and zoneB (tier1), each with a fake managed node on version 1.0
+//that misses a ping when started or restarted.
+func newUpgradeTestServer() (*Server, map[string]*fakeManagedNode, map[string]uint64) {
+	s, _ := newTestServerWithDefaults()
+	b := ring.NewBuilder(64)
+	b.SetReplicaCount(3)
+	fakes := make(map[string]*fakeManagedNode)
+	ids := make(map[string]uint64)
+	for i := 1; i <= 6; i++ {
+		name := fmt.Sprintf("server%d", i)
+		zone := "zoneA"
+		if i%2 == 0 {
+			zone = "zoneB"
+		}
+		addr := fmt.Sprintf("10.0.0.%d:4443", i)
+		n, _ := b.AddNode(true, 100, []string{name, zone}, []string{addr}, name, []byte(""))
+		fakes[name] = &fakeManagedNode{address: addr, version: "1.0", bounce: 1}
+		ids[name] = n.ID()
+		s.managedNodes[n.ID()] = fakes[name]
+	}
+	s.b = b
+	s.r = b.Ring()
+	s.cfg.RingSubscriberTimeout = 5
+	return s, fakes, ids
+}
+
+func waitForUpgrade(t *testing.T, s *Server) *pb.UpgradeStatus {
+	u := s.currentUpgrade()
+	select {
+	case <-u.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for upgrade to finish: %v", u.snapshot())
+	}
+	return u.snapshot()
+}
+
+func waitForUpgradeState(t *testing.T, s *Server, check func(*pb.UpgradeStatus) bool) *pb.UpgradeStatus {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, _ := s.GetUpgradeStatus(context.Background(), &pb.EmptyMsg{})
+		if check(status) {
+			return status
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for upgrade status, last status: %v", status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+//plannedBatches returns the names of the nodes in each batch the request would be
+//upgraded in. Node ids are random so the order within a domain isn't known up front.
+func plannedBatches(t *testing.T, s *Server, r *pb.UpgradeRequest, ids map[string]uint64) [][]string {
+	u, err := s.planUpgrade(r)
+	if err != nil {
+		t.Fatalf("planUpgrade returned unexpected error: %s", err)
+	}
+	names := make(map[uint64]string)
+	for name, id := range ids {
+		names[id] = name
+	}
+	var batches [][]string
+	for _, batch := range u.batches {
+		var b []string
+		for _, n := range batch {
+			b = append(b, names[n.Id])
+		}
+		batches = append(batches, b)
+	}
+	return batches
+}
+
+func upgradeNodeStates(status *pb.UpgradeStatus) map[uint64]pb.UpgradeNodeState {
+	states := make(map[uint64]pb.UpgradeNodeState)
+	for _, n := range status.Nodes {
+		states[n.Id] = n.State
+	}
+	return states
+}
+
+func TestServer_PlanUpgrade(t *testing.T) {
+	s, _, ids := newUpgradeTestServer()
+
+	u, err := s.planUpgrade(&pb.UpgradeRequest{Version: "2.0", BatchSize: 2, Tier: 1})
+	if err != nil {
+		t.Fatalf("planUpgrade returned unexpected error: %s", err)
+	}
+	if len(u.batches) != 4 || u.status.Batches != 4 {
+		t.Fatalf("planUpgrade returned %d batches, expected 4", len(u.batches))
+	}
+	sizes := []int{2, 1, 2, 1}
+	domains := []string{"zoneA", "zoneA", "zoneB", "zoneB"}
+	for i, batch := range u.batches {
+		if len(batch) != sizes[i] {
+			t.Errorf("batch %d has %d nodes, expected %d", i+1, len(batch), sizes[i])
+		}
+		for _, n := range batch {
+			if n.Domain != domains[i] || n.Batch != uint32(i+1) || n.State != pb.UpgradeNodeState_NODE_PENDING {
+				t.Errorf("batch %d has unexpected node: %#v", i+1, n)
+			}
+		}
+	}
+	if u.timeout != DefaultUpgradeHealthTimeout*time.Second {
+		t.Errorf("planUpgrade didn't default health timeout: %s", u.timeout)
+	}
+
+	//tier0 is unique per node so every node is its own failure domain
+	u, err = s.planUpgrade(&pb.UpgradeRequest{Version: "2.0", BatchSize: 3})
+	if err != nil {
+		t.Fatalf("planUpgrade returned unexpected error: %s", err)
+	}
+	if len(u.batches) != 6 || u.batches[0][0].Id != ids["server1"] || u.batches[5][0].Id != ids["server6"] {
+		t.Errorf("planUpgrade by tier0 returned unexpected batches: %v", u.status.Nodes)
+	}
+
+	//canaries are upgraded first, in their own batch, whatever their domain
+	u, err = s.planUpgrade(&pb.UpgradeRequest{Version: "2.0", BatchSize: 2, Tier: 1, Canaries: []uint64{ids["server3"], ids["server4"]}})
+	if err != nil {
+		t.Fatalf("planUpgrade returned unexpected error: %s", err)
+	}
+	if len(u.batches) != 3 || len(u.batches[0]) != 2 || u.soak != DefaultUpgradeSoak*time.Second {
+		t.Fatalf("planUpgrade with canaries returned unexpected batches: %v", u.status.Nodes)
+	}
+	for _, n := range u.status.Nodes {
+		canary := n.Id == ids["server3"] || n.Id == ids["server4"]
+		if n.Canary != canary || (canary && n.Batch != 1) || (!canary && n.Batch == 1) {
+			t.Errorf("planUpgrade with canaries returned unexpected node: %#v", n)
+		}
+	}
+
+	bad := []*pb.UpgradeRequest{
+		{},
+		{Version: "2.0", Tier: -1},
+		{Version: "2.0", HealthTimeout: -1},
+		{Version: "2.0", Soak: -1},
+		{Version: "2.0", Canaries: []uint64{ids["server1"], 42}},
+	}
+	for _, r := range bad {
+		if _, err := s.planUpgrade(r); err == nil {
+			t.Errorf("planUpgrade(%#v) should have returned an error", r)
+		}
+	}
+	s.managedNodes = make(map[uint64]ManagedNode)
+	if _, err := s.planUpgrade(&pb.UpgradeRequest{Version: "2.0"}); err == nil {
+		t.Errorf("planUpgrade with no managed nodes should have returned an error")
+	}
+}
+
+func TestServer_UpgradeCluster(t *testing.T) {
+	s, fakes, _ := newUpgradeTestServer()
+	ctx := context.Background()
+	tracker := &callTracker{delay: 5 * time.Millisecond}
+	for _, f := range fakes {
+		f.tracker = tracker
+		//the old process keeps answering for a bit after the upgrade request
+		f.lag = 2
+	}
+
+	if status, err := s.GetUpgradeStatus(ctx, &pb.EmptyMsg{}); err != nil || status.State != pb.UpgradeState_UPGRADE_IDLE {
+		t.Errorf("GetUpgradeStatus without an upgrade returned: %v, %v", status, err)
+	}
+	if _, err := s.PauseUpgrade(ctx, &pb.EmptyMsg{}); err != NoUpgrade {
+		t.Errorf("PauseUpgrade without an upgrade should have returned NoUpgrade, got: %v", err)
+	}
+
+	status, err := s.UpgradeCluster(ctx, &pb.UpgradeRequest{Version: "2.0", BatchSize: 2, Tier: 1})
+	if err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	if status.Version != "2.0" || status.Batches != 4 || len(status.Nodes) != 6 {
+		t.Errorf("UpgradeCluster returned unexpected status: %v", status)
+	}
+	status = waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_COMPLETE || status.Batch != 4 || status.Finished == 0 || status.Error != "" {
+		t.Errorf("upgrade finished with unexpected status: %v", status)
+	}
+	for _, n := range status.Nodes {
+		if n.State != pb.UpgradeNodeState_NODE_UPGRADED || n.PreviousVersion != "1.0" || n.Version != "2.0" {
+			t.Errorf("node finished upgrade with unexpected status: %#v", n)
+		}
+	}
+	for name, f := range fakes {
+		if f.currentVersion() != "2.0" {
+			t.Errorf("%s is on version %s, expected 2.0", name, f.currentVersion())
+		}
+	}
+	if tracker.maxRunning() > 2 {
+		t.Errorf("upgrade talked to %d nodes at once, expected at most 2", tracker.maxRunning())
+	}
+
+	//finished upgrades can't be controlled but a new one can be started
+	if _, err := s.AbortUpgrade(ctx, &pb.EmptyMsg{}); err != NoUpgrade {
+		t.Errorf("AbortUpgrade of a finished upgrade should have returned NoUpgrade, got: %v", err)
+	}
+	if _, err := s.UpgradeCluster(ctx, &pb.UpgradeRequest{Version: "2.1"}); err != nil {
+		t.Errorf("UpgradeCluster after a finished upgrade returned unexpected error: %s", err)
+	}
+	waitForUpgrade(t, s)
+}
+
+func TestServer_UpgradeClusterFailures(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]func(f *fakeManagedNode){
+		"disk full":                func(f *fakeManagedNode) { f.err = fmt.Errorf("disk full") },
+		"Node not healthy":         func(f *fakeManagedNode) { f.down = true },
+		"reports version 1.9-oops": func(f *fakeManagedNode) { f.badVersion = "1.9-oops" },
+	}
+	for expected, breakNode := range tests {
+		s, fakes, ids := newUpgradeTestServer()
+		r := &pb.UpgradeRequest{Version: "2.0", BatchSize: 2, Tier: 1, HealthTimeout: 1}
+		batches := plannedBatches(t, s, r, ids)
+		//break the lone node in the second zoneA batch
+		breakNode(fakes[batches[1][0]])
+		if _, err := s.UpgradeCluster(ctx, r); err != nil {
+			t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+		}
+		status := waitForUpgrade(t, s)
+		if status.State != pb.UpgradeState_UPGRADE_FAILED || status.Batch != 2 || !strings.Contains(status.Error, expected) {
+			t.Errorf("%s: upgrade finished with unexpected status: %v", expected, status)
+		}
+		states := upgradeNodeStates(status)
+		for i, batch := range batches {
+			state := pb.UpgradeNodeState_NODE_SKIPPED
+			switch i {
+			case 0:
+				state = pb.UpgradeNodeState_NODE_UPGRADED
+			case 1:
+				state = pb.UpgradeNodeState_NODE_FAILED
+			}
+			for _, name := range batch {
+				if states[ids[name]] != state {
+					t.Errorf("%s: %s finished in state %s, expected %s", expected, name, states[ids[name]], state)
+				}
+				if state == pb.UpgradeNodeState_NODE_SKIPPED && len(fakes[name].upgradeRequests()) != 0 {
+					t.Errorf("%s: %s should not have been asked to upgrade", expected, name)
+				}
+			}
+		}
+	}
+}
+
+func TestServer_UpgradeClusterControl(t *testing.T) {
+	s, fakes, ids := newUpgradeTestServer()
+	ctx := context.Background()
+	r := &pb.UpgradeRequest{Version: "2.0", BatchSize: 2, Tier: 1}
+	batches := plannedBatches(t, s, r, ids)
+	gate := make(chan struct{})
+	fakes[batches[0][0]].gate = gate
+
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	if _, err := s.UpgradeCluster(ctx, &pb.UpgradeRequest{Version: "3.0"}); err != UpgradeInProgress {
+		t.Errorf("UpgradeCluster while an upgrade is running should have returned UpgradeInProgress, got: %v", err)
+	}
+
+	//pausing lets the current batch finish but doesn't start the next
+	waitForUpgradeState(t, s, func(status *pb.UpgradeStatus) bool { return status.Batch == 1 })
+	status, err := s.PauseUpgrade(ctx, &pb.EmptyMsg{})
+	if err != nil || status.State != pb.UpgradeState_UPGRADE_PAUSED {
+		t.Fatalf("PauseUpgrade returned: %v, %v", status, err)
+	}
+	close(gate)
+	waitForUpgradeState(t, s, func(status *pb.UpgradeStatus) bool {
+		states := upgradeNodeStates(status)
+		return states[ids[batches[0][0]]] == pb.UpgradeNodeState_NODE_UPGRADED && states[ids[batches[0][1]]] == pb.UpgradeNodeState_NODE_UPGRADED
+	})
+	time.Sleep(50 * time.Millisecond)
+	status, _ = s.GetUpgradeStatus(ctx, &pb.EmptyMsg{})
+	if status.State != pb.UpgradeState_UPGRADE_PAUSED || status.Batch != 1 {
+		t.Errorf("paused upgrade should have stopped after batch 1: %v", status)
+	}
+	second := fakes[batches[1][0]]
+	if len(second.upgradeRequests()) != 0 {
+		t.Errorf("paused upgrade should not have started batch 2")
+	}
+
+	//resume and pause again while batch 2 is running, then abort
+	second.gate = make(chan struct{})
+	if status, err = s.ResumeUpgrade(ctx, &pb.EmptyMsg{}); err != nil || status.State != pb.UpgradeState_UPGRADE_RUNNING {
+		t.Fatalf("ResumeUpgrade returned: %v, %v", status, err)
+	}
+	waitForUpgradeState(t, s, func(status *pb.UpgradeStatus) bool { return status.Batch == 2 })
+	if _, err = s.PauseUpgrade(ctx, &pb.EmptyMsg{}); err != nil {
+		t.Fatalf("PauseUpgrade returned unexpected error: %s", err)
+	}
+	close(second.gate)
+	if status, err = s.AbortUpgrade(ctx, &pb.EmptyMsg{}); err != nil || status.State != pb.UpgradeState_UPGRADE_ABORTED {
+		t.Fatalf("AbortUpgrade returned: %v, %v", status, err)
+	}
+	status = waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_ABORTED || status.Batch != 2 {
+		t.Errorf("aborted upgrade finished with unexpected status: %v", status)
+	}
+	states := upgradeNodeStates(status)
+	if states[ids[batches[1][0]]] != pb.UpgradeNodeState_NODE_UPGRADED || states[ids[batches[2][0]]] != pb.UpgradeNodeState_NODE_SKIPPED {
+		t.Errorf("aborted upgrade has unexpected node states: %v", status.Nodes)
+	}
+	if _, err = s.ResumeUpgrade(ctx, &pb.EmptyMsg{}); err != NoUpgrade {
+		t.Errorf("ResumeUpgrade of an aborted upgrade should have returned NoUpgrade, got: %v", err)
+	}
+}
+
+func TestServer_WatchUpgrade(t *testing.T) {
+	s, fakes, ids := newUpgradeTestServer()
+	ctx := context.Background()
+
+	//no upgrade, just the idle status
+	stream := newFakeUpgradeStream()
+	if err := s.WatchUpgrade(&pb.EmptyMsg{}, stream); err != nil {
+		t.Fatalf("WatchUpgrade returned unexpected error: %s", err)
+	}
+	if status := <-stream.sent; status.State != pb.UpgradeState_UPGRADE_IDLE {
+		t.Errorf("WatchUpgrade without an upgrade sent: %v", status)
+	}
+
+	r := &pb.UpgradeRequest{Version: "2.0", BatchSize: 3, Tier: 1}
+	gate := make(chan struct{})
+	fakes[plannedBatches(t, s, r, ids)[0][0]].gate = gate
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	stream = newFakeUpgradeStream()
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.WatchUpgrade(&pb.EmptyMsg{}, stream)
+	}()
+	close(gate)
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatalf("WatchUpgrade returned unexpected error: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("WatchUpgrade didn't end with the upgrade")
+	}
+	var last *pb.UpgradeStatus
+	var sends int
+	for len(stream.sent) > 0 {
+		status := <-stream.sent
+		if last != nil && status.Batch < last.Batch {
+			t.Errorf("WatchUpgrade sent batch %d after %d", status.Batch, last.Batch)
+		}
+		last = status
+		sends++
+	}
+	if sends < 2 || last.State != pb.UpgradeState_UPGRADE_COMPLETE {
+		t.Errorf("WatchUpgrade sent %d statuses ending with: %v", sends, last)
+	}
+
+	//watching a finished upgrade just sends its final status
+	stream = newFakeUpgradeStream()
+	if err := s.WatchUpgrade(&pb.EmptyMsg{}, stream); err != nil || len(stream.sent) != 1 {
+		t.Errorf("WatchUpgrade of a finished upgrade sent %d statuses: %v", len(stream.sent), err)
+	}
+}
+
+func TestServer_UpgradeClusterCanary(t *testing.T) {
+	s, fakes, ids := newUpgradeTestServer()
+	ctx := context.Background()
+	r := &pb.UpgradeRequest{Version: "2.0", BatchSize: 3, Tier: 1, Soak: 1, Canaries: []uint64{ids["server6"]}}
+	batches := plannedBatches(t, s, r, ids)
+
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	//nothing else gets upgraded while the canary soaks
+	waitForUpgradeState(t, s, func(status *pb.UpgradeStatus) bool { return status.SoakUntil != 0 })
+	if len(fakes["server1"].upgradeRequests()) != 0 || len(fakes["server2"].upgradeRequests()) != 0 {
+		t.Errorf("nodes were upgraded before the canary finished soaking")
+	}
+	status := waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_COMPLETE || status.Batches != 3 || status.Batch != 3 {
+		t.Errorf("canary upgrade finished with unexpected status: %v", status)
+	}
+	if status.Finished-status.Started < 1 {
+		t.Errorf("canary upgrade finished before the soak period was up: %v", status)
+	}
+	if len(batches[0]) != 1 || batches[0][0] != "server6" {
+		t.Errorf("canary should be the only node in the first batch: %v", batches)
+	}
+	for name, f := range fakes {
+		if f.currentVersion() != "2.0" {
+			t.Errorf("%s is on version %s, expected 2.0", name, f.currentVersion())
+		}
+	}
+}
+
+func TestServer_UpgradeClusterCanaryRollback(t *testing.T) {
+	ctx := context.Background()
+
+	//one of the canaries misreports its version after upgrading
+	s, fakes, ids := newUpgradeTestServer()
+	fakes["server2"].badVersion = "1.9-oops"
+	//the canaries keep reporting the version they're leaving for a bit
+	fakes["server1"].lag = 2
+	fakes["server2"].lag = 2
+	r := &pb.UpgradeRequest{Version: "2.0", Tier: 1, HealthTimeout: 1, Soak: 1, Canaries: []uint64{ids["server1"], ids["server2"]}}
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	status := waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_ROLLED_BACK || !strings.Contains(status.Error, "1.9-oops") {
+		t.Errorf("upgrade finished with unexpected status: %v", status)
+	}
+	checkRolledBack(t, status, fakes, ids, "server1", "server2")
+
+	//one of the canaries stops answering pings while soaking
+	s, fakes, ids = newUpgradeTestServer()
+	r = &pb.UpgradeRequest{Version: "2.0", Tier: 1, HealthTimeout: 1, Soak: 5, Canaries: []uint64{ids["server3"], ids["server4"]}}
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	waitForUpgradeState(t, s, func(status *pb.UpgradeStatus) bool { return status.SoakUntil != 0 })
+	fakes["server4"].setDown(true)
+	status = waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_ROLLED_BACK || !strings.Contains(status.Error, "during soak") {
+		t.Errorf("upgrade finished with unexpected status: %v", status)
+	}
+	if status.Finished-status.Started >= 5 {
+		t.Errorf("upgrade should have rolled back as soon as the canary failed: %v", status)
+	}
+	checkRolledBack(t, status, fakes, ids, "server3", "server4")
+
+	//a canary that won't go back gets reported
+	s, fakes, ids = newUpgradeTestServer()
+	fakes["server5"].badVersion = "2.0-oops"
+	fakes["server5"].down = true
+	r = &pb.UpgradeRequest{Version: "2.0", HealthTimeout: 1, Soak: 1, Canaries: []uint64{ids["server5"]}}
+	if _, err := s.UpgradeCluster(ctx, r); err != nil {
+		t.Fatalf("UpgradeCluster returned unexpected error: %s", err)
+	}
+	status = waitForUpgrade(t, s)
+	if status.State != pb.UpgradeState_UPGRADE_ROLLED_BACK {
+		t.Errorf("upgrade finished with unexpected status: %v", status)
+	}
+	if states := upgradeNodeStates(status); states[ids["server5"]] != pb.UpgradeNodeState_NODE_ROLLED_BACK {
+		t.Errorf("server5 finished in state %s, expected %s", states[ids["server5"]], pb.UpgradeNodeState_NODE_ROLLED_BACK)
+	}
+}
+
+//checkRolledBack verifies the canaries were rolled back to 1.0 and nothing else was touched.
+func checkRolledBack(t *testing.T, status *pb.UpgradeStatus, fakes map[string]*fakeManagedNode, ids map[string]uint64, canaries ...string) {
+	states := upgradeNodeStates(status)
+	isCanary := make(map[string]bool)
+	for _, name := range canaries {
+		isCanary[name] = true
+	}
+	for name, f := range fakes {
+		if !isCanary[name] {
+			if states[ids[name]] != pb.UpgradeNodeState_NODE_SKIPPED || len(f.upgradeRequests()) != 0 {
+				t.Errorf("%s should have been skipped, got %s and upgrades %v", name, states[ids[name]], f.upgradeRequests())
+			}
+			continue
+		}
+		if states[ids[name]] != pb.UpgradeNodeState_NODE_ROLLED_BACK {
+			t.Errorf("%s finished in state %s, expected %s", name, states[ids[name]], pb.UpgradeNodeState_NODE_ROLLED_BACK)
+		}
+		if upgrades := f.upgradeRequests(); len(upgrades) != 2 || upgrades[0] != "2.0" || upgrades[1] != "1.0" || f.currentVersion() != "1.0" {
+			t.Errorf("%s should have been upgraded to 2.0 then rolled back to 1.0, got %v", name, upgrades)
+		}
+	}
+}
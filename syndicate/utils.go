@@ -6,9 +6,12 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/gholt/ring"
 	pb "github.com/pandemicsyn/syndicate/api/proto"
+	"github.com/spaolacci/murmur3"
 )
 
 // FatalIf is just a lazy log/panic on error func
@@ -87,3 +90,84 @@ func ExtractCapacity(path string, disks []*pb.Disk) uint32 {
 	}
 	return 0
 }
+
+//partitionCounts returns the number of partition replicas assigned to each node in the ring.
+func partitionCounts(r ring.Ring) map[uint64]uint64 {
+	counts := make(map[uint64]uint64)
+	partitions := uint64(1) << r.PartitionBitCount()
+	for p := uint64(0); p < partitions; p++ {
+		for _, n := range r.ResponsibleNodes(uint32(p)) {
+			counts[n.ID()]++
+		}
+	}
+	return counts
+}
+
+//partitionReplicas returns the nodes responsible for each replica of the given partition.
+func partitionReplicas(r ring.Ring, partition uint32) []*pb.PartitionReplica {
+	var replicas []*pb.PartitionReplica
+	for _, n := range r.ResponsibleNodes(partition) {
+		replicas = append(replicas, &pb.PartitionReplica{Id: n.ID(), Addresses: n.Addresses(), Meta: n.Meta()})
+	}
+	return replicas
+}
+
+//keyPartition returns the partition a key maps to in a ring with the given partition
+//bit count. Keys are hashed the same way the oort stores do, using the upper bits of
+//the first half of the key's murmur3 128 bit hash.
+func keyPartition(key []byte, partitionBitCount uint16) uint32 {
+	keyA, _ := murmur3.Sum128(key)
+	return uint32(keyA >> (64 - partitionBitCount))
+}
+
+//translatePartition maps a partition in a ring with the from partition bit count to
+//the partition covering the same key space in a ring with the to partition bit count.
+//When the to ring has more partitions the first of them is returned.
+func translatePartition(partition uint32, from, to uint16) uint32 {
+	if to < from {
+		return partition >> (from - to)
+	}
+	return partition << (to - from)
+}
+
+//findPreviousRing returns the path to the most recent versioned ring file in the ring dir
+//older than the given version, or an empty string if there isn't one.
+func findPreviousRing(cfg *Config, servicename string, version int64) (string, error) {
+	fp, err := os.Open(cfg.RingDir)
+	if err != nil {
+		return "", err
+	}
+	names, err := fp.Readdirnames(-1)
+	fp.Close()
+	if err != nil {
+		return "", err
+	}
+	suffix := fmt.Sprintf("-%s.ring", servicename)
+	var prev int64
+	path := ""
+	for _, name := range names {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		v, err := strconv.ParseInt(strings.TrimSuffix(name, suffix), 10, 64)
+		if err != nil || v >= version || v <= prev {
+			continue
+		}
+		prev = v
+		path = filepath.Join(cfg.RingDir, name)
+	}
+	return path, nil
+}
+
+//nodeToPb returns the pb.Node for a ring node.
+func nodeToPb(n ring.Node) *pb.Node {
+	return &pb.Node{
+		Id:        n.ID(),
+		Active:    n.Active(),
+		Capacity:  n.Capacity(),
+		Tiers:     n.Tiers(),
+		Addresses: n.Addresses(),
+		Meta:      n.Meta(),
+		Conf:      n.Config(),
+	}
+}
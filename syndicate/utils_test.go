@@ -1,9 +1,77 @@
 package syndicate
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
 func TestFilter(t *testing.T) {
 
 }
+
+func TestKeyPartition(t *testing.T) {
+	for _, key := range []string{"a", "somekey", "/some/other/key"} {
+		p := keyPartition([]byte(key), 16)
+		if p >= 1<<16 {
+			t.Errorf("keyPartition(%q, 16) returned out of range partition %d", key, p)
+		}
+		if p>>4 != keyPartition([]byte(key), 12) {
+			t.Errorf("keyPartition(%q, 12) should be the upper 12 bits of keyPartition(%q, 16)", key, key)
+		}
+		if keyPartition([]byte(key), 0) != 0 {
+			t.Errorf("keyPartition(%q, 0) should always be partition 0", key)
+		}
+	}
+}
+
+func TestTranslatePartition(t *testing.T) {
+	tests := []struct {
+		p, expected uint32
+		from, to    uint16
+	}{
+		{5, 5, 8, 8},
+		{5, 1, 8, 6},
+		{5, 20, 8, 10},
+		{255, 0, 8, 0},
+	}
+	for _, test := range tests {
+		if p := translatePartition(test.p, test.from, test.to); p != test.expected {
+			t.Errorf("translatePartition(%d, %d, %d) returned %d, expected %d", test.p, test.from, test.to, p, test.expected)
+		}
+	}
+}
+
+func TestFindPreviousRing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syndicate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := &Config{RingDir: dir}
+
+	path, err := findPreviousRing(cfg, "test", 100)
+	if err != nil || path != "" {
+		t.Errorf("findPreviousRing with no versioned rings returned %q, %v", path, err)
+	}
+
+	for _, name := range []string{"10-test.ring", "50-test.ring", "100-test.ring", "200-test.ring", "70-test.builder", "80-other.ring", "test.ring", "nope-test.ring"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(""), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path, err = findPreviousRing(cfg, "test", 100)
+	if err != nil || path != filepath.Join(dir, "50-test.ring") {
+		t.Errorf("findPreviousRing returned %q, %v, expected %s", path, err, filepath.Join(dir, "50-test.ring"))
+	}
+	path, err = findPreviousRing(cfg, "test", 10)
+	if err != nil || path != "" {
+		t.Errorf("findPreviousRing with no older rings returned %q, %v", path, err)
+	}
+
+	cfg.RingDir = filepath.Join(dir, "missing")
+	if _, err = findPreviousRing(cfg, "test", 100); err == nil {
+		t.Errorf("findPreviousRing with a missing ring dir should have errored")
+	}
+}
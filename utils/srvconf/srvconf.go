@@ -1,6 +1,7 @@
 package srvconf
 
 import (
+	"bufio"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -8,18 +9,29 @@ import (
 	"net"
 	"os"
 	"runtime"
+	"strings"
 	"time"
 
 	pb "github.com/pandemicsyn/syndicate/api/proto"
 	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/load"
 	"github.com/shirou/gopsutil/mem"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
+const (
+	DefaultTopologyFile   = "/etc/syndicate/topology" //The default file to read a nodes rack/row/datacenter labels from
+	DefaultReportInterval = 60 * time.Second          //The default interval between node status reports
+)
+
 var (
 	ErrSRVLookupFailed = errors.New("srv lookup failed")
+
+	// topologyLabels are the supported failure domain labels ordered from
+	// the narrowest to the widest. They're used (in order) as tier1..tierN.
+	topologyLabels = []string{"rack", "row", "datacenter"}
 )
 
 // lookup returned records are sorted by priority and randomized by weight within a priority.
@@ -36,6 +48,78 @@ func lookup(service string) ([]*net.SRV, error) {
 type SRVLoader struct {
 	Record       string
 	SyndicateURL string
+	TopologyFile string
+	// ReplaceID, if set, makes Load take over the existing ring entry with
+	// that ID (via ReplaceNode) instead of registering, i.e. when the host
+	// replaces one that died.
+	ReplaceID uint64
+	// Services are the services LoadServices registers the host with, all
+	// those synd serves if empty.
+	Services []string
+	// Rollback makes LoadServices undo the registrations that succeeded
+	// if any of the services refuses the host.
+	Rollback bool
+}
+
+// GetTopology returns the nodes failure domain labels (rack, row, datacenter).
+// Labels are read from the topology file as simple "label = value" lines and
+// may be overridden via the SYNDICATE_RACK, SYNDICATE_ROW, and
+// SYNDICATE_DATACENTER environment variables. A missing topology file is not
+// an error.
+func GetTopology(path string) (map[string]string, error) {
+	labels := make(map[string]string)
+	f, err := os.Open(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return labels, err
+	default:
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			kv := strings.SplitN(line, "=", 2)
+			if len(kv) != 2 {
+				return labels, fmt.Errorf("Invalid line in topology file %s: %q", path, line)
+			}
+			labels[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.Trim(strings.TrimSpace(kv[1]), "\"")
+		}
+		if err := scanner.Err(); err != nil {
+			return labels, err
+		}
+	}
+	for _, label := range topologyLabels {
+		if v := os.Getenv("SYNDICATE_" + strings.ToUpper(label)); v != "" {
+			labels[label] = v
+		}
+	}
+	return labels, nil
+}
+
+// TopologyTiers builds a nodes tier list from its hostname (tier0) and
+// failure domain labels. A wider label (i.e. datacenter) may not be provided
+// without the narrower labels beneath it (row and rack) since that would
+// shift the remaining labels into the wrong tier.
+func TopologyTiers(hostname string, labels map[string]string) ([]string, error) {
+	tiers := []string{hostname}
+	var missing string
+	for _, label := range topologyLabels {
+		v := labels[label]
+		if v == "" {
+			if missing == "" {
+				missing = label
+			}
+			continue
+		}
+		if missing != "" {
+			return tiers, fmt.Errorf("Topology label %s provided but %s is missing", label, missing)
+		}
+		tiers = append(tiers, v)
+	}
+	return tiers, nil
 }
 
 func GetHardwareProfile() (*pb.HardwareProfile, error) {
@@ -69,24 +153,93 @@ func GetHardwareProfile() (*pb.HardwareProfile, error) {
 	return hw, nil
 }
 
-func (s *SRVLoader) getConfig() (*pb.NodeConfig, error) {
-	nconfig := &pb.NodeConfig{}
+// GetNodeStatus builds a status report for the node with the given ID from
+// its current hardware profile (including disk usage) and load averages.
+func GetNodeStatus(id uint64, ringVersion int64) (*pb.NodeStatusReport, error) {
+	r := &pb.NodeStatusReport{Id: id, RingVersion: ringVersion}
+	r.Hostname, _ = os.Hostname()
+	var err error
+	r.Hardware, err = GetHardwareProfile()
+	if err != nil {
+		return r, err
+	}
+	avg, err := load.Avg()
+	if err != nil {
+		return r, err
+	}
+	r.Load1, r.Load5, r.Load15 = avg.Load1, avg.Load5, avg.Load15
+	return r, nil
+}
+
+// NodeReporter sends ReportNodeStatus heartbeats to synd on an interval so it
+// can track the nodes usage and notice if it stops reporting.
+type NodeReporter struct {
+	SyndicateURL string
+	ID           uint64
+	Interval     time.Duration // DefaultReportInterval if not set
+	// RingVersion, if set, returns the ring version the node is running.
+	RingVersion func() int64
+}
+
+// Run sends a report every Interval until stop is closed. Failed reports are
+// logged and retried on the next interval.
+func (r *NodeReporter) Run(stop <-chan struct{}) error {
+	conn, err := dialSyndicate(r.SyndicateURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := pb.NewSyndicateClient(conn)
+	interval := r.Interval
+	if interval == 0 {
+		interval = DefaultReportInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.report(client); err != nil {
+			log.Println("node status report failed:", err)
+		}
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *NodeReporter) report(client pb.SyndicateClient) error {
+	var version int64
+	if r.RingVersion != nil {
+		version = r.RingVersion()
+	}
+	report, err := GetNodeStatus(r.ID, version)
+	if err != nil {
+		return err
+	}
+	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	_, err = client.ReportNodeStatus(ctx, report)
+	return err
+}
+
+func dialSyndicate(url string) (*grpc.ClientConn, error) {
 	var opts []grpc.DialOption
 	var creds credentials.TransportAuthenticator
 	creds = credentials.NewTLS(&tls.Config{
 		InsecureSkipVerify: true,
 	})
 	opts = append(opts, grpc.WithTransportCredentials(creds))
-	conn, err := grpc.Dial(s.SyndicateURL, opts...)
+	conn, err := grpc.Dial(url, opts...)
 	if err != nil {
-		return nconfig, fmt.Errorf("Failed to dial ring server for config: %s", err)
+		return nil, fmt.Errorf("Failed to dial ring server: %s", err)
 	}
-	defer conn.Close()
-
-	client := pb.NewSyndicateClient(conn)
-
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	return conn, nil
+}
 
+// localNode returns a registration request describing this host, without
+// its tiers.
+func localNode() (*pb.RegisterRequest, error) {
+	var err error
 	rr := &pb.RegisterRequest{}
 	rr.Hostname, _ = os.Hostname()
 	addrs, _ := net.InterfaceAddrs()
@@ -94,27 +247,138 @@ func (s *SRVLoader) getConfig() (*pb.NodeConfig, error) {
 		rr.Addrs = append(rr.Addrs, addrs[k].String())
 	}
 	rr.Hardware, err = GetHardwareProfile()
+	return rr, err
+}
+
+// registerRequest returns the registration request for this host, with its
+// tiers taken from the topology file.
+func (s *SRVLoader) registerRequest() (*pb.RegisterRequest, error) {
+	rr, err := localNode()
+	if err != nil {
+		return rr, err
+	}
+	if s.TopologyFile == "" {
+		s.TopologyFile = DefaultTopologyFile
+	}
+	labels, err := GetTopology(s.TopologyFile)
+	if err != nil {
+		return rr, err
+	}
+	rr.Tiers, err = TopologyTiers(rr.Hostname, labels)
+	return rr, err
+}
+
+func (s *SRVLoader) getConfig() (*pb.NodeConfig, error) {
+	nconfig := &pb.NodeConfig{}
+	conn, err := dialSyndicate(s.SyndicateURL)
+	if err != nil {
+		return nconfig, err
+	}
+	defer conn.Close()
+
+	client := pb.NewSyndicateClient(conn)
+
+	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+
+	if s.ReplaceID != 0 {
+		rr, err := localNode()
+		if err != nil {
+			return nconfig, err
+		}
+		return client.ReplaceNode(ctx, &pb.ReplaceNodeRequest{
+			Id:       s.ReplaceID,
+			Hostname: rr.Hostname,
+			Addrs:    rr.Addrs,
+			Hardware: rr.Hardware,
+		})
+	}
+	rr, err := s.registerRequest()
 	if err != nil {
 		return nconfig, err
 	}
-	rr.Tiers = []string{rr.Hostname}
 
 	nconfig, err = client.RegisterNode(ctx, rr)
 	return nconfig, err
 }
 
+// resolve looks up the synd address from Record unless SyndicateURL is set.
+func (s *SRVLoader) resolve() error {
+	if s.SyndicateURL != "" {
+		return nil
+	}
+	// Specific endpoint given
+	if _, _, err := net.SplitHostPort(s.Record); err == nil {
+		s.SyndicateURL = s.Record
+		return nil
+	}
+	serviceAddrs, err := lookup(s.Record)
+	if err != nil {
+		return err
+	}
+	s.SyndicateURL = fmt.Sprintf("%s:%d", serviceAddrs[0].Target, serviceAddrs[0].Port)
+	return nil
+}
+
 func (s *SRVLoader) Load() (nodeconfig *pb.NodeConfig, err error) {
-	if s.SyndicateURL == "" {
-		// Specific endpoint given
-		if _, _, err := net.SplitHostPort(s.Record); err == nil {
-			s.SyndicateURL = s.Record
-		} else {
-			serviceAddrs, err := lookup(s.Record)
-			if err != nil {
-				return &pb.NodeConfig{}, err
-			}
-			s.SyndicateURL = fmt.Sprintf("%s:%d", serviceAddrs[0].Target, serviceAddrs[0].Port)
-		}
+	if err := s.resolve(); err != nil {
+		return &pb.NodeConfig{}, err
 	}
 	return s.getConfig()
 }
+
+// LoadServices registers the host with each of Services (all of the services
+// synd serves if empty) in a single RegisterNodeMulti call, so a host running
+// several backends can't end up in one ring but not another. It returns the
+// NodeConfig of each service the host is registered with by name. If any
+// service refuses the host the error lists why for each, along with those
+// that were skipped or rolled back (see Rollback), and the returned configs
+// are just the services that kept the registration.
+func (s *SRVLoader) LoadServices() (map[string]*pb.NodeConfig, error) {
+	configs := make(map[string]*pb.NodeConfig)
+	if err := s.resolve(); err != nil {
+		return configs, err
+	}
+	rr, err := s.registerRequest()
+	if err != nil {
+		return configs, err
+	}
+	conn, err := dialSyndicate(s.SyndicateURL)
+	if err != nil {
+		return configs, err
+	}
+	defer conn.Close()
+	client := pb.NewSyndicateClient(conn)
+	ctx, _ := context.WithTimeout(context.Background(), 30*time.Second)
+	res, err := client.RegisterNodeMulti(ctx, &pb.RegisterMultiRequest{Node: rr, Services: s.Services, Rollback: s.Rollback})
+	if err != nil {
+		return configs, err
+	}
+	return multiConfigs(res)
+}
+
+// multiConfigs maps a RegisterNodeMulti result to the NodeConfig of each
+// service that kept the registration, along with an error listing the
+// problems with the rest if the registration failed.
+func multiConfigs(res *pb.RegisterMultiResult) (map[string]*pb.NodeConfig, error) {
+	configs := make(map[string]*pb.NodeConfig)
+	var problems []string
+	for _, reg := range res.Results {
+		switch {
+		case reg.Error != "":
+			problems = append(problems, fmt.Sprintf("%s: %s", reg.Service, reg.Error))
+		case reg.Skipped:
+			problems = append(problems, fmt.Sprintf("%s: skipped", reg.Service))
+		case reg.RollbackError != "":
+			problems = append(problems, fmt.Sprintf("%s: rollback failed: %s", reg.Service, reg.RollbackError))
+			configs[reg.Service] = reg.Config
+		case reg.RolledBack:
+			problems = append(problems, fmt.Sprintf("%s: rolled back", reg.Service))
+		default:
+			configs[reg.Service] = reg.Config
+		}
+	}
+	if !res.Ok {
+		return configs, fmt.Errorf("Registration failed: %s", strings.Join(problems, "; "))
+	}
+	return configs, nil
+}
@@ -0,0 +1,154 @@
+package srvconf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/pandemicsyn/syndicate/api/proto"
+)
+
+func writeTopology(t *testing.T, contents string) (string, func()) {
+	dir, err := ioutil.TempDir("", "srvconf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "topology")
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestGetTopology(t *testing.T) {
+	path, cleanup := writeTopology(t, `# where this host lives
+rack = r12
+
+  ROW=row3
+datacenter = "dal 1"
+# rack = ignored
+`)
+	defer cleanup()
+	labels, err := GetTopology(path)
+	if err != nil {
+		t.Fatalf("GetTopology returned unexpected error: %s", err)
+	}
+	expected := map[string]string{"rack": "r12", "row": "row3", "datacenter": "dal 1"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("GetTopology returned %v, expected %v", labels, expected)
+	}
+
+	// the environment overrides the file
+	os.Setenv("SYNDICATE_RACK", "r99")
+	defer os.Unsetenv("SYNDICATE_RACK")
+	labels, err = GetTopology(path)
+	if err != nil || labels["rack"] != "r99" || labels["row"] != "row3" {
+		t.Errorf("GetTopology with SYNDICATE_RACK set returned: %v, %v", labels, err)
+	}
+
+	// a missing file just leaves the environment
+	labels, err = GetTopology(filepath.Join(filepath.Dir(path), "missing"))
+	if err != nil || !reflect.DeepEqual(labels, map[string]string{"rack": "r99"}) {
+		t.Errorf("GetTopology of a missing file returned: %v, %v", labels, err)
+	}
+}
+
+func TestGetTopologyMalformed(t *testing.T) {
+	path, cleanup := writeTopology(t, "rack = r12\nrow3\n")
+	defer cleanup()
+	if _, err := GetTopology(path); err == nil || !strings.Contains(err.Error(), `"row3"`) {
+		t.Errorf("GetTopology of a malformed line returned: %v", err)
+	}
+}
+
+func TestTopologyTiers(t *testing.T) {
+	tests := []struct {
+		labels map[string]string
+		tiers  []string
+		err    string
+	}{
+		{map[string]string{}, []string{"host1"}, ""},
+		{map[string]string{"rack": "r1"}, []string{"host1", "r1"}, ""},
+		{map[string]string{"rack": "r1", "row": "w1", "datacenter": "d1"}, []string{"host1", "r1", "w1", "d1"}, ""},
+		{map[string]string{"datacenter": "d1"}, nil, "datacenter provided but rack is missing"},
+		{map[string]string{"rack": "r1", "datacenter": "d1"}, nil, "datacenter provided but row is missing"},
+		{map[string]string{"row": "w1"}, nil, "row provided but rack is missing"},
+	}
+	for _, test := range tests {
+		tiers, err := TopologyTiers("host1", test.labels)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("TopologyTiers(%v) returned error %v, expected %q", test.labels, err, test.err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(tiers, test.tiers) {
+			t.Errorf("TopologyTiers(%v) returned (%v, %v), expected %v", test.labels, tiers, err, test.tiers)
+		}
+	}
+}
+
+func TestMultiConfigs(t *testing.T) {
+	value := &pb.NodeConfig{Localid: 1}
+	group := &pb.NodeConfig{Localid: 2}
+	tests := []struct {
+		name    string
+		res     *pb.RegisterMultiResult
+		configs map[string]*pb.NodeConfig
+		err     string
+	}{
+		{
+			"all registered",
+			&pb.RegisterMultiResult{Ok: true, Results: []*pb.ServiceRegistration{
+				{Service: "group", Config: group, Added: true},
+				{Service: "value", Config: value},
+			}},
+			map[string]*pb.NodeConfig{"group": group, "value": value},
+			"",
+		},
+		{
+			"refused without rollback",
+			&pb.RegisterMultiResult{Results: []*pb.ServiceRegistration{
+				{Service: "group", Error: "Invalid tiers"},
+				{Service: "value", Config: value, Added: true},
+			}},
+			map[string]*pb.NodeConfig{"value": value},
+			"Registration failed: group: Invalid tiers",
+		},
+		{
+			"refused and rolled back",
+			&pb.RegisterMultiResult{Results: []*pb.ServiceRegistration{
+				{Service: "group", Error: "Invalid tiers"},
+				{Service: "object", Skipped: true},
+				{Service: "value", Config: value, Added: true, RolledBack: true},
+			}},
+			map[string]*pb.NodeConfig{},
+			"Registration failed: group: Invalid tiers; object: skipped; value: rolled back",
+		},
+		{
+			"rollback failed",
+			&pb.RegisterMultiResult{Results: []*pb.ServiceRegistration{
+				{Service: "group", Error: "Invalid tiers"},
+				{Service: "value", Config: value, Added: true, RollbackError: "builder locked"},
+			}},
+			map[string]*pb.NodeConfig{"value": value},
+			"Registration failed: group: Invalid tiers; value: rollback failed: builder locked",
+		},
+	}
+	for _, test := range tests {
+		configs, err := multiConfigs(test.res)
+		if !reflect.DeepEqual(configs, test.configs) {
+			t.Errorf("%s: multiConfigs returned configs %v, expected %v", test.name, configs, test.configs)
+		}
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: multiConfigs returned unexpected error: %s", test.name, err)
+		case test.err != "" && (err == nil || err.Error() != test.err):
+			t.Errorf("%s: multiConfigs returned error %v, expected %q", test.name, err, test.err)
+		}
+	}
+}